@@ -0,0 +1,13 @@
+data "snowflake_git_repository_refs" "refs" {
+  database = "my_database"
+  schema   = "my_schema"
+  name     = "my_repository"
+}
+
+output "branches" {
+  value = [for branch in data.snowflake_git_repository_refs.refs.branches : branch.name]
+}
+
+output "tags" {
+  value = [for tag in data.snowflake_git_repository_refs.refs.tags : tag.name]
+}
@@ -0,0 +1,8 @@
+data "snowflake_inbound_share" "share" {
+  provider_account = "organization_name.account_name"
+  name             = "share_name"
+}
+
+output "shared_databases" {
+  value = [for object in data.snowflake_inbound_share.share.shared_objects : object.name if object.kind == "DATABASE"]
+}
@@ -0,0 +1,5 @@
+data "snowflake_network_policies" "all" {}
+
+data "snowflake_network_policies" "without_describe" {
+  with_describe = false
+}
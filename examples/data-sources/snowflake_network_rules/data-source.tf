@@ -0,0 +1,8 @@
+data "snowflake_network_rules" "in_schema" {
+  database = "database"
+  schema   = "schema"
+}
+
+data "snowflake_network_rules" "like" {
+  like = "vpce%"
+}
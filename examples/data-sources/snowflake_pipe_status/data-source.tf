@@ -0,0 +1,9 @@
+data "snowflake_pipe_status" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+  name     = "MYPIPE"
+}
+
+output "pipe_is_running" {
+  value = data.snowflake_pipe_status.current.execution_state == "RUNNING"
+}
@@ -0,0 +1,11 @@
+# privileges of all object types
+data "snowflake_privileges" "all" {}
+
+# privileges which can be granted on views
+data "snowflake_privileges" "view" {
+  object_type = "VIEW"
+}
+
+output "view_privileges" {
+  value = data.snowflake_privileges.view.object_types[0].privileges
+}
@@ -1,2 +1,7 @@
 data "snowflake_resource_monitors" "current" {
-}
\ No newline at end of file
+}
+
+# resource monitors which used at least 80 percent of their credit quota
+data "snowflake_resource_monitors" "crossing" {
+  used_percentage_threshold = 80
+}
@@ -0,0 +1,18 @@
+# hierarchy of a single account role with the effective privileges
+data "snowflake_role_hierarchy" "analyst" {
+  account_role_name = "ANALYST"
+}
+
+output "analyst_inherited_roles" {
+  value = data.snowflake_role_hierarchy.analyst.roles[index(data.snowflake_role_hierarchy.analyst.roles.*.name, "\"ANALYST\"")].inherited_roles
+}
+
+# hierarchy of a database role
+data "snowflake_role_hierarchy" "database_role" {
+  database_role_name = "\"MY_DB\".\"MY_DATABASE_ROLE\""
+}
+
+# hierarchy of all account roles, without resolving the privileges
+data "snowflake_role_hierarchy" "all" {
+  with_effective_privileges = false
+}
@@ -0,0 +1,15 @@
+data "snowflake_tag_references" "table" {
+  object_name = "my_database.my_schema.my_table"
+  object_type = "TABLE"
+}
+
+# tags of all the columns of the table, including the ones inherited from the table, schema and database
+data "snowflake_tag_references" "columns" {
+  object_name = "my_database.my_schema.my_table"
+  object_type = "TABLE"
+  all_columns = true
+}
+
+output "classified_columns" {
+  value = [for reference in data.snowflake_tag_references.columns.tag_references : reference.column_name if !reference.inherited]
+}
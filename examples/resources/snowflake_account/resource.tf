@@ -15,4 +15,9 @@ resource "snowflake_account" "ac1" {
   edition              = "STANDARD"
   comment              = "Snowflake Test Account"
   region               = "AWS_US_WEST_2"
+  grace_period_in_days = 7
+
+  organization_parameters = {
+    ENABLE_ACCOUNT_DATABASE_REPLICATION = "true"
+  }
 }
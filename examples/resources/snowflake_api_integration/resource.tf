@@ -21,4 +21,11 @@ resource "snowflake_api_integration" "gcp" {
   google_audience      = "api-gateway-id-123456.apigateway.gcp-project.cloud.goog"
   api_allowed_prefixes = ["https://gateway-id-123456.uc.gateway.dev/"]
   enabled              = true
-}
\ No newline at end of file
+}
+resource "snowflake_api_integration" "git" {
+  name                           = "git_integration"
+  api_provider                   = "git_https_api"
+  api_allowed_prefixes           = ["https://github.com/my-account/"]
+  allowed_authentication_secrets = ["\"my_database\".\"my_schema\".\"my_secret\""]
+  enabled                        = true
+}
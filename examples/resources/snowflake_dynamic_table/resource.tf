@@ -9,4 +9,7 @@ resource "snowflake_dynamic_table" "dt" {
   warehouse = "mywh"
   query     = "SELECT product_id, product_name FROM \"mydb\".\"myschema\".\"staging_table\""
   comment   = "example comment"
+
+  cluster_by  = ["product_id"]
+  copy_grants = true
 }
@@ -0,0 +1,6 @@
+resource "snowflake_file_format_avro" "example" {
+  name       = "EXAMPLE_AVRO"
+  database   = "EXAMPLE_DB"
+  schema     = "EXAMPLE_SCHEMA"
+  trim_space = true
+}
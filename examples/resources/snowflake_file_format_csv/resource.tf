@@ -0,0 +1,10 @@
+resource "snowflake_file_format_csv" "example" {
+  name            = "EXAMPLE_CSV"
+  database        = "EXAMPLE_DB"
+  schema          = "EXAMPLE_SCHEMA"
+  compression     = "GZIP"
+  field_delimiter = "|"
+  parse_header    = true
+  null_if         = ["NULL", ""]
+  comment         = "Pipe separated files with a header"
+}
@@ -0,0 +1,7 @@
+resource "snowflake_file_format_json" "example" {
+  name              = "EXAMPLE_JSON"
+  database          = "EXAMPLE_DB"
+  schema            = "EXAMPLE_SCHEMA"
+  strip_outer_array = true
+  strip_null_values = true
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | file format name
+terraform import snowflake_file_format_orc.example 'dbName|schemaName|fileFormatName'
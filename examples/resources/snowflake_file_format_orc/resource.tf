@@ -0,0 +1,6 @@
+resource "snowflake_file_format_orc" "example" {
+  name     = "EXAMPLE_ORC"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  null_if  = ["NULL"]
+}
@@ -0,0 +1,7 @@
+resource "snowflake_file_format_parquet" "example" {
+  name           = "EXAMPLE_PARQUET"
+  database       = "EXAMPLE_DB"
+  schema         = "EXAMPLE_SCHEMA"
+  compression    = "SNAPPY"
+  binary_as_text = false
+}
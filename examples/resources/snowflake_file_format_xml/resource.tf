@@ -0,0 +1,7 @@
+resource "snowflake_file_format_xml" "example" {
+  name                = "EXAMPLE_XML"
+  database            = "EXAMPLE_DB"
+  schema              = "EXAMPLE_SCHEMA"
+  strip_outer_element = true
+  preserve_space      = true
+}
@@ -0,0 +1,22 @@
+resource "snowflake_function_java" "example" {
+  database        = "database"
+  schema          = "schema"
+  name            = "echo_varchar"
+  runtime_version = "11"
+  handler         = "TestFunc.echoVarchar"
+  return_type     = "VARCHAR"
+  comment         = "Example Java function"
+
+  arguments {
+    arg_name      = "x"
+    arg_data_type = "VARCHAR"
+  }
+
+  function_definition = <<EOT
+class TestFunc {
+  public static String echoVarchar(String x) {
+    return x;
+  }
+}
+EOT
+}
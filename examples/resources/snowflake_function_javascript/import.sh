@@ -0,0 +1,2 @@
+# format is <database_name>.<schema_name>.<function_name>(<arg types, separated with ','>)
+terraform import snowflake_function_javascript.example '"dbName"."schemaName"."functionName"(varchar, varchar)'
@@ -0,0 +1,23 @@
+resource "snowflake_function_javascript" "example" {
+  database    = "database"
+  schema      = "schema"
+  name        = "js_factorial"
+  return_type = "DOUBLE"
+  comment     = "Example JavaScript function"
+
+  arguments {
+    arg_name      = "d"
+    arg_data_type = "DOUBLE"
+  }
+
+  function_definition = <<EOT
+if (D <= 0) {
+  return 1;
+}
+var result = 1;
+for (var i = 2; i <= D; i++) {
+  result = result * i;
+}
+return result;
+EOT
+}
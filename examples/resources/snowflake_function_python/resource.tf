@@ -0,0 +1,19 @@
+resource "snowflake_function_python" "example" {
+  database        = "database"
+  schema          = "schema"
+  name            = "addone"
+  runtime_version = "3.8"
+  handler         = "addone_py"
+  return_type     = "NUMBER(38,0)"
+  comment         = "Example Python function"
+
+  arguments {
+    arg_name      = "i"
+    arg_data_type = "NUMBER(38,0)"
+  }
+
+  function_definition = <<EOT
+def addone_py(i):
+  return i+1
+EOT
+}
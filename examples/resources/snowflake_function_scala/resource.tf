@@ -0,0 +1,22 @@
+resource "snowflake_function_scala" "example" {
+  database        = "database"
+  schema          = "schema"
+  name            = "echo_varchar"
+  runtime_version = "2.12"
+  handler         = "Echo.echoVarchar"
+  return_type     = "VARCHAR"
+  comment         = "Example Scala function"
+
+  arguments {
+    arg_name      = "x"
+    arg_data_type = "VARCHAR"
+  }
+
+  function_definition = <<EOT
+class Echo {
+  def echoVarchar(x : String): String = {
+    return x
+  }
+}
+EOT
+}
@@ -0,0 +1,14 @@
+resource "snowflake_function_sql" "example" {
+  database    = "database"
+  schema      = "schema"
+  name        = "area_of_circle"
+  return_type = "FLOAT"
+  comment     = "Example SQL function"
+
+  arguments {
+    arg_name      = "radius"
+    arg_data_type = "FLOAT"
+  }
+
+  function_definition = "pi() * radius * radius"
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | git repository name
+terraform import snowflake_git_repository.example 'dbName|schemaName|gitRepositoryName'
@@ -0,0 +1,24 @@
+resource "snowflake_api_integration" "git" {
+  name                           = "git_integration"
+  api_provider                   = "git_https_api"
+  api_allowed_prefixes           = ["https://github.com/my-account/"]
+  allowed_authentication_secrets = ["\"my_database\".\"my_schema\".\"my_git_secret\""]
+  enabled                        = true
+}
+
+resource "snowflake_git_repository" "repository" {
+  name            = "my_repository"
+  database        = "my_database"
+  schema          = "my_schema"
+  origin          = "https://github.com/my-account/my-repository.git"
+  api_integration = snowflake_api_integration.git.name
+  git_credentials = "\"my_database\".\"my_schema\".\"my_git_secret\""
+  comment         = "Snowpark code"
+  fetch_on_apply  = true
+}
+
+# the files of the repository are available in the repository stage, e.g.
+# IMPORTS = ('@"my_database"."my_schema"."my_repository"/branches/main/src/handler.py')
+output "main_branch_path" {
+  value = "@${snowflake_git_repository.repository.qualified_name}/branches/main/"
+}
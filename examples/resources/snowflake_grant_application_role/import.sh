@@ -0,0 +1,2 @@
+# format is application_role_name (string) | object_type (ROLE|APPLICATION) | grantee_name (string)
+terraform import "\"my_app\".\"app_role\"|ROLE|\"parent_role\""
@@ -0,0 +1,21 @@
+##################################
+### grant application role to account role
+##################################
+
+resource "snowflake_role" "parent_role" {
+  name = var.parent_role_name
+}
+
+resource "snowflake_grant_application_role" "g" {
+  application_role_name    = "\"${var.application_name}\".\"${var.application_role_name}\""
+  parent_account_role_name = snowflake_role.parent_role.name
+}
+
+##################################
+### grant application role to application
+##################################
+
+resource "snowflake_grant_application_role" "g" {
+  application_role_name = "\"${var.application_name}\".\"${var.application_role_name}\""
+  application_name      = var.consumer_application_name
+}
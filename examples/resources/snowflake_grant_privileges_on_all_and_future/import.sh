@@ -0,0 +1,2 @@
+# format is grantee_type (ROLE|DATABASE ROLE) | role_name (string) | with_grant_option (bool) | object_type_plural (string) | InDatabase|InSchema | database_or_schema_name (string)
+terraform import snowflake_grant_privileges_on_all_and_future.example 'ROLE|"my_role"|false|TABLES|InSchema|"my_db"."my_schema"'
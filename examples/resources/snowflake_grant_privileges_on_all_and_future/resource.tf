@@ -0,0 +1,16 @@
+# grant on all existing and future tables in a schema to an account role
+resource "snowflake_grant_privileges_on_all_and_future" "example" {
+  account_role_name  = snowflake_role.example.name
+  privileges         = ["SELECT", "INSERT"]
+  object_type_plural = "TABLES"
+  in_schema          = "\"${snowflake_database.example.name}\".\"${snowflake_schema.example.name}\""
+}
+
+# grant on all existing and future views in a database to a database role
+resource "snowflake_grant_privileges_on_all_and_future" "example_database_role" {
+  database_role_name = "\"${snowflake_database.example.name}\".\"${snowflake_database_role.example.name}\""
+  privileges         = ["SELECT"]
+  with_grant_option  = true
+  object_type_plural = "VIEWS"
+  in_database        = snowflake_database.example.name
+}
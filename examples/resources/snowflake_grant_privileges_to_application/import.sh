@@ -0,0 +1,2 @@
+# format is application_name (string) | grant_on_kind (OnAccount|OnAccountObject|OnSchema|OnSchemaObject) [ | object_type (string) ] [ | object_name (string) ]
+terraform import snowflake_grant_privileges_to_application.example '"my_app"|OnAccountObject|WAREHOUSE|"my_warehouse"'
@@ -0,0 +1,47 @@
+##################################
+### on account
+##################################
+
+resource "snowflake_grant_privileges_to_application" "example" {
+  application_name = "\"my_app\""
+  privileges       = ["EXECUTE TASK"]
+  on_account       = true
+}
+
+##################################
+### on account object
+##################################
+
+resource "snowflake_grant_privileges_to_application" "example" {
+  application_name = "\"my_app\""
+  privileges       = ["USAGE"]
+  on_account_object {
+    object_type = "WAREHOUSE"
+    object_name = "\"my_warehouse\""
+  }
+}
+
+##################################
+### on schema
+##################################
+
+resource "snowflake_grant_privileges_to_application" "example" {
+  application_name = "\"my_app\""
+  privileges       = ["USAGE"]
+  on_schema {
+    schema_name = "\"my_db\".\"my_schema\""
+  }
+}
+
+##################################
+### on schema object
+##################################
+
+resource "snowflake_grant_privileges_to_application" "example" {
+  application_name = "\"my_app\""
+  privileges       = ["SELECT", "INSERT"]
+  on_schema_object {
+    object_type = "TABLE"
+    object_name = "\"my_db\".\"my_schema\".\"my_table\""
+  }
+}
@@ -32,6 +32,18 @@ resource "snowflake_grant_privileges_to_share" "example" {
 
 ## ID: "\"share_name\"|USAGE|OnSchema|\"database_name\".\"schema_name\""
 
+##################################
+### on function
+##################################
+
+resource "snowflake_grant_privileges_to_share" "example" {
+  to_share    = snowflake_share.example.name
+  privileges  = ["USAGE"]
+  on_function = "\"${snowflake_database.example.name}\".\"${snowflake_schema.example.name}\".\"${snowflake_function.example.name}\"(NUMBER, VARCHAR)"
+}
+
+## ID: "\"share_name\"|USAGE|OnFunction|\"database_name\".\"schema_name\".\"function_name\"(NUMBER, VARCHAR)"
+
 ##################################
 ### on table
 ##################################
@@ -79,3 +91,35 @@ resource "snowflake_grant_privileges_to_share" "example" {
 }
 
 ## ID: "\"share_name\"|SELECT|OnView|\"database_name\".\"schema_name\".\"view_name\""
+
+##################################
+### on materialized view
+##################################
+
+resource "snowflake_grant_privileges_to_share" "example" {
+  to_share             = snowflake_share.example.name
+  privileges           = ["SELECT"]
+  on_materialized_view = "${snowflake_database.example.name}.${snowflake_schema.example.name}.${snowflake_materialized_view.example.name}"
+}
+
+## ID: "\"share_name\"|SELECT|OnMaterializedView|\"database_name\".\"schema_name\".\"materialized_view_name\""
+
+##################################
+### on external, iceberg and dynamic tables
+##################################
+
+resource "snowflake_grant_privileges_to_share" "example" {
+  to_share         = snowflake_share.example.name
+  privileges       = ["SELECT"]
+  on_dynamic_table = "${snowflake_database.example.name}.${snowflake_schema.example.name}.${snowflake_dynamic_table.example.name}"
+}
+
+## ID: "\"share_name\"|SELECT|OnDynamicTable|\"database_name\".\"schema_name\".\"dynamic_table_name\""
+
+resource "snowflake_grant_privileges_to_share" "example" {
+  to_share                        = snowflake_share.example.name
+  privileges                      = ["SELECT"]
+  on_all_iceberg_tables_in_schema = "${snowflake_database.example.name}.${snowflake_schema.example.name}"
+}
+
+## ID: "\"share_name\"|SELECT|OnAllIcebergTablesInSchema|\"database_name\".\"schema_name\""
@@ -1,10 +1,33 @@
 resource "snowflake_managed_account" "account" {
-  name           = "managed account"
+  name           = "managed_account"
   admin_name     = "admin"
-  admin_password = "secret"
+  admin_password = var.admin_password
   type           = "READER"
   comment        = "A managed account."
-  cloud          = "aws"
-  region         = "us-west-2"
-  locator        = "managed-account"
+}
+
+resource "snowflake_share" "share" {
+  name             = "share_name"
+  managed_accounts = [snowflake_managed_account.account.name]
+}
+
+# a second provider instance connecting to the reader account; the connection values are known only after the managed
+# account is created, so the resources using this provider have to be applied after it (e.g. with -target)
+provider "snowflake" {
+  alias    = "reader"
+  account  = snowflake_managed_account.account.connection_config[0].account
+  host     = snowflake_managed_account.account.connection_config[0].host
+  user     = snowflake_managed_account.account.connection_config[0].user
+  password = var.admin_password
+}
+
+data "snowflake_current_account" "provider_account" {}
+
+resource "snowflake_database" "shared" {
+  provider = snowflake.reader
+  name     = "shared_database"
+  from_share = {
+    provider = data.snowflake_current_account.provider_account.account
+    share    = snowflake_share.share.name
+  }
 }
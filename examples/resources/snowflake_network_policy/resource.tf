@@ -5,3 +5,18 @@ resource "snowflake_network_policy" "policy" {
   allowed_ip_list = ["192.168.0.100/24"]
   blocked_ip_list = ["192.168.0.101"]
 }
+
+resource "snowflake_network_rule" "vpce" {
+  name       = "vpce"
+  database   = "database"
+  schema     = "schema"
+  type       = "AWSVPCEID"
+  mode       = "INGRESS"
+  value_list = ["vpce-123abc3420c1931d7"]
+}
+
+resource "snowflake_network_policy" "private_link" {
+  name = "private_link_policy"
+
+  allowed_network_rule_list = [snowflake_network_rule.vpce.qualified_name]
+}
@@ -0,0 +1,9 @@
+resource "snowflake_network_rule" "rule" {
+  name       = "rule"
+  database   = "database"
+  schema     = "schema"
+  comment    = "A rule."
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.100/24", "29.254.123.20"]
+}
@@ -0,0 +1 @@
+terraform import snowflake_notification_integration_inbound_azure_storage_queue.example name
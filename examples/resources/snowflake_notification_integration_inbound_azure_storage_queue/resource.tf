@@ -0,0 +1,7 @@
+resource "snowflake_notification_integration_inbound_azure_storage_queue" "integration" {
+  name                            = "notification"
+  comment                         = "A notification integration."
+  enabled                         = true
+  azure_storage_queue_primary_uri = "https://myqueue.queue.core.windows.net/mystoragequeue"
+  azure_tenant_id                 = "00000000-0000-0000-0000-000000000000"
+}
@@ -0,0 +1 @@
+terraform import snowflake_notification_integration_inbound_gcp_pubsub.example name
@@ -0,0 +1,6 @@
+resource "snowflake_notification_integration_inbound_gcp_pubsub" "integration" {
+  name                         = "notification"
+  comment                      = "A notification integration."
+  enabled                      = true
+  gcp_pubsub_subscription_name = "projects/project-1234/subscriptions/sub2"
+}
@@ -0,0 +1 @@
+terraform import snowflake_notification_integration_outbound_aws_sns.example name
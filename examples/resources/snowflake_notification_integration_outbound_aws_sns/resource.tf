@@ -0,0 +1,7 @@
+resource "snowflake_notification_integration_outbound_aws_sns" "integration" {
+  name              = "notification"
+  comment           = "A notification integration."
+  enabled           = true
+  aws_sns_topic_arn = "arn:aws:sns:us-east-2:111122223333:sns_topic"
+  aws_sns_role_arn  = "arn:aws:iam::111122223333:role/error_sns_role"
+}
@@ -0,0 +1 @@
+terraform import snowflake_notification_integration_outbound_azure_event_grid.example name
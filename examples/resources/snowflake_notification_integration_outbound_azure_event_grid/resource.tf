@@ -0,0 +1,7 @@
+resource "snowflake_notification_integration_outbound_azure_event_grid" "integration" {
+  name                            = "notification"
+  comment                         = "A notification integration."
+  enabled                         = true
+  azure_event_grid_topic_endpoint = "https://myaccount.region-1.eventgrid.azure.net/api/events"
+  azure_tenant_id                 = "00000000-0000-0000-0000-000000000000"
+}
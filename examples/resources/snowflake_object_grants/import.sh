@@ -0,0 +1,2 @@
+# format is object_type | object_name (fully qualified)
+terraform import snowflake_object_grants.example 'TABLE|"DATABASE"."SCHEMA"."TABLE"'
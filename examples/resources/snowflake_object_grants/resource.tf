@@ -0,0 +1,44 @@
+resource "snowflake_role" "analyst" {
+  name = "ANALYST"
+}
+
+resource "snowflake_database_role" "reader" {
+  database = "DATABASE"
+  name     = "READER"
+}
+
+# Every privilege on the database, except OWNERSHIP and the grants to the system roles, is managed by this resource.
+# Privileges granted on the database outside of Terraform are revoked on the next apply.
+resource "snowflake_object_grants" "database" {
+  object_type = "DATABASE"
+  object_name = "\"DATABASE\""
+
+  grant {
+    privilege         = "USAGE"
+    account_role_name = snowflake_role.analyst.name
+  }
+
+  grant {
+    privilege         = "MONITOR"
+    account_role_name = snowflake_role.analyst.name
+    with_grant_option = true
+  }
+
+  grant {
+    privilege          = "USAGE"
+    database_role_name = "\"DATABASE\".\"${snowflake_database_role.reader.name}\""
+  }
+
+  # grants to these roles (and to the system roles) are neither read nor revoked
+  ignored_account_roles = ["DBA"]
+}
+
+resource "snowflake_object_grants" "table" {
+  object_type = "TABLE"
+  object_name = "\"DATABASE\".\"SCHEMA\".\"TABLE\""
+
+  grant {
+    privilege         = "SELECT"
+    account_role_name = snowflake_role.analyst.name
+  }
+}
@@ -10,4 +10,8 @@ resource "snowflake_pipe" "pipe" {
 
   aws_sns_topic_arn    = "..."
   notification_channel = "..."
+
+  paused               = false
+  force_resume         = true
+  force_resume_options = ["STALENESS_CHECK_OVERRIDE"]
 }
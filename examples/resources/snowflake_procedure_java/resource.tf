@@ -0,0 +1,26 @@
+resource "snowflake_procedure_java" "example" {
+  database        = "database"
+  schema          = "schema"
+  name            = "file_reader"
+  runtime_version = "11"
+  packages        = ["com.snowflake:snowpark:latest"]
+  handler         = "FileReader.execute"
+  return_type     = "VARCHAR"
+  execute_as      = "CALLER"
+  comment         = "Example Java procedure"
+
+  arguments {
+    arg_name      = "input"
+    arg_data_type = "VARCHAR"
+  }
+
+  procedure_definition = <<EOT
+import com.snowflake.snowpark_java.*;
+
+class FileReader {
+  public String execute(Session session, String input) {
+    return input;
+  }
+}
+EOT
+}
@@ -0,0 +1,23 @@
+resource "snowflake_procedure_javascript" "example" {
+  database    = "database"
+  schema      = "schema"
+  name        = "stproc1"
+  return_type = "VARCHAR"
+  execute_as  = "CALLER"
+  comment     = "Example JavaScript procedure"
+
+  arguments {
+    arg_name      = "float_param1"
+    arg_data_type = "FLOAT"
+  }
+
+  procedure_definition = <<EOT
+var sql_command = "INSERT INTO stproc_test_table1 (num_col1) VALUES (" + FLOAT_PARAM1 + ")";
+try {
+  snowflake.execute({ sqlText: sql_command });
+  return "Succeeded.";
+} catch (err) {
+  return "Failed: " + err;
+}
+EOT
+}
@@ -0,0 +1,26 @@
+resource "snowflake_procedure_python" "example" {
+  database        = "database"
+  schema          = "schema"
+  name            = "run"
+  runtime_version = "3.8"
+  packages        = ["snowflake-snowpark-python"]
+  handler         = "run"
+  return_type     = "VARCHAR"
+  comment         = "Example Python procedure"
+
+  arguments {
+    arg_name      = "from_table"
+    arg_data_type = "VARCHAR"
+  }
+
+  arguments {
+    arg_name      = "to_table"
+    arg_data_type = "VARCHAR"
+  }
+
+  procedure_definition = <<EOT
+def run(session, from_table, to_table):
+  session.table(from_table).write.save_as_table(to_table)
+  return "SUCCESS"
+EOT
+}
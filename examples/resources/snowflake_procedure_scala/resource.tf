@@ -0,0 +1,25 @@
+resource "snowflake_procedure_scala" "example" {
+  database        = "database"
+  schema          = "schema"
+  name            = "file_reader"
+  runtime_version = "2.12"
+  packages        = ["com.snowflake:snowpark:latest"]
+  handler         = "FileReader.execute"
+  return_type     = "VARCHAR"
+  comment         = "Example Scala procedure"
+
+  arguments {
+    arg_name      = "input"
+    arg_data_type = "VARCHAR"
+  }
+
+  procedure_definition = <<EOT
+import com.snowflake.snowpark_java.Session
+
+object FileReader {
+  def execute(session: Session, input: String): String = {
+    return input
+  }
+}
+EOT
+}
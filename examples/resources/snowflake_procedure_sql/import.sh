@@ -0,0 +1,2 @@
+# format is <database_name>.<schema_name>.<procedure_name>(<arg types, separated with ','>)
+terraform import snowflake_procedure_sql.example '"dbName"."schemaName"."procedureName"(varchar, varchar)'
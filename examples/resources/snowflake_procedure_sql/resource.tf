@@ -0,0 +1,19 @@
+resource "snowflake_procedure_sql" "example" {
+  database    = "database"
+  schema      = "schema"
+  name        = "output_message"
+  return_type = "VARCHAR"
+  execute_as  = "CALLER"
+  comment     = "Example SQL procedure"
+
+  arguments {
+    arg_name      = "message"
+    arg_data_type = "VARCHAR"
+  }
+
+  procedure_definition = <<EOT
+BEGIN
+  RETURN message;
+END;
+EOT
+}
@@ -0,0 +1,4 @@
+# format is resource monitor name | ACCOUNT
+terraform import snowflake_resource_monitor_assignment.account 'monitorName|ACCOUNT'
+# format is resource monitor name | WAREHOUSE | warehouse name
+terraform import snowflake_resource_monitor_assignment.warehouse 'monitorName|WAREHOUSE|warehouseName'
@@ -0,0 +1,16 @@
+resource "snowflake_resource_monitor" "monitor" {
+  name         = "monitor"
+  credit_quota = 100
+}
+
+# assign the resource monitor to the account
+resource "snowflake_resource_monitor_assignment" "account" {
+  resource_monitor = snowflake_resource_monitor.monitor.name
+  set_for_account  = true
+}
+
+# assign the resource monitor to a warehouse
+resource "snowflake_resource_monitor_assignment" "warehouse" {
+  resource_monitor = snowflake_resource_monitor.monitor.name
+  warehouse        = "WAREHOUSE"
+}
@@ -1,4 +1,4 @@
-resource "snowflake_database" "database" {
+resource "snowflake_database" "test_database" {
   name = "things"
 }
 
@@ -12,3 +12,15 @@ resource "snowflake_sequence" "test_sequence" {
   schema   = snowflake_schema.test_schema.name
   name     = "thing_counter"
 }
+
+resource "snowflake_sequence" "order_ids" {
+  database  = snowflake_database.test_database.name
+  schema    = snowflake_schema.test_schema.name
+  name      = "order_ids"
+  start     = 1000
+  increment = 10
+  ordering  = "NOORDER"
+
+  # changing the ordering to ORDER recreates the sequence starting with its current next value
+  preserve_next_value_on_replace = true
+}
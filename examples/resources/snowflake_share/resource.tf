@@ -9,3 +9,14 @@ resource "snowflake_database" "example" {
   depends_on = [snowflake_share.test]
   name       = "test"
 }
+
+resource "snowflake_managed_account" "reader" {
+  name           = "reader_account"
+  admin_name     = "admin"
+  admin_password = var.admin_password
+}
+
+resource "snowflake_share" "reader_share" {
+  name             = "reader_share_name"
+  managed_accounts = [snowflake_managed_account.reader.name]
+}
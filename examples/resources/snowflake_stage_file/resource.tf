@@ -0,0 +1,34 @@
+resource "snowflake_stage" "code" {
+  name     = "CODE"
+  database = "database"
+  schema   = "schema"
+}
+
+data "archive_file" "handler" {
+  type        = "zip"
+  source_dir  = "${path.module}/handler"
+  output_path = "${path.module}/build/handler.zip"
+}
+
+resource "snowflake_stage_file" "handler" {
+  database = snowflake_stage.code.database
+  schema   = snowflake_stage.code.schema
+  stage    = snowflake_stage.code.name
+  path     = "python"
+  source   = data.archive_file.handler.output_path
+}
+
+resource "snowflake_function_python" "example" {
+  database        = "database"
+  schema          = "schema"
+  name            = "addone"
+  runtime_version = "3.8"
+  imports         = [snowflake_stage_file.handler.staged_path]
+  handler         = "handler.addone"
+  return_type     = "NUMBER(38,0)"
+
+  arguments {
+    arg_name      = "i"
+    arg_data_type = "NUMBER(38,0)"
+  }
+}
@@ -0,0 +1,30 @@
+locals {
+  role_name = "snowflake-storage-integration"
+}
+
+data "aws_caller_identity" "current" {}
+
+# the role ARN is known before the role is created, so the trust policy can use the outputs of the integration in the same apply
+resource "snowflake_storage_integration_aws" "integration" {
+  name                      = "storage"
+  comment                   = "A storage integration."
+  storage_allowed_locations = ["s3://mybucket/path/"]
+  storage_blocked_locations = ["s3://mybucket/path/sensitivedata/"]
+  storage_aws_role_arn      = "arn:aws:iam::${data.aws_caller_identity.current.account_id}:role/${local.role_name}"
+  storage_aws_object_acl    = "bucket-owner-full-control"
+}
+
+resource "aws_iam_role" "snowflake" {
+  name = local.role_name
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Action    = "sts:AssumeRole"
+      Principal = { AWS = snowflake_storage_integration_aws.integration.storage_aws_iam_user_arn }
+      Condition = {
+        StringEquals = { "sts:ExternalId" = snowflake_storage_integration_aws.integration.storage_aws_external_id }
+      }
+    }]
+  })
+}
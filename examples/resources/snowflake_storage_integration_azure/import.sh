@@ -0,0 +1 @@
+terraform import snowflake_storage_integration_azure.example name
@@ -0,0 +1,10 @@
+resource "snowflake_storage_integration_azure" "integration" {
+  name                      = "storage"
+  comment                   = "A storage integration."
+  storage_allowed_locations = ["azure://myaccount.blob.core.windows.net/mycontainer/path/"]
+  azure_tenant_id           = "00000000-0000-0000-0000-000000000000"
+}
+
+output "azure_consent_url" {
+  value = snowflake_storage_integration_azure.integration.azure_consent_url
+}
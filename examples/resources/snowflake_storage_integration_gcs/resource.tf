@@ -0,0 +1,12 @@
+resource "snowflake_storage_integration_gcs" "integration" {
+  name                      = "storage"
+  comment                   = "A storage integration."
+  storage_allowed_locations = ["gcs://mybucket/path/"]
+  storage_blocked_locations = ["gcs://mybucket/path/sensitivedata/"]
+}
+
+resource "google_storage_bucket_iam_member" "snowflake" {
+  bucket = "mybucket"
+  role   = "roles/storage.objectViewer"
+  member = "serviceAccount:${snowflake_storage_integration_gcs.integration.storage_gcp_service_account}"
+}
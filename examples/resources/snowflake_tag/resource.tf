@@ -13,3 +13,12 @@ resource "snowflake_tag" "tag" {
   schema         = snowflake_schema.schema.name
   allowed_values = ["finance", "engineering"]
 }
+
+resource "snowflake_tag" "propagated_tag" {
+  name                    = "data_classification"
+  database                = snowflake_database.database.name
+  schema                  = snowflake_schema.schema.name
+  allowed_values          = ["restricted", "internal", "public"]
+  propagate               = "ON_DEPENDENCY_AND_DATA_MOVEMENT"
+  propagation_on_conflict = "ALLOWED_VALUES_SEQUENCE"
+}
@@ -11,3 +11,32 @@ SQL
   or_replace = false
   is_secure  = false
 }
+
+resource "snowflake_view" "view_with_policies" {
+  database = "database"
+  schema   = "schema"
+  name     = "view_with_policies"
+
+  statement       = "select id, email from foo"
+  change_tracking = true
+
+  column {
+    column_name = "ID"
+  }
+  column {
+    column_name = "EMAIL"
+    comment     = "email of the customer"
+    masking_policy {
+      policy_name = "\"database\".\"schema\".\"email_mask\""
+    }
+  }
+
+  row_access_policy {
+    policy_name = "\"database\".\"schema\".\"rows_policy\""
+    on          = ["ID"]
+  }
+
+  data_metric_schedule {
+    using_cron = "0 8 * * * UTC"
+  }
+}
@@ -213,6 +213,8 @@ func upgradeResourceMonitorStateV0toV1(ctx context.Context, req resource.Upgrade
 		return
 	}
 
+	// set_for_account and warehouses are replaced by the snowflake_resource_monitor_assignment resource, so they are dropped
+	// from the state without unassigning the resource monitor
 	resourceMonitorV1 := &resourceMonitorModelV1{
 		Name:           name,
 		NotifyUsers:    notifyUsers,
@@ -279,21 +281,21 @@ func resourceMonitorSchemaV1() schema.Schema {
 				Sensitive:   isSensitive("snowflake_resource_monitor.*.credit_quota"),
 			},
 			"used_credits": schema.Float64Attribute{
-				Description: "The number of credits used by the resource monitor.",
+				Description: "The number of credits used in the current monthly billing cycle by all the warehouses associated with the resource monitor.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.Float64{
 					float64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"remaining_credits": schema.Float64Attribute{
-				Description: "The number of credits remaining for the resource monitor.",
+				Description: "The number of credits still available to use in the current monthly billing cycle.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.Float64{
 					float64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"level": schema.StringAttribute{
-				Description: "resource monitor level",
+				Description: "The level at which the resource monitor is assigned: `ACCOUNT`, `WAREHOUSE` or empty when it is not assigned.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -600,14 +602,7 @@ func (r *ResourceMonitorResource) read(ctx context.Context, data *resourceMonito
 
 	data.CreditQuota = types.Float64Value(resourceMonitor.CreditQuota)
 	data.Frequency = types.StringValue(string(resourceMonitor.Frequency))
-	switch resourceMonitor.Level {
-	case sdk.ResourceMonitorLevelAccount:
-		data.Level = types.StringValue("ACCOUNT")
-	case sdk.ResourceMonitorLevelWarehouse:
-		data.Level = types.StringValue("WAREHOUSE")
-	case sdk.ResourceMonitorLevelNull:
-		data.Level = types.StringValue("NULL")
-	}
+	data.Level = types.StringValue(resourceMonitor.Level.String())
 	data.UsedCredits = types.Float64Value(resourceMonitor.UsedCredits)
 	data.RemainingCredits = types.Float64Value(resourceMonitor.RemainingCredits)
 
@@ -0,0 +1,145 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitRepositoryRefsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database of the Git repository.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema of the Git repository.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the Git repository.",
+	},
+	"branches": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The branches of the Git repository, as of the last fetch.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The path of the branch in the repository stage, e.g. `/branches/main`.",
+				},
+				"checkouts": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"commit_hash": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+	"tags": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The tags of the Git repository, as of the last fetch.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The path of the tag in the repository stage, e.g. `/tags/v1.0.0`.",
+				},
+				"commit_hash": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"author": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func GitRepositoryRefs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadGitRepositoryRefs,
+		Schema:      gitRepositoryRefsSchema,
+		Description: "Lists the branches and tags of a Git repository.",
+	}
+}
+
+func ReadGitRepositoryRefs(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	branches, err := client.GitRepositories.ShowGitBranches(ctx, sdk.NewShowGitBranchesGitRepositoryRequest(id))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to retrieve branches of git repository %s, err = %w", id.FullyQualifiedName(), err))
+	}
+	branchesList := make([]map[string]any, len(branches))
+	for i, branch := range branches {
+		branchMap := map[string]any{
+			"name":        branch.Name,
+			"path":        branch.Path,
+			"commit_hash": branch.CommitHash,
+		}
+		if branch.Checkouts != nil {
+			branchMap["checkouts"] = *branch.Checkouts
+		}
+		branchesList[i] = branchMap
+	}
+	if err := d.Set("branches", branchesList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := client.GitRepositories.ShowGitTags(ctx, sdk.NewShowGitTagsGitRepositoryRequest(id))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to retrieve tags of git repository %s, err = %w", id.FullyQualifiedName(), err))
+	}
+	tagsList := make([]map[string]any, len(tags))
+	for i, tag := range tags {
+		tagMap := map[string]any{
+			"name":        tag.Name,
+			"path":        tag.Path,
+			"commit_hash": tag.CommitHash,
+		}
+		if tag.Author != nil {
+			tagMap["author"] = *tag.Author
+		}
+		if tag.Message != nil {
+			tagMap["message"] = *tag.Message
+		}
+		tagsList[i] = tagMap
+	}
+	if err := d.Set("tags", tagsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	return nil
+}
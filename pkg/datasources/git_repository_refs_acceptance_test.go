@@ -0,0 +1,46 @@
+package datasources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GitRepositoryRefs_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	apiIntegrationName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: config.Variables{
+					"name":                 config.StringVariable(name),
+					"database":             config.StringVariable(acc.TestDatabaseName),
+					"schema":               config.StringVariable(acc.TestSchemaName),
+					"api_integration_name": config.StringVariable(apiIntegrationName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.snowflake_git_repository_refs.test", "branches.#"),
+					resource.TestCheckResourceAttrSet("data.snowflake_git_repository_refs.test", "branches.0.name"),
+					resource.TestCheckResourceAttrSet("data.snowflake_git_repository_refs.test", "branches.0.path"),
+					resource.TestCheckResourceAttrSet("data.snowflake_git_repository_refs.test", "branches.0.commit_hash"),
+					resource.TestCheckResourceAttrSet("data.snowflake_git_repository_refs.test", "tags.#"),
+					resource.TestCheckResourceAttrSet("data.snowflake_git_repository_refs.test", "tags.0.name"),
+					resource.TestCheckResourceAttrSet("data.snowflake_git_repository_refs.test", "tags.0.commit_hash"),
+				),
+			},
+		},
+	})
+}
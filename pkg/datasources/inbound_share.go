@@ -0,0 +1,82 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var inboundShareSchema = map[string]*schema.Schema{
+	"provider_account": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The account providing the share, either as `organization_name.account_name` or as the account locator.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the share.",
+	},
+	"shared_objects": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The objects shared with the current account.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kind": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the shared object, e.g. `DATABASE`.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The fully qualified name of the shared object.",
+				},
+				"shared_on": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Date and time when the object was added to the share.",
+				},
+			},
+		},
+	},
+}
+
+func InboundShare() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadInboundShare,
+		Schema:      inboundShareSchema,
+		Description: "Describes an inbound share, i.e. a share consumed by the current account (e.g. a reader account), listing the shared objects. For more information, check [DESCRIBE SHARE documentation](https://docs.snowflake.com/en/sql-reference/sql/desc-share).",
+	}
+}
+
+func ReadInboundShare(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	providerAccount := sdk.NewAccountIdentifierFromFullyQualifiedName(d.Get("provider_account").(string))
+	id := sdk.NewExternalObjectIdentifier(providerAccount, sdk.NewAccountObjectIdentifier(d.Get("name").(string)))
+
+	details, err := client.Shares.DescribeConsumer(ctx, id)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to describe inbound share %s, err = %w", id.FullyQualifiedName(), err))
+	}
+	sharedObjects := make([]map[string]any, len(details.SharedObjects))
+	for i, sharedObject := range details.SharedObjects {
+		sharedObjects[i] = map[string]any{
+			"kind":      sharedObject.Kind.String(),
+			"name":      sharedObject.Name.FullyQualifiedName(),
+			"shared_on": sharedObject.SharedOn.String(),
+		}
+	}
+	if err := d.Set("shared_objects", sharedObjects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(providerAccount.Name(), id.Name()))
+	return nil
+}
@@ -0,0 +1,73 @@
+package datasources_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TODO [SNOW-1284394]: Unskip the test
+func TestAcc_InboundShare_basic(t *testing.T) {
+	t.Skip("TestAcc_Share are skipped")
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	shareName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	client := acc.Client(t)
+	secondaryClient := acc.SecondaryClient(t)
+	ctx := context.Background()
+
+	// the share is provided by the secondary account to the current account
+	replicationAccounts, err := secondaryClient.ReplicationFunctions.ShowReplicationAccounts(ctx)
+	require.NoError(t, err)
+	var accountId *sdk.AccountIdentifier
+	for _, replicationAccount := range replicationAccounts {
+		if replicationAccount.AccountLocator == client.GetAccountLocator() {
+			accountId = sdk.Pointer(sdk.NewAccountIdentifier(replicationAccount.OrganizationName, replicationAccount.AccountName))
+		}
+	}
+	require.NotNil(t, accountId)
+
+	databaseId := sdk.NewAccountObjectIdentifier(databaseName)
+	require.NoError(t, secondaryClient.Databases.Create(ctx, databaseId, nil))
+	t.Cleanup(func() {
+		require.NoError(t, secondaryClient.Databases.Drop(ctx, databaseId, nil))
+	})
+	shareId := sdk.NewAccountObjectIdentifier(shareName)
+	require.NoError(t, secondaryClient.Shares.Create(ctx, shareId, nil))
+	t.Cleanup(func() {
+		require.NoError(t, secondaryClient.Shares.Drop(ctx, shareId))
+	})
+	require.NoError(t, secondaryClient.Grants.GrantPrivilegeToShare(ctx, []sdk.ObjectPrivilege{sdk.ObjectPrivilegeUsage}, &sdk.ShareGrantOn{Database: databaseId}, shareId))
+	require.NoError(t, secondaryClient.Shares.Alter(ctx, shareId, &sdk.AlterShareOptions{Add: &sdk.ShareAdd{Accounts: []sdk.AccountIdentifier{*accountId}}}))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: config.Variables{
+					"provider_account": config.StringVariable(secondaryClient.GetAccountLocator()),
+					"name":             config.StringVariable(shareName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_inbound_share.test", "shared_objects.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_inbound_share.test", "shared_objects.0.kind", "DATABASE"),
+					resource.TestCheckResourceAttrSet("data.snowflake_inbound_share.test", "shared_objects.0.shared_on"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,137 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var networkPoliciesSchema = map[string]*schema.Schema{
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESCRIBE NETWORK POLICY for every network policy to fill in the IP and network rule lists.",
+	},
+	"network_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The network policies in the account.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"entries_in_allowed_ip_list": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"entries_in_blocked_ip_list": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"entries_in_allowed_network_rules": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"entries_in_blocked_network_rules": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"allowed_ip_list": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"blocked_ip_list": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"allowed_network_rule_list": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The fully qualified names of the network rules allowing the access.",
+				},
+				"blocked_network_rule_list": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The fully qualified names of the network rules blocking the access.",
+				},
+			},
+		},
+	},
+}
+
+func NetworkPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadNetworkPolicies,
+		Schema:      networkPoliciesSchema,
+		Description: "Lists the network policies in the account together with the IP addresses and network rules they allow or block.",
+	}
+}
+
+func ReadNetworkPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	networkPolicies, err := client.NetworkPolicies.Show(ctx, sdk.NewShowNetworkPolicyRequest())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to retrieve network policies, err = %w", err))
+	}
+
+	networkPoliciesList := make([]map[string]any, len(networkPolicies))
+	for i, networkPolicy := range networkPolicies {
+		networkPolicyMap := map[string]any{
+			"name":                             networkPolicy.Name,
+			"comment":                          networkPolicy.Comment,
+			"entries_in_allowed_ip_list":       networkPolicy.EntriesInAllowedIpList,
+			"entries_in_blocked_ip_list":       networkPolicy.EntriesInBlockedIpList,
+			"entries_in_allowed_network_rules": networkPolicy.EntriesInAllowedNetworkRules,
+			"entries_in_blocked_network_rules": networkPolicy.EntriesInBlockedNetworkRules,
+		}
+		if d.Get("with_describe").(bool) {
+			descriptions, err := client.NetworkPolicies.Describe(ctx, sdk.NewAccountObjectIdentifier(networkPolicy.Name))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("unable to describe network policy %s, err = %w", networkPolicy.Name, err))
+			}
+			for _, description := range descriptions {
+				switch description.Name {
+				case "ALLOWED_IP_LIST", "BLOCKED_IP_LIST":
+					if description.Value != "" {
+						networkPolicyMap[strings.ToLower(description.Name)] = strings.Split(description.Value, ",")
+					}
+				case "ALLOWED_NETWORK_RULE_LIST", "BLOCKED_NETWORK_RULE_LIST":
+					networkRules, err := sdk.ParseNetworkRulesSnowflakeDto(description.Value)
+					if err != nil {
+						return diag.FromErr(err)
+					}
+					names := make([]string, len(networkRules))
+					for j, networkRule := range networkRules {
+						names[j] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(networkRule.FullyQualifiedRuleName).FullyQualifiedName()
+					}
+					networkPolicyMap[strings.ToLower(description.Name)] = names
+				}
+			}
+		}
+		networkPoliciesList[i] = networkPolicyMap
+	}
+
+	if err := d.Set("network_policies", networkPoliciesList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("network_policies")
+	return nil
+}
@@ -0,0 +1,68 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NetworkPolicies_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: config.Variables{
+					"name":     config.StringVariable(name),
+					"database": config.StringVariable(acc.TestDatabaseName),
+					"schema":   config.StringVariable(acc.TestSchemaName),
+				},
+				Check: containsNetworkPolicy(name, map[string]string{
+					"comment":                          "Terraform acceptance test",
+					"entries_in_allowed_network_rules": "1",
+					"blocked_ip_list.#":                "1",
+					"blocked_ip_list.0":                "192.168.0.101",
+					"allowed_network_rule_list.#":      "1",
+					"allowed_network_rule_list.0":      sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName(),
+				}),
+			},
+		},
+	})
+}
+
+func containsNetworkPolicy(name string, expected map[string]string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		dataSource, ok := state.RootModule().Resources["data.snowflake_network_policies.test"]
+		if !ok {
+			return fmt.Errorf("data source data.snowflake_network_policies.test not found")
+		}
+		attributes := dataSource.Primary.Attributes
+		for i := 0; attributes[fmt.Sprintf("network_policies.%d.name", i)] != ""; i++ {
+			if attributes[fmt.Sprintf("network_policies.%d.name", i)] != name {
+				continue
+			}
+			for key, value := range expected {
+				if actual := attributes[fmt.Sprintf("network_policies.%d.%s", i, key)]; actual != value {
+					return fmt.Errorf("expected %s of network policy %s to be %s, got %s", key, name, value, actual)
+				}
+			}
+			return nil
+		}
+		return fmt.Errorf("network policy %s not found", name)
+	}
+}
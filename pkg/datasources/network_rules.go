@@ -0,0 +1,154 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var networkRulesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The database from which to return the network rules. If not set, the network rules in the whole account are returned.",
+	},
+	"schema": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"database"},
+		Description:  "The schema from which to return the network rules.",
+	},
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the network rules by name using the LIKE pattern (case-insensitive).",
+	},
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESCRIBE NETWORK RULE for every network rule to fill in the value list.",
+	},
+	"network_rules": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The network rules.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"qualified_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The fully qualified name of the network rule, as used in the network rule lists of the network policies.",
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"mode": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"entries_in_value_list": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"value_list": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	},
+}
+
+func NetworkRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadNetworkRules,
+		Schema:      networkRulesSchema,
+		Description: "Lists the network rules in the account, database or schema together with the network identifiers they contain.",
+	}
+}
+
+func ReadNetworkRules(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	request := sdk.NewShowNetworkRuleRequest()
+	id := "network_rules"
+	database, schemaName := d.Get("database").(string), d.Get("schema").(string)
+	switch {
+	case schemaName != "":
+		schemaId := sdk.NewDatabaseObjectIdentifier(database, schemaName)
+		request.WithIn(&sdk.In{Schema: schemaId})
+		id = helpers.EncodeSnowflakeID(schemaId)
+	case database != "":
+		databaseId := sdk.NewAccountObjectIdentifier(database)
+		request.WithIn(&sdk.In{Database: databaseId})
+		id = helpers.EncodeSnowflakeID(databaseId)
+	default:
+		request.WithIn(&sdk.In{Account: sdk.Bool(true)})
+	}
+	if like, ok := d.GetOk("like"); ok {
+		request.WithLike(&sdk.Like{Pattern: sdk.String(like.(string))})
+	}
+
+	networkRules, err := client.NetworkRules.Show(ctx, request)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to retrieve network rules, err = %w", err))
+	}
+
+	networkRulesList := make([]map[string]any, len(networkRules))
+	for i, networkRule := range networkRules {
+		networkRuleMap := map[string]any{
+			"name":                  networkRule.Name,
+			"database":              networkRule.DatabaseName,
+			"schema":                networkRule.SchemaName,
+			"qualified_name":        networkRule.ID().FullyQualifiedName(),
+			"type":                  string(networkRule.Type),
+			"mode":                  string(networkRule.Mode),
+			"owner":                 networkRule.Owner,
+			"comment":               networkRule.Comment,
+			"entries_in_value_list": networkRule.EntriesInValueList,
+		}
+		if d.Get("with_describe").(bool) {
+			details, err := client.NetworkRules.Describe(ctx, networkRule.ID())
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("unable to describe network rule %s, err = %w", networkRule.ID().FullyQualifiedName(), err))
+			}
+			networkRuleMap["value_list"] = details.ValueList
+		}
+		networkRulesList[i] = networkRuleMap
+	}
+
+	if err := d.Set("network_rules", networkRulesList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	return nil
+}
@@ -0,0 +1,45 @@
+package datasources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NetworkRules_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: config.Variables{
+					"name":     config.StringVariable(name),
+					"database": config.StringVariable(acc.TestDatabaseName),
+					"schema":   config.StringVariable(acc.TestSchemaName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_network_rules.test", "network_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_network_rules.test", "network_rules.0.name", name),
+					resource.TestCheckResourceAttr("data.snowflake_network_rules.test", "network_rules.0.qualified_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName()),
+					resource.TestCheckResourceAttr("data.snowflake_network_rules.test", "network_rules.0.type", "IPV4"),
+					resource.TestCheckResourceAttr("data.snowflake_network_rules.test", "network_rules.0.mode", "INGRESS"),
+					resource.TestCheckResourceAttr("data.snowflake_network_rules.test", "network_rules.0.entries_in_value_list", "2"),
+					resource.TestCheckResourceAttr("data.snowflake_network_rules.test", "network_rules.0.value_list.#", "2"),
+				),
+			},
+		},
+	})
+}
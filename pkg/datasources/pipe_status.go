@@ -0,0 +1,129 @@
+package datasources
+
+import (
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var pipeStatusSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which the pipe is created.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which the pipe is created.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the pipe.",
+	},
+	"execution_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current execution state of the pipe.",
+	},
+	"pending_file_count": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of files currently being processed by the pipe.",
+	},
+	"last_ingested_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp when the most recent file was loaded successfully by the pipe.",
+	},
+	"last_ingested_file_path": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Path of the file loaded at the time specified in `last_ingested_timestamp`.",
+	},
+	"notification_channel_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Amazon SQS queue or Microsoft Azure Storage queue associated with the pipe.",
+	},
+	"num_outstanding_messages_on_channel": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of messages in the queue that have been queued but not received yet.",
+	},
+	"last_received_message_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp of the last event message received from the message queue.",
+	},
+	"last_forwarded_message_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp of the last event message that matched the stage and was forwarded to the pipe.",
+	},
+	"last_pulled_from_channel_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp of the last time the queue was polled for messages.",
+	},
+	"last_forwarded_file_path": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Path of the file forwarded to the pipe at the time specified in `last_forwarded_message_timestamp`.",
+	},
+	"error": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Error message produced when the pipe was last compiled for execution (if applicable).",
+	},
+	"fault": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Most recent internal Snowflake process error (if applicable).",
+	},
+}
+
+func PipeStatus() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadPipeStatus,
+		Schema: pipeStatusSchema,
+	}
+}
+
+// ReadPipeStatus implements schema.ReadFunc.
+func ReadPipeStatus(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+
+	pipeId := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	pipeStatus, err := client.SystemFunctions.PipeStatusDetails(pipeId)
+	if err != nil {
+		return fmt.Errorf("error reading status of pipe %v: %w", pipeId.FullyQualifiedName(), err)
+	}
+
+	fields := map[string]any{
+		"execution_state":                     string(pipeStatus.ExecutionState),
+		"pending_file_count":                  pipeStatus.PendingFileCount,
+		"last_ingested_timestamp":             pipeStatus.LastIngestedTimestamp,
+		"last_ingested_file_path":             pipeStatus.LastIngestedFilePath,
+		"notification_channel_name":           pipeStatus.NotificationChannelName,
+		"num_outstanding_messages_on_channel": pipeStatus.NumOutstandingMessagesOnChannel,
+		"last_received_message_timestamp":     pipeStatus.LastReceivedMessageTimestamp,
+		"last_forwarded_message_timestamp":    pipeStatus.LastForwardedMessageTimestamp,
+		"last_pulled_from_channel_timestamp":  pipeStatus.LastPulledFromChannelTimestamp,
+		"last_forwarded_file_path":            pipeStatus.LastForwardedFilePath,
+		"error":                               pipeStatus.Error,
+		"fault":                               pipeStatus.Fault,
+	}
+	for key, value := range fields {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(pipeId))
+	return nil
+}
@@ -0,0 +1,69 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PipeStatus(t *testing.T) {
+	pipeName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: pipeStatus(acc.TestDatabaseName, acc.TestSchemaName, pipeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_pipe_status.t", "name", pipeName),
+					resource.TestCheckResourceAttr("data.snowflake_pipe_status.t", "execution_state", "PAUSED"),
+					resource.TestCheckResourceAttr("data.snowflake_pipe_status.t", "pending_file_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func pipeStatus(databaseName string, schemaName string, pipeName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+  column {
+    name = "id"
+    type = "NUMBER(5,0)"
+  }
+}
+
+resource "snowflake_stage" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+}
+
+resource "snowflake_pipe" "test" {
+  database       = "%[1]s"
+  schema         = "%[2]s"
+  name           = "%[3]s"
+  copy_statement = "copy into ${snowflake_table.test.database}.${snowflake_table.test.schema}.${snowflake_table.test.name} from @${snowflake_stage.test.database}.${snowflake_stage.test.schema}.${snowflake_stage.test.name}"
+  paused         = true
+}
+
+data "snowflake_pipe_status" "t" {
+  database = snowflake_pipe.test.database
+  schema   = snowflake_pipe.test.schema
+  name     = snowflake_pipe.test.name
+}
+`, databaseName, schemaName, pipeName)
+}
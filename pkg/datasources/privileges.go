@@ -0,0 +1,86 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func validPrivilegesObjectTypes() []string {
+	objectTypes := make([]string, 0)
+	for _, objectType := range sdk.ObjectTypesWithPrivileges() {
+		objectTypes = append(objectTypes, objectType.String())
+	}
+	return objectTypes
+}
+
+var privilegesSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      fmt.Sprintf("The object type for which the privileges are listed. If not set, the privileges of all object types are listed. Valid values are: %s", strings.Join(validPrivilegesObjectTypes(), " | ")),
+		ValidateDiagFunc: resources.StringInSlice(validPrivilegesObjectTypes(), true),
+	},
+	"object_types": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The object types with the privileges which can be granted on them.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The object type (ACCOUNT lists the global privileges).",
+				},
+				"privileges": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The privileges which can be granted on the object type (without OWNERSHIP and ALL PRIVILEGES).",
+				},
+			},
+		},
+	},
+}
+
+// Privileges lists the privileges which can be granted on each object type, as validated by the grant resources.
+func Privileges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadPrivileges,
+		Schema:      privilegesSchema,
+		Description: "Lists the privileges which can be granted on each object type. The same catalog is used by the grant resources to reject the privileges known to be invalid for the object type at plan time.",
+	}
+}
+
+func ReadPrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	objectTypes := sdk.ObjectTypesWithPrivileges()
+	id := "privileges"
+	if objectType := d.Get("object_type").(string); objectType != "" {
+		objectTypes = []sdk.ObjectType{sdk.ObjectType(strings.ToUpper(objectType))}
+		id = strings.ToUpper(objectType)
+	}
+
+	result := make([]map[string]any, 0, len(objectTypes))
+	for _, objectType := range objectTypes {
+		privileges, ok := sdk.PrivilegesForObjectType(objectType)
+		if !ok {
+			return diag.FromErr(fmt.Errorf("no privileges are known for object type %s", objectType))
+		}
+		result = append(result, map[string]any{
+			"object_type": objectType.String(),
+			"privileges":  privileges,
+		})
+	}
+
+	if err := d.Set("object_types", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	return nil
+}
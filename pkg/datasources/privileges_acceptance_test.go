@@ -0,0 +1,37 @@
+package datasources_test
+
+import (
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Privileges_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: config.Variables{
+					"object_type": config.StringVariable("view"),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_privileges.test", "id", "VIEW"),
+					resource.TestCheckResourceAttr("data.snowflake_privileges.test", "object_types.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_privileges.test", "object_types.0.object_type", "VIEW"),
+					resource.TestCheckResourceAttr("data.snowflake_privileges.test", "object_types.0.privileges.#", "2"),
+					resource.TestCheckResourceAttr("data.snowflake_privileges.test", "object_types.0.privileges.0", "REFERENCES"),
+					resource.TestCheckResourceAttr("data.snowflake_privileges.test", "object_types.0.privileges.1", "SELECT"),
+				),
+			},
+		},
+	})
+}
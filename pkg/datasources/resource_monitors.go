@@ -9,9 +9,16 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var resourceMonitorsSchema = map[string]*schema.Schema{
+	"used_percentage_threshold": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Returns only the resource monitors with a credit quota which used at least the given percentage of it, e.g. to feed the monitors crossing a budget threshold to alerting.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
 	"resource_monitors": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -36,6 +43,24 @@ var resourceMonitorsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"used_credits": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"remaining_credits": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"used_percentage": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The percentage of the credit quota used in the current interval; 0 when the resource monitor has no credit quota.",
+				},
+				"level": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The level at which the resource monitor is assigned: `ACCOUNT`, `WAREHOUSE` or empty when it is not assigned.",
+				},
 			},
 		},
 	},
@@ -68,15 +93,29 @@ func ReadResourceMonitors(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	resourceMonitors := make([]map[string]any, len(extractedResourceMonitors))
+	// checked in the raw config, as the threshold of 0 is valid
+	filterByThreshold := !d.GetRawConfig().GetAttr("used_percentage_threshold").IsNull()
+	threshold := d.Get("used_percentage_threshold").(int)
+	resourceMonitors := make([]map[string]any, 0, len(extractedResourceMonitors))
 
-	for i, resourceMonitor := range extractedResourceMonitors {
-		resourceMonitors[i] = map[string]any{
-			"name":         resourceMonitor.Name,
-			"frequency":    resourceMonitor.Frequency,
-			"credit_quota": fmt.Sprintf("%f", resourceMonitor.CreditQuota),
-			"comment":      resourceMonitor.Comment,
+	for _, resourceMonitor := range extractedResourceMonitors {
+		var usedPercentage float64
+		if resourceMonitor.CreditQuota > 0 {
+			usedPercentage = resourceMonitor.UsedCredits / resourceMonitor.CreditQuota * 100
+		}
+		if filterByThreshold && (resourceMonitor.CreditQuota == 0 || usedPercentage < float64(threshold)) {
+			continue
 		}
+		resourceMonitors = append(resourceMonitors, map[string]any{
+			"name":              resourceMonitor.Name,
+			"frequency":         resourceMonitor.Frequency,
+			"credit_quota":      fmt.Sprintf("%f", resourceMonitor.CreditQuota),
+			"comment":           resourceMonitor.Comment,
+			"used_credits":      resourceMonitor.UsedCredits,
+			"remaining_credits": resourceMonitor.RemainingCredits,
+			"used_percentage":   usedPercentage,
+			"level":             resourceMonitor.Level.String(),
+		})
 	}
 
 	return d.Set("resource_monitors", resourceMonitors)
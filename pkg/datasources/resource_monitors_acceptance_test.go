@@ -28,6 +28,15 @@ func TestAcc_ResourceMonitors(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.snowflake_resource_monitors.s", "resource_monitors.#"),
 					resource.TestCheckResourceAttrSet("data.snowflake_resource_monitors.s", "resource_monitors.0.name"),
+					resource.TestCheckResourceAttrSet("data.snowflake_resource_monitors.s", "resource_monitors.0.used_credits"),
+					resource.TestCheckResourceAttrSet("data.snowflake_resource_monitors.s", "resource_monitors.0.remaining_credits"),
+					resource.TestCheckResourceAttrSet("data.snowflake_resource_monitors.s", "resource_monitors.0.used_percentage"),
+					// the new resource monitor has a credit quota, so it crosses the threshold of 0 percent
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_resource_monitors.crossing", "resource_monitors.*", map[string]string{
+						"name":            resourceMonitorName,
+						"used_credits":    "0",
+						"used_percentage": "0",
+					}),
 				),
 			},
 		},
@@ -44,5 +53,10 @@ func resourceMonitors(resourceMonitorName string) string {
 	data snowflake_resource_monitors "s" {
 		depends_on = [snowflake_resource_monitor.s]
 	}
+
+	data snowflake_resource_monitors "crossing" {
+		used_percentage_threshold = 0
+		depends_on                = [snowflake_resource_monitor.s]
+	}
 	`, resourceMonitorName)
 }
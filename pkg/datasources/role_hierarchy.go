@@ -0,0 +1,308 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var roleHierarchyPrivilegeSchema = map[string]*schema.Schema{
+	"privilege": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The privilege granted on the object.",
+	},
+	"object_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the object on which the privilege is granted.",
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the object on which the privilege is granted.",
+	},
+	"granted_to": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The fully qualified name of the role to which the privilege is granted directly.",
+	},
+}
+
+var roleHierarchySchema = map[string]*schema.Schema{
+	"account_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The fully qualified name of the account role from which the hierarchy is resolved. If neither `account_role_name` nor `database_role_name` is set, the hierarchy of all account roles is resolved.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ConflictsWith:    []string{"database_role_name"},
+	},
+	"database_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The fully qualified name of the database role from which the hierarchy is resolved.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ConflictsWith:    []string{"account_role_name"},
+	},
+	"with_effective_privileges": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "If true, the effective privileges (granted directly or inherited from the granted roles) are resolved for every role in the hierarchy.",
+	},
+	"roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The roles in the hierarchy.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The fully qualified name of the role.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the role (ROLE | DATABASE ROLE | APPLICATION ROLE).",
+				},
+				"granted_roles": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The fully qualified names of the roles granted directly to the role.",
+				},
+				"inherited_roles": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The fully qualified names of all the roles granted to the role, directly or through other roles.",
+				},
+				"effective_privileges": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The privileges granted to the role directly or through the inherited roles.",
+					Elem:        &schema.Resource{Schema: roleHierarchyPrivilegeSchema},
+				},
+			},
+		},
+	},
+}
+
+// RoleHierarchy resolves the graph of account, database and application roles granted to each other.
+func RoleHierarchy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadRoleHierarchy,
+		Schema:      roleHierarchySchema,
+		Description: "Resolves the hierarchy of account, database and application roles and the effective privileges of every role in it.",
+	}
+}
+
+type roleHierarchyPrivilege struct {
+	Privilege  string
+	ObjectType string
+	ObjectName string
+}
+
+type roleHierarchyNode struct {
+	Type         sdk.ObjectType
+	Name         string
+	GrantedRoles []string
+	Privileges   []roleHierarchyPrivilege
+}
+
+// roleHierarchy maps the fully qualified role names to the roles. Applications share the namespace with databases,
+// so the names of database and application roles are unique.
+type roleHierarchy map[string]*roleHierarchyNode
+
+// inheritedRoles returns all roles reachable from the given role. The visited roles are tracked, so cycles in the graph
+// (which Snowflake does not allow, but could appear when the grants change during the traversal) do not loop forever.
+func (h roleHierarchy) inheritedRoles(name string) []string {
+	visited := map[string]bool{name: true}
+	inherited := make([]string, 0)
+	stack := []string{name}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node, ok := h[current]
+		if !ok {
+			continue
+		}
+		for _, granted := range node.GrantedRoles {
+			if visited[granted] {
+				continue
+			}
+			visited[granted] = true
+			inherited = append(inherited, granted)
+			stack = append(stack, granted)
+		}
+	}
+	sort.Strings(inherited)
+	return inherited
+}
+
+// effectivePrivileges returns the privileges granted to the role and to all the inherited roles.
+func (h roleHierarchy) effectivePrivileges(name string) []map[string]any {
+	result := make([]map[string]any, 0)
+	for _, roleName := range append([]string{name}, h.inheritedRoles(name)...) {
+		node, ok := h[roleName]
+		if !ok {
+			continue
+		}
+		for _, privilege := range node.Privileges {
+			result = append(result, map[string]any{
+				"privilege":   privilege.Privilege,
+				"object_type": privilege.ObjectType,
+				"object_name": privilege.ObjectName,
+				"granted_to":  roleName,
+			})
+		}
+	}
+	return result
+}
+
+// grantedRoleName returns the type and fully qualified name of the role granted in the SHOW GRANTS TO row. Roles are
+// granted with the USAGE privilege, other privileges on roles (e.g. OWNERSHIP) do not make the role inherited.
+func grantedRoleName(grant sdk.Grant) (sdk.ObjectType, string, bool) {
+	if grant.Privilege != "USAGE" {
+		return "", "", false
+	}
+	switch grant.GrantedOn {
+	case sdk.ObjectTypeRole:
+		return sdk.ObjectTypeRole, sdk.NewAccountObjectIdentifier(grant.Name.Name()).FullyQualifiedName(), true
+	case sdk.ObjectTypeDatabaseRole, sdk.ObjectTypeApplicationRole:
+		name := grant.Name.Name()
+		separator := "."
+		if strings.Contains(name, `"."`) {
+			separator = `"."`
+		}
+		parts := strings.SplitN(name, separator, 2)
+		if len(parts) != 2 {
+			return "", "", false
+		}
+		return grant.GrantedOn, sdk.NewDatabaseObjectIdentifier(strings.Trim(parts[0], `"`), strings.Trim(parts[1], `"`)).FullyQualifiedName(), true
+	}
+	return "", "", false
+}
+
+func showRoleHierarchyGrants(ctx context.Context, client *sdk.Client, node *roleHierarchyNode) ([]sdk.Grant, error) {
+	to := new(sdk.ShowGrantsTo)
+	switch node.Type {
+	case sdk.ObjectTypeRole:
+		to.Role = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(node.Name)
+	case sdk.ObjectTypeDatabaseRole:
+		to.DatabaseRole = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(node.Name)
+	case sdk.ObjectTypeApplicationRole:
+		to.ApplicationRole = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(node.Name)
+	}
+	return client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: to})
+}
+
+// buildRoleHierarchy traverses the grants starting from the given roles and returns all the reachable roles.
+func buildRoleHierarchy(ctx context.Context, client *sdk.Client, roots []*roleHierarchyNode) (roleHierarchy, error) {
+	hierarchy := make(roleHierarchy)
+	queue := make([]*roleHierarchyNode, 0, len(roots))
+	for _, root := range roots {
+		if _, ok := hierarchy[root.Name]; !ok {
+			hierarchy[root.Name] = root
+			queue = append(queue, root)
+		}
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		grants, err := showRoleHierarchyGrants(ctx, client, node)
+		if err != nil {
+			return nil, fmt.Errorf("error showing grants to %s %s: %w", node.Type, node.Name, err)
+		}
+		for _, grant := range grants {
+			if roleType, roleName, ok := grantedRoleName(grant); ok {
+				node.GrantedRoles = append(node.GrantedRoles, roleName)
+				if _, visited := hierarchy[roleName]; !visited {
+					granted := &roleHierarchyNode{Type: roleType, Name: roleName}
+					hierarchy[roleName] = granted
+					queue = append(queue, granted)
+				}
+				continue
+			}
+			node.Privileges = append(node.Privileges, roleHierarchyPrivilege{
+				Privilege:  grant.Privilege,
+				ObjectType: grant.GrantedOn.String(),
+				ObjectName: grant.Name.Name(),
+			})
+		}
+		sort.Strings(node.GrantedRoles)
+	}
+	return hierarchy, nil
+}
+
+func ReadRoleHierarchy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	var roots []*roleHierarchyNode
+	var id string
+	switch {
+	case d.Get("account_role_name").(string) != "":
+		roleId := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("account_role_name").(string))
+		if _, err := client.Roles.ShowByID(ctx, roleId); err != nil {
+			return diag.FromErr(fmt.Errorf("error showing account role %s: %w", roleId.FullyQualifiedName(), err))
+		}
+		roots = append(roots, &roleHierarchyNode{Type: sdk.ObjectTypeRole, Name: roleId.FullyQualifiedName()})
+		id = roleId.FullyQualifiedName()
+	case d.Get("database_role_name").(string) != "":
+		roleId := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(d.Get("database_role_name").(string))
+		roots = append(roots, &roleHierarchyNode{Type: sdk.ObjectTypeDatabaseRole, Name: roleId.FullyQualifiedName()})
+		id = roleId.FullyQualifiedName()
+	default:
+		roles, err := client.Roles.Show(ctx, sdk.NewShowRoleRequest())
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error showing account roles: %w", err))
+		}
+		for _, role := range roles {
+			roots = append(roots, &roleHierarchyNode{Type: sdk.ObjectTypeRole, Name: role.ID().FullyQualifiedName()})
+		}
+		id = "role_hierarchy"
+	}
+
+	hierarchy, err := buildRoleHierarchy(ctx, client, roots)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	names := make([]string, 0, len(hierarchy))
+	for name := range hierarchy {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	roles := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		node := hierarchy[name]
+		role := map[string]any{
+			"name":            node.Name,
+			"type":            node.Type.String(),
+			"granted_roles":   node.GrantedRoles,
+			"inherited_roles": hierarchy.inheritedRoles(name),
+		}
+		if d.Get("with_effective_privileges").(bool) {
+			role["effective_privileges"] = hierarchy.effectivePrivileges(name)
+		}
+		roles = append(roles, role)
+	}
+
+	if err := d.Set("roles", roles); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	return nil
+}
@@ -0,0 +1,59 @@
+package datasources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_RoleHierarchy_basic(t *testing.T) {
+	// prefixes keep the parent role first in the sorted output
+	parentRoleName := "A_" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	childRoleName := "B_" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	parentRoleId := sdk.NewAccountObjectIdentifier(parentRoleName).FullyQualifiedName()
+	childRoleId := sdk.NewAccountObjectIdentifier(childRoleName).FullyQualifiedName()
+
+	configVariables := config.Variables{
+		"parent_role_name": config.StringVariable(parentRoleName),
+		"child_role_name":  config.StringVariable(childRoleName),
+		"database":         config.StringVariable(acc.TestDatabaseName),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_role_hierarchy.test", "roles.#", "2"),
+					resource.TestCheckResourceAttr("data.snowflake_role_hierarchy.test", "roles.0.name", parentRoleId),
+					resource.TestCheckResourceAttr("data.snowflake_role_hierarchy.test", "roles.0.type", "ROLE"),
+					resource.TestCheckResourceAttr("data.snowflake_role_hierarchy.test", "roles.0.granted_roles.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_role_hierarchy.test", "roles.0.granted_roles.0", childRoleId),
+					resource.TestCheckResourceAttr("data.snowflake_role_hierarchy.test", "roles.0.inherited_roles.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_role_hierarchy.test", "roles.0.inherited_roles.0", childRoleId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_role_hierarchy.test", "roles.0.effective_privileges.*", map[string]string{
+						"privilege":   "USAGE",
+						"object_type": "DATABASE",
+						"object_name": acc.TestDatabaseName,
+						"granted_to":  childRoleId,
+					}),
+					resource.TestCheckResourceAttr("data.snowflake_role_hierarchy.test", "roles.1.name", childRoleId),
+					resource.TestCheckResourceAttr("data.snowflake_role_hierarchy.test", "roles.1.inherited_roles.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleHierarchyInheritedRoles(t *testing.T) {
+	role := func(name string, grantedRoles ...string) *roleHierarchyNode {
+		return &roleHierarchyNode{Type: sdk.ObjectTypeRole, Name: name, GrantedRoles: grantedRoles}
+	}
+
+	testCases := []struct {
+		Name      string
+		Hierarchy roleHierarchy
+		Role      string
+		Expected  []string
+	}{
+		{
+			Name:      "no granted roles",
+			Hierarchy: roleHierarchy{`"A"`: role(`"A"`)},
+			Role:      `"A"`,
+			Expected:  []string{},
+		},
+		{
+			Name:      "unknown role",
+			Hierarchy: roleHierarchy{},
+			Role:      `"A"`,
+			Expected:  []string{},
+		},
+		{
+			Name: "chain",
+			Hierarchy: roleHierarchy{
+				`"A"`: role(`"A"`, `"B"`),
+				`"B"`: role(`"B"`, `"C"`),
+				`"C"`: role(`"C"`),
+			},
+			Role:     `"A"`,
+			Expected: []string{`"B"`, `"C"`},
+		},
+		{
+			Name: "cycle",
+			Hierarchy: roleHierarchy{
+				`"A"`: role(`"A"`, `"B"`),
+				`"B"`: role(`"B"`, `"A"`),
+			},
+			Role:     `"A"`,
+			Expected: []string{`"B"`},
+		},
+		{
+			Name: "cycle not including the role",
+			Hierarchy: roleHierarchy{
+				`"A"`: role(`"A"`, `"B"`),
+				`"B"`: role(`"B"`, `"C"`),
+				`"C"`: role(`"C"`, `"B"`),
+			},
+			Role:     `"A"`,
+			Expected: []string{`"B"`, `"C"`},
+		},
+		{
+			Name: "diamond",
+			Hierarchy: roleHierarchy{
+				`"A"`: role(`"A"`, `"B"`, `"C"`),
+				`"B"`: role(`"B"`, `"D"`),
+				`"C"`: role(`"C"`, `"D"`),
+				`"D"`: role(`"D"`),
+			},
+			Role:     `"A"`,
+			Expected: []string{`"B"`, `"C"`, `"D"`},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, tt.Hierarchy.inheritedRoles(tt.Role))
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var tagReferencesSchema = map[string]*schema.Schema{
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Fully qualified name of the object to list the tags of, e.g. `database.schema.table` or `database.schema.table.column` for a column.",
+	},
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  fmt.Sprintf("Type of the object. Allowed object types: %v.", sdk.TagAssociationAllowedObjectTypesString),
+		ValidateFunc: validation.StringInSlice(sdk.TagAssociationAllowedObjectTypesString, true),
+	},
+	"all_columns": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Lists the tags of all the columns of the table instead of the tags of the table itself. Can be used only with the table-like object types.",
+	},
+	"tag_references": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The tags set on the object, directly or inherited from its parent objects.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tag_database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag_schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag_value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"level": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The domain of the object the tag is set on, e.g. `TABLE` for a tag inherited by a column from its table.",
+				},
+				"object_database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"object_schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"object_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"domain": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"column_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"apply_method": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "How the tag was set on the object, e.g. `MANUAL`, `PROPAGATED` or `CLASSIFIED`.",
+				},
+				"inherited": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the tag is inherited from a parent object instead of being set directly on the object.",
+				},
+			},
+		},
+	},
+}
+
+func TagReferences() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadTagReferences,
+		Schema:      tagReferencesSchema,
+		Description: "Lists the tags set on an object (or on all the columns of a table), including the inherited ones. For more information, check [TAG_REFERENCES documentation](https://docs.snowflake.com/en/sql-reference/functions/tag_references).",
+	}
+}
+
+func ReadTagReferences(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	objectName := d.Get("object_name").(string)
+	objectType := sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string)))
+	objectDomain := sdk.TagReferenceObjectDomainFromObjectType(objectType)
+
+	var references []sdk.TagReference
+	var err error
+	if d.Get("all_columns").(bool) {
+		if objectDomain != sdk.TagReferenceObjectDomainTable {
+			return diag.FromErr(fmt.Errorf("all_columns can be used only with the table-like object types, got %v", objectType))
+		}
+		tableId, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(objectName).(sdk.SchemaObjectIdentifier)
+		if !ok {
+			return diag.FromErr(fmt.Errorf("expected %v to be a fully qualified table name", objectName))
+		}
+		references, err = client.TagReferences.GetForAllColumns(ctx, sdk.NewGetForAllColumnsTagReferenceRequest(tableId))
+	} else {
+		objectId := sdk.NewObjectIdentifierFromFullyQualifiedName(objectName)
+		references, err = client.TagReferences.GetForEntity(ctx, sdk.NewGetForEntityTagReferenceRequest(objectId, objectDomain))
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to retrieve tag references of %v %v, err = %w", objectType, objectName, err))
+	}
+
+	referencesList := make([]map[string]any, len(references))
+	for i, reference := range references {
+		referenceMap := map[string]any{
+			"tag_database": reference.TagDatabase,
+			"tag_schema":   reference.TagSchema,
+			"tag_name":     reference.TagName,
+			"tag_value":    reference.TagValue,
+			"level":        reference.Level,
+			"object_name":  reference.ObjectName,
+			"domain":       reference.Domain,
+			"inherited":    reference.IsInherited(),
+		}
+		if reference.ObjectDatabase != nil {
+			referenceMap["object_database"] = *reference.ObjectDatabase
+		}
+		if reference.ObjectSchema != nil {
+			referenceMap["object_schema"] = *reference.ObjectSchema
+		}
+		if reference.ColumnName != nil {
+			referenceMap["column_name"] = *reference.ColumnName
+		}
+		if reference.ApplyMethod != nil {
+			referenceMap["apply_method"] = *reference.ApplyMethod
+		}
+		referencesList[i] = referenceMap
+	}
+	if err := d.Set("tag_references", referencesList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(objectType.String(), objectName))
+	return nil
+}
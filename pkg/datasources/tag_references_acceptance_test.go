@@ -0,0 +1,48 @@
+package datasources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_TagReferences_basic(t *testing.T) {
+	tagName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: config.Variables{
+					"tag_name":   config.StringVariable(tagName),
+					"table_name": config.StringVariable(tableName),
+					"database":   config.StringVariable(acc.TestDatabaseName),
+					"schema":     config.StringVariable(acc.TestSchemaName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_tag_references.table", "tag_references.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_tag_references.table", "tag_references.0.tag_name", tagName),
+					resource.TestCheckResourceAttr("data.snowflake_tag_references.table", "tag_references.0.tag_value", "TABLE_VALUE"),
+					resource.TestCheckResourceAttr("data.snowflake_tag_references.table", "tag_references.0.level", "TABLE"),
+					resource.TestCheckResourceAttr("data.snowflake_tag_references.table", "tag_references.0.inherited", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_tag_references.columns", "tag_references.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_tag_references.columns", "tag_references.0.column_name", "column_name"),
+					resource.TestCheckResourceAttr("data.snowflake_tag_references.columns", "tag_references.0.domain", "COLUMN"),
+					resource.TestCheckResourceAttr("data.snowflake_tag_references.columns", "tag_references.0.inherited", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,15 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "api_integration_name" {
+  type = string
+}
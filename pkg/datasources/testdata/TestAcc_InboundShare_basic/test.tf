@@ -0,0 +1,4 @@
+data "snowflake_inbound_share" "test" {
+  provider_account = var.provider_account
+  name             = var.name
+}
@@ -0,0 +1,7 @@
+variable "provider_account" {
+  type = string
+}
+
+variable "name" {
+  type = string
+}
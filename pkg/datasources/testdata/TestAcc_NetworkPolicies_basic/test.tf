@@ -0,0 +1,19 @@
+resource "snowflake_network_rule" "test" {
+  name       = var.name
+  database   = var.database
+  schema     = var.schema
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.100/24"]
+}
+
+resource "snowflake_network_policy" "test" {
+  name                      = var.name
+  comment                   = "Terraform acceptance test"
+  blocked_ip_list           = ["192.168.0.101"]
+  allowed_network_rule_list = [snowflake_network_rule.test.qualified_name]
+}
+
+data "snowflake_network_policies" "test" {
+  depends_on = [snowflake_network_policy.test]
+}
@@ -0,0 +1,15 @@
+resource "snowflake_network_rule" "test" {
+  name       = var.name
+  database   = var.database
+  schema     = var.schema
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.100/24", "29.254.123.20"]
+}
+
+data "snowflake_network_rules" "test" {
+  database   = var.database
+  schema     = var.schema
+  like       = var.name
+  depends_on = [snowflake_network_rule.test]
+}
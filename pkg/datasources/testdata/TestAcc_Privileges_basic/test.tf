@@ -0,0 +1,3 @@
+data "snowflake_privileges" "test" {
+  object_type = var.object_type
+}
@@ -0,0 +1,3 @@
+variable "object_type" {
+  type = string
+}
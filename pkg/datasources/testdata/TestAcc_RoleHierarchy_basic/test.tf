@@ -0,0 +1,30 @@
+resource "snowflake_role" "parent" {
+  name = var.parent_role_name
+}
+
+resource "snowflake_role" "child" {
+  name = var.child_role_name
+}
+
+resource "snowflake_grant_account_role" "child_to_parent" {
+  role_name        = snowflake_role.child.name
+  parent_role_name = snowflake_role.parent.name
+}
+
+resource "snowflake_grant_privileges_to_account_role" "child" {
+  account_role_name = snowflake_role.child.name
+  privileges        = ["USAGE"]
+
+  on_account_object {
+    object_type = "DATABASE"
+    object_name = var.database
+  }
+}
+
+data "snowflake_role_hierarchy" "test" {
+  depends_on = [
+    snowflake_grant_account_role.child_to_parent,
+    snowflake_grant_privileges_to_account_role.child,
+  ]
+  account_role_name = snowflake_role.parent.name
+}
@@ -0,0 +1,11 @@
+variable "parent_role_name" {
+  type = string
+}
+
+variable "child_role_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
@@ -0,0 +1,43 @@
+resource "snowflake_tag" "test" {
+  name     = var.tag_name
+  database = var.database
+  schema   = var.schema
+}
+
+resource "snowflake_table" "test" {
+  name     = var.table_name
+  database = var.database
+  schema   = var.schema
+
+  column {
+    name = "column_name"
+    type = "VARIANT"
+  }
+}
+
+resource "snowflake_tag_association" "test" {
+  object_identifier {
+    database = var.database
+    schema   = var.schema
+    name     = snowflake_table.test.name
+  }
+
+  object_type = "TABLE"
+  tag_id      = snowflake_tag.test.id
+  tag_value   = "TABLE_VALUE"
+}
+
+data "snowflake_tag_references" "table" {
+  object_name = "${var.database}.${var.schema}.${snowflake_table.test.name}"
+  object_type = "TABLE"
+
+  depends_on = [snowflake_tag_association.test]
+}
+
+data "snowflake_tag_references" "columns" {
+  object_name = "${var.database}.${var.schema}.${snowflake_table.test.name}"
+  object_type = "TABLE"
+  all_columns = true
+
+  depends_on = [snowflake_tag_association.test]
+}
@@ -0,0 +1,15 @@
+variable "tag_name" {
+  type = string
+}
+
+variable "table_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
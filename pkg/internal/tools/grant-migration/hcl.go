@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteConfiguration writes the resource, import and removed blocks of the migration to the writer.
+// Resources inside modules are prefixed with a comment, because the blocks have to be moved into the module source by hand.
+func WriteConfiguration(w io.Writer, migration *Migration) error {
+	var b strings.Builder
+	for _, warning := range migration.Warnings {
+		fmt.Fprintf(&b, "# WARNING: %s\n", warning)
+	}
+	if len(migration.Warnings) > 0 {
+		b.WriteString("\n")
+	}
+
+	for _, resource := range migration.Resources {
+		if resource.Module != "" {
+			fmt.Fprintf(&b, "# module: %s\n", resource.Module)
+		}
+		fmt.Fprintf(&b, "# migrated from: %s\n", strings.Join(resource.Sources, ", "))
+		fmt.Fprintf(&b, "resource %q %q {\n", resource.Type, resource.Name)
+		for _, line := range resource.Body {
+			fmt.Fprintf(&b, "  %s\n", line)
+		}
+		b.WriteString("}\n\n")
+
+		b.WriteString("import {\n")
+		fmt.Fprintf(&b, "  to = %s\n", address(resource.Module, resource.Type, resource.Name))
+		fmt.Fprintf(&b, "  id = %s\n", quote(resource.ImportId))
+		b.WriteString("}\n\n")
+	}
+
+	for _, removed := range migration.Removed {
+		b.WriteString("removed {\n")
+		fmt.Fprintf(&b, "  from = %s\n", removed)
+		b.WriteString("  lifecycle {\n")
+		b.WriteString("    destroy = false\n")
+		b.WriteString("  }\n")
+		b.WriteString("}\n\n")
+	}
+
+	_, err := io.WriteString(w, strings.TrimSuffix(b.String(), "\n"))
+	return err
+}
+
+func address(module string, resourceType string, name string) string {
+	if module == "" {
+		return fmt.Sprintf("%s.%s", resourceType, name)
+	}
+	return fmt.Sprintf("%s.%s.%s", module, resourceType, name)
+}
+
+func attribute(name string, value string) string {
+	return fmt.Sprintf("%s = %s", name, quote(value))
+}
+
+func listAttribute(name string, values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = quote(value)
+	}
+	return fmt.Sprintf("%s = [%s]", name, strings.Join(quoted, ", "))
+}
+
+func block(name string, body []string) []string {
+	lines := []string{name + " {"}
+	for _, line := range body {
+		lines = append(lines, "  "+line)
+	}
+	return append(lines, "}")
+}
+
+// quote returns the value as an HCL string literal; template sequences are escaped, so they are not interpolated.
+func quote(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"${", "$${",
+		"%{", "%%{",
+	)
+	return `"` + replacer.Replace(value) + `"`
+}
@@ -0,0 +1,48 @@
+// Command grant-migration reads a Terraform state file and prints the configuration replacing the deprecated grant resources
+// (snowflake_*_grant, snowflake_role_grants, snowflake_role_ownership_grant, snowflake_user_ownership_grant and
+// snowflake_grant_privileges_to_role) with snowflake_grant_privileges_to_account_role, snowflake_grant_ownership and
+// snowflake_grant_account_role. Every new resource is accompanied by an import block and every legacy resource by
+// a removed block, so that the migration can be applied without revoking and granting the privileges again.
+//
+// Usage:
+//
+//	go run ./pkg/internal/tools/grant-migration/ [path to the state file, "-" for stdin; default: terraform.tfstate] > grants.tf
+package main
+
+import (
+	"io"
+	"log"
+	"os"
+)
+
+func main() {
+	path := "terraform.tfstate"
+	if len(os.Args) > 1 {
+		path = os.Args[1]
+	}
+
+	var reader io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	state, err := ReadState(reader)
+	if err != nil {
+		log.Fatal(err)
+	}
+	migration, err := Migrate(state)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, warning := range migration.Warnings {
+		log.Println("WARNING:", warning)
+	}
+	if err := WriteConfiguration(os.Stdout, migration); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,528 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type grantLevel int
+
+const (
+	accountLevel grantLevel = iota
+	accountObjectLevel
+	schemaLevel
+	schemaObjectLevel
+)
+
+// legacyGrant describes a deprecated *_grant resource granting a single privilege on a single kind of object.
+type legacyGrant struct {
+	level         grantLevel
+	objectType    sdk.ObjectType
+	nameAttribute string
+	withArguments bool
+}
+
+var legacyGrants = map[string]legacyGrant{
+	"snowflake_account_grant":           {level: accountLevel},
+	"snowflake_database_grant":          {level: accountObjectLevel, objectType: sdk.ObjectTypeDatabase, nameAttribute: "database_name"},
+	"snowflake_failover_group_grant":    {level: accountObjectLevel, objectType: sdk.ObjectTypeFailoverGroup, nameAttribute: "failover_group_name"},
+	"snowflake_integration_grant":       {level: accountObjectLevel, objectType: sdk.ObjectTypeIntegration, nameAttribute: "integration_name"},
+	"snowflake_resource_monitor_grant":  {level: accountObjectLevel, objectType: sdk.ObjectTypeResourceMonitor, nameAttribute: "monitor_name"},
+	"snowflake_user_grant":              {level: accountObjectLevel, objectType: sdk.ObjectTypeUser, nameAttribute: "user_name"},
+	"snowflake_warehouse_grant":         {level: accountObjectLevel, objectType: sdk.ObjectTypeWarehouse, nameAttribute: "warehouse_name"},
+	"snowflake_schema_grant":            {level: schemaLevel, objectType: sdk.ObjectTypeSchema},
+	"snowflake_external_table_grant":    {level: schemaObjectLevel, objectType: sdk.ObjectTypeExternalTable, nameAttribute: "external_table_name"},
+	"snowflake_file_format_grant":       {level: schemaObjectLevel, objectType: sdk.ObjectTypeFileFormat, nameAttribute: "file_format_name"},
+	"snowflake_function_grant":          {level: schemaObjectLevel, objectType: sdk.ObjectTypeFunction, nameAttribute: "function_name", withArguments: true},
+	"snowflake_masking_policy_grant":    {level: schemaObjectLevel, objectType: sdk.ObjectTypeMaskingPolicy, nameAttribute: "masking_policy_name"},
+	"snowflake_materialized_view_grant": {level: schemaObjectLevel, objectType: sdk.ObjectTypeMaterializedView, nameAttribute: "materialized_view_name"},
+	"snowflake_pipe_grant":              {level: schemaObjectLevel, objectType: sdk.ObjectTypePipe, nameAttribute: "pipe_name"},
+	"snowflake_procedure_grant":         {level: schemaObjectLevel, objectType: sdk.ObjectTypeProcedure, nameAttribute: "procedure_name", withArguments: true},
+	"snowflake_row_access_policy_grant": {level: schemaObjectLevel, objectType: sdk.ObjectTypeRowAccessPolicy, nameAttribute: "row_access_policy_name"},
+	"snowflake_sequence_grant":          {level: schemaObjectLevel, objectType: sdk.ObjectTypeSequence, nameAttribute: "sequence_name"},
+	"snowflake_stage_grant":             {level: schemaObjectLevel, objectType: sdk.ObjectTypeStage, nameAttribute: "stage_name"},
+	"snowflake_stream_grant":            {level: schemaObjectLevel, objectType: sdk.ObjectTypeStream, nameAttribute: "stream_name"},
+	"snowflake_table_grant":             {level: schemaObjectLevel, objectType: sdk.ObjectTypeTable, nameAttribute: "table_name"},
+	"snowflake_tag_grant":               {level: schemaObjectLevel, objectType: sdk.ObjectTypeTag, nameAttribute: "tag_name"},
+	"snowflake_task_grant":              {level: schemaObjectLevel, objectType: sdk.ObjectTypeTask, nameAttribute: "task_name"},
+	"snowflake_view_grant":              {level: schemaObjectLevel, objectType: sdk.ObjectTypeView, nameAttribute: "view_name"},
+}
+
+const (
+	grantPrivilegesToAccountRoleResource = "snowflake_grant_privileges_to_account_role"
+	grantOwnershipResource               = "snowflake_grant_ownership"
+	grantAccountRoleResource             = "snowflake_grant_account_role"
+)
+
+// MigratedResource is a new-style grant resource with the import ID matching the state of the legacy resources it replaces.
+type MigratedResource struct {
+	Module   string
+	Type     string
+	Name     string
+	ImportId string
+	Body     []string
+	Sources  []string
+}
+
+// Migration is the outcome of migrating the grants from a state file.
+type Migration struct {
+	Resources []MigratedResource
+	// Removed contains the addresses of the legacy resources which should be removed from the state without revoking the privileges.
+	Removed []string
+	// Warnings describe the legacy grants which could not be migrated, e.g. grants to shares.
+	Warnings []string
+}
+
+type privilegesGrant struct {
+	module  string
+	sources []string
+	id      resources.GrantPrivilegesToAccountRoleId
+}
+
+// Migrate converts the deprecated grant resources in the state to the new grant resources. Privileges granted to the same role
+// on the same object with the same grant option are merged into a single snowflake_grant_privileges_to_account_role resource.
+func Migrate(state *State) (*Migration, error) {
+	migration := &Migration{}
+	privilegesGrants := make(map[string]*privilegesGrant)
+	var privilegesGrantKeys []string
+	addPrivilegesGrant := func(module string, source string, id resources.GrantPrivilegesToAccountRoleId) {
+		privileges, allPrivileges := id.Privileges, id.AllPrivileges
+		id.Privileges, id.AllPrivileges = nil, false
+		key := module + "|" + id.String()
+		if grant, ok := privilegesGrants[key]; ok {
+			grant.sources = appendUnique(grant.sources, source)
+			grant.id.AllPrivileges = grant.id.AllPrivileges || allPrivileges
+			for _, privilege := range privileges {
+				grant.id.Privileges = appendUnique(grant.id.Privileges, privilege)
+			}
+			return
+		}
+		id.Privileges, id.AllPrivileges = privileges, allPrivileges
+		privilegesGrants[key] = &privilegesGrant{module: module, sources: []string{source}, id: id}
+		privilegesGrantKeys = append(privilegesGrantKeys, key)
+	}
+
+	for _, resource := range state.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+		_, isLegacyGrant := legacyGrants[resource.Type]
+		switch {
+		case isLegacyGrant, resource.Type == "snowflake_grant_privileges_to_role", resource.Type == "snowflake_role_grants",
+			resource.Type == "snowflake_role_ownership_grant", resource.Type == "snowflake_user_ownership_grant":
+		default:
+			continue
+		}
+
+		for _, instance := range resource.Instances {
+			source := resource.InstanceAddress(instance)
+			attributes := instance.Attributes
+			switch resource.Type {
+			case "snowflake_grant_privileges_to_role":
+				id, err := migrateGrantPrivilegesToRole(attributes)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", source, err)
+				}
+				addPrivilegesGrant(resource.Module, source, id)
+			case "snowflake_role_grants":
+				migration.Resources = append(migration.Resources, migrateRoleGrants(resource.Module, source, attributes)...)
+			case "snowflake_role_ownership_grant":
+				migration.Resources = append(migration.Resources, migrateOwnershipGrant(resource.Module, source, sdk.ObjectTypeRole, stringAttribute(attributes, "on_role_name"), attributes))
+			case "snowflake_user_ownership_grant":
+				migration.Resources = append(migration.Resources, migrateOwnershipGrant(resource.Module, source, sdk.ObjectTypeUser, stringAttribute(attributes, "on_user_name"), attributes))
+			default:
+				if shares := stringListAttribute(attributes, "shares"); len(shares) > 0 {
+					migration.Warnings = append(migration.Warnings, fmt.Sprintf("%s: the grants to shares %s are not migrated, use snowflake_grant_privileges_to_share instead", source, strings.Join(shares, ", ")))
+				}
+				on, err := legacyGrantData(legacyGrants[resource.Type], attributes)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", source, err)
+				}
+				privilege := stringAttribute(attributes, "privilege")
+				for _, role := range stringListAttribute(attributes, "roles") {
+					if privilege == "OWNERSHIP" {
+						migration.Resources = append(migration.Resources, ownershipResource(resource.Module, source, role, stringAttribute(attributes, "current_grants"), on))
+						continue
+					}
+					id := resources.GrantPrivilegesToAccountRoleId{
+						RoleName:        sdk.NewAccountObjectIdentifier(role),
+						WithGrantOption: boolAttribute(attributes, "with_grant_option"),
+						Kind:            on.kind,
+						Data:            on.data,
+					}
+					if privilege == "ALL" || privilege == "ALL PRIVILEGES" {
+						id.AllPrivileges = true
+					} else {
+						id.Privileges = []string{privilege}
+					}
+					addPrivilegesGrant(resource.Module, source, id)
+				}
+			}
+		}
+		migration.Removed = append(migration.Removed, resource.Address())
+	}
+
+	for _, key := range privilegesGrantKeys {
+		migration.Resources = append(migration.Resources, privilegesResource(privilegesGrants[key]))
+	}
+	assignNames(migration.Resources)
+	return migration, nil
+}
+
+type grantData struct {
+	kind resources.AccountRoleGrantKind
+	data fmt.Stringer
+}
+
+func legacyGrantData(grant legacyGrant, attributes map[string]any) (grantData, error) {
+	databaseName := stringAttribute(attributes, "database_name")
+	schemaName := stringAttribute(attributes, "schema_name")
+	onAll, onFuture := boolAttribute(attributes, "on_all"), boolAttribute(attributes, "on_future")
+
+	switch grant.level {
+	case accountLevel:
+		return grantData{kind: resources.OnAccountAccountRoleGrantKind, data: new(resources.OnAccountGrantData)}, nil
+	case accountObjectLevel:
+		return grantData{kind: resources.OnAccountObjectAccountRoleGrantKind, data: &resources.OnAccountObjectGrantData{
+			ObjectType: grant.objectType,
+			ObjectName: sdk.NewAccountObjectIdentifier(stringAttribute(attributes, grant.nameAttribute)),
+		}}, nil
+	case schemaLevel:
+		onSchema := &resources.OnSchemaGrantData{}
+		switch {
+		case onAll:
+			onSchema.Kind = resources.OnAllSchemasInDatabaseSchemaGrantKind
+			onSchema.DatabaseName = sdk.Pointer(sdk.NewAccountObjectIdentifier(databaseName))
+		case onFuture:
+			onSchema.Kind = resources.OnFutureSchemasInDatabaseSchemaGrantKind
+			onSchema.DatabaseName = sdk.Pointer(sdk.NewAccountObjectIdentifier(databaseName))
+		default:
+			onSchema.Kind = resources.OnSchemaSchemaGrantKind
+			onSchema.SchemaName = sdk.Pointer(sdk.NewDatabaseObjectIdentifier(databaseName, schemaName))
+		}
+		return grantData{kind: resources.OnSchemaAccountRoleGrantKind, data: onSchema}, nil
+	case schemaObjectLevel:
+		onSchemaObject := &resources.OnSchemaObjectGrantData{}
+		if onAll || onFuture {
+			bulk := &resources.BulkOperationGrantData{ObjectNamePlural: grant.objectType.Plural()}
+			if schemaName == "" {
+				bulk.Kind = resources.InDatabaseBulkOperationGrantKind
+				bulk.Database = sdk.Pointer(sdk.NewAccountObjectIdentifier(databaseName))
+			} else {
+				bulk.Kind = resources.InSchemaBulkOperationGrantKind
+				bulk.Schema = sdk.Pointer(sdk.NewDatabaseObjectIdentifier(databaseName, schemaName))
+			}
+			onSchemaObject.Kind = resources.OnFutureSchemaObjectGrantKind
+			if onAll {
+				onSchemaObject.Kind = resources.OnAllSchemaObjectGrantKind
+			}
+			onSchemaObject.OnAllOrFuture = bulk
+		} else {
+			name := stringAttribute(attributes, grant.nameAttribute)
+			var objectName sdk.ObjectIdentifier = sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+			if grant.withArguments {
+				arguments := make([]sdk.DataType, 0)
+				for _, argument := range stringListAttribute(attributes, "argument_data_types") {
+					arguments = append(arguments, sdk.DataType(strings.ToUpper(argument)))
+				}
+				objectName = sdk.NewSchemaObjectIdentifierWithArguments(databaseName, schemaName, name, arguments)
+			}
+			onSchemaObject.Kind = resources.OnObjectSchemaObjectGrantKind
+			onSchemaObject.Object = &sdk.Object{ObjectType: grant.objectType, Name: objectName}
+		}
+		return grantData{kind: resources.OnSchemaObjectAccountRoleGrantKind, data: onSchemaObject}, nil
+	}
+	return grantData{}, fmt.Errorf("unsupported grant level %d", grant.level)
+}
+
+func migrateGrantPrivilegesToRole(attributes map[string]any) (resources.GrantPrivilegesToAccountRoleId, error) {
+	id := resources.GrantPrivilegesToAccountRoleId{
+		RoleName:        sdk.NewAccountObjectIdentifier(stringAttribute(attributes, "role_name")),
+		WithGrantOption: boolAttribute(attributes, "with_grant_option"),
+		AllPrivileges:   boolAttribute(attributes, "all_privileges"),
+		Privileges:      stringListAttribute(attributes, "privileges"),
+	}
+
+	switch {
+	case boolAttribute(attributes, "on_account"):
+		id.Kind = resources.OnAccountAccountRoleGrantKind
+		id.Data = new(resources.OnAccountGrantData)
+	case blockAttribute(attributes, "on_account_object") != nil:
+		block := blockAttribute(attributes, "on_account_object")
+		id.Kind = resources.OnAccountObjectAccountRoleGrantKind
+		id.Data = &resources.OnAccountObjectGrantData{
+			ObjectType: sdk.ObjectType(stringAttribute(block, "object_type")),
+			ObjectName: sdk.NewAccountObjectIdentifierFromFullyQualifiedName(stringAttribute(block, "object_name")),
+		}
+	case blockAttribute(attributes, "on_schema") != nil:
+		block := blockAttribute(attributes, "on_schema")
+		onSchema := &resources.OnSchemaGrantData{}
+		switch {
+		case stringAttribute(block, "schema_name") != "":
+			onSchema.Kind = resources.OnSchemaSchemaGrantKind
+			onSchema.SchemaName = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(stringAttribute(block, "schema_name")))
+		case stringAttribute(block, "all_schemas_in_database") != "":
+			onSchema.Kind = resources.OnAllSchemasInDatabaseSchemaGrantKind
+			onSchema.DatabaseName = sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(stringAttribute(block, "all_schemas_in_database")))
+		default:
+			onSchema.Kind = resources.OnFutureSchemasInDatabaseSchemaGrantKind
+			onSchema.DatabaseName = sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(stringAttribute(block, "future_schemas_in_database")))
+		}
+		id.Kind = resources.OnSchemaAccountRoleGrantKind
+		id.Data = onSchema
+	case blockAttribute(attributes, "on_schema_object") != nil:
+		block := blockAttribute(attributes, "on_schema_object")
+		onSchemaObject := &resources.OnSchemaObjectGrantData{}
+		switch {
+		case stringAttribute(block, "object_type") != "":
+			objectType := sdk.ObjectType(stringAttribute(block, "object_type"))
+			onSchemaObject.Kind = resources.OnObjectSchemaObjectGrantKind
+			onSchemaObject.Object = &sdk.Object{
+				ObjectType: objectType,
+				Name:       sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(stringAttribute(block, "object_name")),
+			}
+		case blockAttribute(block, "all") != nil:
+			onSchemaObject.Kind = resources.OnAllSchemaObjectGrantKind
+			onSchemaObject.OnAllOrFuture = bulkOperationGrantData(blockAttribute(block, "all"))
+		default:
+			onSchemaObject.Kind = resources.OnFutureSchemaObjectGrantKind
+			onSchemaObject.OnAllOrFuture = bulkOperationGrantData(blockAttribute(block, "future"))
+		}
+		id.Kind = resources.OnSchemaObjectAccountRoleGrantKind
+		id.Data = onSchemaObject
+	default:
+		return id, fmt.Errorf("could not determine the object the privileges are granted on")
+	}
+	return id, nil
+}
+
+func bulkOperationGrantData(block map[string]any) *resources.BulkOperationGrantData {
+	bulk := &resources.BulkOperationGrantData{ObjectNamePlural: sdk.PluralObjectType(stringAttribute(block, "object_type_plural"))}
+	if inSchema := stringAttribute(block, "in_schema"); inSchema != "" {
+		bulk.Kind = resources.InSchemaBulkOperationGrantKind
+		bulk.Schema = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(inSchema))
+	} else {
+		bulk.Kind = resources.InDatabaseBulkOperationGrantKind
+		bulk.Database = sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(stringAttribute(block, "in_database")))
+	}
+	return bulk
+}
+
+func migrateRoleGrants(module string, source string, attributes map[string]any) []MigratedResource {
+	role := sdk.NewAccountObjectIdentifier(stringAttribute(attributes, "role_name"))
+	var migrated []MigratedResource
+	for _, grantee := range []struct {
+		objectType sdk.ObjectType
+		attribute  string
+		names      []string
+	}{
+		{objectType: sdk.ObjectTypeRole, attribute: "parent_role_name", names: stringListAttribute(attributes, "roles")},
+		{objectType: sdk.ObjectTypeUser, attribute: "user_name", names: stringListAttribute(attributes, "users")},
+	} {
+		for _, name := range grantee.names {
+			granteeId := sdk.NewAccountObjectIdentifier(name)
+			migrated = append(migrated, MigratedResource{
+				Module:   module,
+				Type:     grantAccountRoleResource,
+				Name:     role.Name() + "_" + name,
+				ImportId: strings.Join([]string{role.FullyQualifiedName(), grantee.objectType.String(), granteeId.FullyQualifiedName()}, "|"),
+				Body: []string{
+					attribute("role_name", role.FullyQualifiedName()),
+					attribute(grantee.attribute, granteeId.FullyQualifiedName()),
+				},
+				Sources: []string{source},
+			})
+		}
+	}
+	return migrated
+}
+
+func migrateOwnershipGrant(module string, source string, objectType sdk.ObjectType, name string, attributes map[string]any) MigratedResource {
+	return ownershipResource(module, source, stringAttribute(attributes, "to_role_name"), stringAttribute(attributes, "current_grants"), grantData{
+		kind: resources.OnAccountObjectAccountRoleGrantKind,
+		data: &resources.OnAccountObjectGrantData{ObjectType: objectType, ObjectName: sdk.NewAccountObjectIdentifier(name)},
+	})
+}
+
+func ownershipResource(module string, source string, role string, currentGrants string, on grantData) MigratedResource {
+	id := resources.GrantOwnershipId{
+		GrantOwnershipTargetRoleKind: resources.ToAccountGrantOwnershipTargetRoleKind,
+		AccountRoleName:              sdk.NewAccountObjectIdentifier(role),
+	}
+	body := []string{attribute("account_role_name", id.AccountRoleName.FullyQualifiedName())}
+	if behavior := resources.OutboundPrivilegesBehavior(strings.ToUpper(currentGrants)); behavior == resources.CopyOutboundPrivilegesBehavior || behavior == resources.RevokeOutboundPrivilegesBehavior {
+		id.OutboundPrivilegesBehavior = sdk.Pointer(behavior)
+		body = append(body, attribute("outbound_privileges", string(behavior)))
+	}
+
+	var onBody []string
+	switch data := on.data.(type) {
+	case *resources.OnAccountObjectGrantData:
+		id.Kind = resources.OnObjectGrantOwnershipKind
+		id.Data = &resources.OnObjectGrantOwnershipData{ObjectType: data.ObjectType, ObjectName: data.ObjectName}
+		onBody = []string{attribute("object_type", data.ObjectType.String()), attribute("object_name", data.ObjectName.FullyQualifiedName())}
+	case *resources.OnSchemaGrantData:
+		switch data.Kind {
+		case resources.OnSchemaSchemaGrantKind:
+			id.Kind = resources.OnObjectGrantOwnershipKind
+			id.Data = &resources.OnObjectGrantOwnershipData{ObjectType: sdk.ObjectTypeSchema, ObjectName: *data.SchemaName}
+			onBody = []string{attribute("object_type", sdk.ObjectTypeSchema.String()), attribute("object_name", data.SchemaName.FullyQualifiedName())}
+		default:
+			bulk := &resources.BulkOperationGrantData{ObjectNamePlural: sdk.PluralObjectTypeSchemas, Kind: resources.InDatabaseBulkOperationGrantKind, Database: data.DatabaseName}
+			id.Kind, id.Data = resources.OnFutureGrantOwnershipKind, bulk
+			blockName := "future"
+			if data.Kind == resources.OnAllSchemasInDatabaseSchemaGrantKind {
+				id.Kind, blockName = resources.OnAllGrantOwnershipKind, "all"
+			}
+			onBody = block(blockName, bulkOperationBody(bulk))
+		}
+	case *resources.OnSchemaObjectGrantData:
+		switch data.Kind {
+		case resources.OnObjectSchemaObjectGrantKind:
+			id.Kind = resources.OnObjectGrantOwnershipKind
+			id.Data = &resources.OnObjectGrantOwnershipData{ObjectType: data.Object.ObjectType, ObjectName: data.Object.Name}
+			onBody = []string{attribute("object_type", data.Object.ObjectType.String()), attribute("object_name", data.Object.Name.FullyQualifiedName())}
+		default:
+			id.Kind, id.Data = resources.OnFutureGrantOwnershipKind, data.OnAllOrFuture
+			blockName := "future"
+			if data.Kind == resources.OnAllSchemaObjectGrantKind {
+				id.Kind, blockName = resources.OnAllGrantOwnershipKind, "all"
+			}
+			onBody = block(blockName, bulkOperationBody(data.OnAllOrFuture))
+		}
+	}
+	body = append(body, block("on", onBody)...)
+
+	return MigratedResource{
+		Module:   module,
+		Type:     grantOwnershipResource,
+		Name:     role,
+		ImportId: id.String(),
+		Body:     body,
+		Sources:  []string{source},
+	}
+}
+
+func privilegesResource(grant *privilegesGrant) MigratedResource {
+	id := grant.id
+	if id.AllPrivileges {
+		id.Privileges = nil
+	}
+	sort.Strings(id.Privileges)
+
+	body := []string{attribute("account_role_name", id.RoleName.FullyQualifiedName())}
+	if id.AllPrivileges {
+		body = append(body, "all_privileges = true")
+	} else {
+		body = append(body, listAttribute("privileges", id.Privileges))
+	}
+	if id.WithGrantOption {
+		body = append(body, "with_grant_option = true")
+	}
+
+	var objectName string
+	switch data := id.Data.(type) {
+	case *resources.OnAccountGrantData:
+		body = append(body, "on_account = true")
+	case *resources.OnAccountObjectGrantData:
+		objectName = data.ObjectName.Name()
+		body = append(body, block("on_account_object", []string{
+			attribute("object_type", data.ObjectType.String()),
+			attribute("object_name", data.ObjectName.FullyQualifiedName()),
+		})...)
+	case *resources.OnSchemaGrantData:
+		switch data.Kind {
+		case resources.OnSchemaSchemaGrantKind:
+			objectName = data.SchemaName.Name()
+			body = append(body, block("on_schema", []string{attribute("schema_name", data.SchemaName.FullyQualifiedName())})...)
+		case resources.OnAllSchemasInDatabaseSchemaGrantKind:
+			objectName = "all_schemas_" + data.DatabaseName.Name()
+			body = append(body, block("on_schema", []string{attribute("all_schemas_in_database", data.DatabaseName.FullyQualifiedName())})...)
+		case resources.OnFutureSchemasInDatabaseSchemaGrantKind:
+			objectName = "future_schemas_" + data.DatabaseName.Name()
+			body = append(body, block("on_schema", []string{attribute("future_schemas_in_database", data.DatabaseName.FullyQualifiedName())})...)
+		}
+	case *resources.OnSchemaObjectGrantData:
+		switch data.Kind {
+		case resources.OnObjectSchemaObjectGrantKind:
+			objectName = data.Object.Name.Name()
+			body = append(body, block("on_schema_object", []string{
+				attribute("object_type", data.Object.ObjectType.String()),
+				attribute("object_name", data.Object.Name.FullyQualifiedName()),
+			})...)
+		case resources.OnAllSchemaObjectGrantKind:
+			objectName = "all_" + data.OnAllOrFuture.ObjectNamePlural.String()
+			body = append(body, block("on_schema_object", block("all", bulkOperationBody(data.OnAllOrFuture)))...)
+		case resources.OnFutureSchemaObjectGrantKind:
+			objectName = "future_" + data.OnAllOrFuture.ObjectNamePlural.String()
+			body = append(body, block("on_schema_object", block("future", bulkOperationBody(data.OnAllOrFuture)))...)
+		}
+	}
+
+	name := id.RoleName.Name()
+	if objectName != "" {
+		name += "_" + objectName
+	}
+	return MigratedResource{
+		Module:   grant.module,
+		Type:     grantPrivilegesToAccountRoleResource,
+		Name:     name,
+		ImportId: id.String(),
+		Body:     body,
+		Sources:  grant.sources,
+	}
+}
+
+func bulkOperationBody(bulk *resources.BulkOperationGrantData) []string {
+	body := []string{attribute("object_type_plural", bulk.ObjectNamePlural.String())}
+	switch bulk.Kind {
+	case resources.InDatabaseBulkOperationGrantKind:
+		body = append(body, attribute("in_database", bulk.Database.FullyQualifiedName()))
+	case resources.InSchemaBulkOperationGrantKind:
+		body = append(body, attribute("in_schema", bulk.Schema.FullyQualifiedName()))
+	}
+	return body
+}
+
+// assignNames turns the proposed names into valid, unique resource names within each module.
+func assignNames(migrated []MigratedResource) {
+	used := make(map[string]int)
+	for i := range migrated {
+		name := resourceName(migrated[i].Name)
+		key := migrated[i].Module + "|" + migrated[i].Type + "|" + name
+		used[key]++
+		if used[key] > 1 {
+			name = fmt.Sprintf("%s_%d", name, used[key])
+		}
+		migrated[i].Name = name
+	}
+}
+
+func resourceName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-':
+			b.WriteRune(r)
+		case !strings.HasSuffix(b.String(), "_"):
+			b.WriteRune('_')
+		}
+	}
+	result := strings.Trim(b.String(), "_")
+	if result == "" || (result[0] >= '0' && result[0] <= '9') || result[0] == '-' {
+		result = "grant_" + result
+	}
+	return result
+}
+
+func appendUnique(values []string, value string) []string {
+	if slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "snowflake_database_grant",
+      "name": "usage",
+      "instances": [{"attributes": {"database_name": "DB", "privilege": "USAGE", "roles": ["ANALYST", "LOADER"], "shares": ["SHARE"], "with_grant_option": false}}]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_database_grant",
+      "name": "monitor",
+      "instances": [{"attributes": {"database_name": "DB", "privilege": "MONITOR", "roles": ["ANALYST"], "with_grant_option": false}}]
+    },
+    {
+      "module": "module.grants",
+      "mode": "managed",
+      "type": "snowflake_table_grant",
+      "name": "select",
+      "instances": [
+        {"index_key": "future", "attributes": {"database_name": "DB", "schema_name": "PUBLIC", "privilege": "SELECT", "roles": ["ANALYST"], "on_future": true}},
+        {"index_key": "all", "attributes": {"database_name": "DB", "schema_name": "", "privilege": "SELECT", "roles": ["ANALYST"], "on_all": true}}
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_function_grant",
+      "name": "usage",
+      "instances": [{"attributes": {"database_name": "DB", "schema_name": "PUBLIC", "function_name": "ADD", "argument_data_types": ["number", "number"], "privilege": "USAGE", "roles": ["ANALYST"]}}]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_schema_grant",
+      "name": "ownership",
+      "instances": [{"attributes": {"database_name": "DB", "schema_name": "PUBLIC", "privilege": "OWNERSHIP", "roles": ["ADMIN"]}}]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_role_grants",
+      "name": "analyst",
+      "instances": [{"attributes": {"role_name": "ANALYST", "roles": ["SYSADMIN"], "users": ["JOHN"]}}]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_role_ownership_grant",
+      "name": "analyst",
+      "instances": [{"attributes": {"on_role_name": "ANALYST", "to_role_name": "SECURITYADMIN", "current_grants": "COPY"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_grant_privileges_to_role",
+      "name": "warehouse",
+      "instances": [{"attributes": {"role_name": "LOADER", "privileges": ["OPERATE", "USAGE"], "all_privileges": false, "on_account": false, "on_account_object": [{"object_type": "WAREHOUSE", "object_name": "\"WH\""}], "on_schema": [], "on_schema_object": []}}]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_database",
+      "name": "db",
+      "instances": [{"attributes": {"name": "DB"}}]
+    }
+  ]
+}`
+
+func Test_Migrate(t *testing.T) {
+	state, err := ReadState(strings.NewReader(testState))
+	require.NoError(t, err)
+
+	migration, err := Migrate(state)
+	require.NoError(t, err)
+
+	importIds := make(map[string]string)
+	for _, resource := range migration.Resources {
+		importIds[address(resource.Module, resource.Type, resource.Name)] = resource.ImportId
+	}
+	assert.Equal(t, map[string]string{
+		"snowflake_grant_privileges_to_account_role.analyst_db":                          `"ANALYST"|false|false|MONITOR,USAGE|OnAccountObject|DATABASE|"DB"`,
+		"snowflake_grant_privileges_to_account_role.loader_db":                           `"LOADER"|false|false|USAGE|OnAccountObject|DATABASE|"DB"`,
+		"module.grants.snowflake_grant_privileges_to_account_role.analyst_future_tables": `"ANALYST"|false|false|SELECT|OnSchemaObject|OnFuture|TABLES|InSchema|"DB"."PUBLIC"`,
+		"module.grants.snowflake_grant_privileges_to_account_role.analyst_all_tables":    `"ANALYST"|false|false|SELECT|OnSchemaObject|OnAll|TABLES|InDatabase|"DB"`,
+		"snowflake_grant_privileges_to_account_role.analyst_add":                         `"ANALYST"|false|false|USAGE|OnSchemaObject|OnObject|FUNCTION|"DB"."PUBLIC"."ADD"(NUMBER, NUMBER)`,
+		"snowflake_grant_privileges_to_account_role.loader_wh":                           `"LOADER"|false|false|OPERATE,USAGE|OnAccountObject|WAREHOUSE|"WH"`,
+		"snowflake_grant_ownership.admin":                                                `ToAccountRole|"ADMIN"||OnObject|SCHEMA|"DB"."PUBLIC"`,
+		"snowflake_grant_ownership.securityadmin":                                        `ToAccountRole|"SECURITYADMIN"|COPY|OnObject|ROLE|"ANALYST"`,
+		"snowflake_grant_account_role.analyst_sysadmin":                                  `"ANALYST"|ROLE|"SYSADMIN"`,
+		"snowflake_grant_account_role.analyst_john":                                      `"ANALYST"|USER|"JOHN"`,
+	}, importIds)
+
+	assert.Equal(t, []string{
+		"snowflake_database_grant.usage",
+		"snowflake_database_grant.monitor",
+		"module.grants.snowflake_table_grant.select",
+		"snowflake_function_grant.usage",
+		"snowflake_schema_grant.ownership",
+		"snowflake_role_grants.analyst",
+		"snowflake_role_ownership_grant.analyst",
+		"snowflake_grant_privileges_to_role.warehouse",
+	}, migration.Removed)
+
+	require.Len(t, migration.Warnings, 1)
+	assert.Contains(t, migration.Warnings[0], "snowflake_database_grant.usage")
+	assert.Contains(t, migration.Warnings[0], "SHARE")
+}
+
+func Test_WriteConfiguration(t *testing.T) {
+	migration := &Migration{
+		Resources: []MigratedResource{
+			{
+				Type:     grantPrivilegesToAccountRoleResource,
+				Name:     "analyst_db",
+				ImportId: `"ANALYST"|false|false|USAGE|OnAccountObject|DATABASE|"DB"`,
+				Body: append([]string{
+					attribute("account_role_name", `"ANALYST"`),
+					listAttribute("privileges", []string{"USAGE"}),
+				}, block("on_account_object", []string{
+					attribute("object_type", "DATABASE"),
+					attribute("object_name", `"DB"`),
+				})...),
+				Sources: []string{"snowflake_database_grant.usage"},
+			},
+		},
+		Removed: []string{"snowflake_database_grant.usage"},
+	}
+
+	var b strings.Builder
+	require.NoError(t, WriteConfiguration(&b, migration))
+	assert.Equal(t, `# migrated from: snowflake_database_grant.usage
+resource "snowflake_grant_privileges_to_account_role" "analyst_db" {
+  account_role_name = "\"ANALYST\""
+  privileges = ["USAGE"]
+  on_account_object {
+    object_type = "DATABASE"
+    object_name = "\"DB\""
+  }
+}
+
+import {
+  to = snowflake_grant_privileges_to_account_role.analyst_db
+  id = "\"ANALYST\"|false|false|USAGE|OnAccountObject|DATABASE|\"DB\""
+}
+
+removed {
+  from = snowflake_database_grant.usage
+  lifecycle {
+    destroy = false
+  }
+}
+`, b.String())
+}
+
+func Test_quote(t *testing.T) {
+	assert.Equal(t, `"a\"b\\c$${d}%%{e}"`, quote(`a"b\c${d}%{e}`))
+}
+
+func Test_resourceName(t *testing.T) {
+	assert.Equal(t, "analyst_my_db", resourceName(`ANALYST_"my.db"`))
+	assert.Equal(t, "grant_1role", resourceName("1role"))
+}
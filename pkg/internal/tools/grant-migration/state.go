@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// State is the subset of the Terraform state file (format version 4) needed to migrate the grants.
+type State struct {
+	Version   int             `json:"version"`
+	Resources []StateResource `json:"resources"`
+}
+
+type StateResource struct {
+	Module    string          `json:"module,omitempty"`
+	Mode      string          `json:"mode"`
+	Type      string          `json:"type"`
+	Name      string          `json:"name"`
+	Instances []StateInstance `json:"instances"`
+}
+
+type StateInstance struct {
+	IndexKey   any            `json:"index_key,omitempty"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// Address returns the address of the resource, e.g. module.grants.snowflake_table_grant.select.
+func (r StateResource) Address() string {
+	address := fmt.Sprintf("%s.%s", r.Type, r.Name)
+	if r.Module != "" {
+		address = fmt.Sprintf("%s.%s", r.Module, address)
+	}
+	return address
+}
+
+// InstanceAddress returns the address of a single instance of the resource created with count or for_each.
+func (r StateResource) InstanceAddress(instance StateInstance) string {
+	switch key := instance.IndexKey.(type) {
+	case string:
+		return fmt.Sprintf("%s[%q]", r.Address(), key)
+	case float64:
+		return fmt.Sprintf("%s[%d]", r.Address(), int(key))
+	default:
+		return r.Address()
+	}
+}
+
+func ReadState(reader io.Reader) (*State, error) {
+	state := new(State)
+	if err := json.NewDecoder(reader).Decode(state); err != nil {
+		return nil, fmt.Errorf("could not parse the state file: %w", err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("unsupported state file version %d, expected 4", state.Version)
+	}
+	return state, nil
+}
+
+func stringAttribute(attributes map[string]any, key string) string {
+	if v, ok := attributes[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func boolAttribute(attributes map[string]any, key string) bool {
+	if v, ok := attributes[key].(bool); ok {
+		return v
+	}
+	return false
+}
+
+func stringListAttribute(attributes map[string]any, key string) []string {
+	values, ok := attributes[key].([]any)
+	if !ok {
+		return nil
+	}
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok && s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func blockAttribute(attributes map[string]any, key string) map[string]any {
+	values, ok := attributes[key].([]any)
+	if !ok || len(values) == 0 {
+		return nil
+	}
+	block, _ := values[0].(map[string]any)
+	return block
+}
@@ -0,0 +1,507 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+const (
+	databasesKind  = "databases"
+	schemasKind    = "schemas"
+	warehousesKind = "warehouses"
+	rolesKind      = "roles"
+	usersKind      = "users"
+	grantsKind     = "grants"
+	tablesKind     = "tables"
+	viewsKind      = "views"
+	tasksKind      = "tasks"
+)
+
+// AllObjectKinds are the kinds of the objects the generator can import.
+var AllObjectKinds = []string{databasesKind, schemasKind, warehousesKind, rolesKind, usersKind, grantsKind, tablesKind, viewsKind, tasksKind}
+
+// systemDatabases are created by Snowflake and cannot be managed by Terraform.
+var systemDatabases = []string{"SNOWFLAKE", "SNOWFLAKE_SAMPLE_DATA"}
+
+// systemAccountRoles are the system-defined roles, see https://docs.snowflake.com/en/user-guide/security-access-control-overview#system-defined-roles.
+var systemAccountRoles = []string{"ACCOUNTADMIN", "ORGADMIN", "PUBLIC", "SECURITYADMIN", "SYSADMIN", "USERADMIN"}
+
+// grantAccountObjectTypes are the account level objects supported by the on_account_object block of snowflake_grant_privileges_to_account_role.
+var grantAccountObjectTypes = []sdk.ObjectType{
+	sdk.ObjectTypeComputePool,
+	sdk.ObjectTypeDatabase,
+	sdk.ObjectTypeExternalVolume,
+	sdk.ObjectTypeFailoverGroup,
+	sdk.ObjectTypeIntegration,
+	sdk.ObjectTypeReplicationGroup,
+	sdk.ObjectTypeResourceMonitor,
+	sdk.ObjectTypeUser,
+	sdk.ObjectTypeWarehouse,
+}
+
+// Filter limits the objects included in the generated configuration.
+type Filter struct {
+	// Databases are the names of the databases walked for schemas, tables, views and tasks (all databases when empty).
+	Databases []string
+	// NamePattern has to match the names of the imported objects. The grants are included for the account roles matching it.
+	NamePattern *regexp.Regexp
+	// ObjectKinds are the kinds of the imported objects (all kinds when empty).
+	ObjectKinds []string
+}
+
+func (f Filter) Validate() error {
+	for _, kind := range f.ObjectKinds {
+		if !slices.Contains(AllObjectKinds, strings.ToLower(kind)) {
+			return fmt.Errorf("unsupported object kind %s, valid kinds are: %s", kind, strings.Join(AllObjectKinds, ", "))
+		}
+	}
+	return nil
+}
+
+func (f Filter) includesKind(kind string) bool {
+	return len(f.ObjectKinds) == 0 || slices.ContainsFunc(f.ObjectKinds, func(k string) bool { return strings.EqualFold(k, kind) })
+}
+
+func (f Filter) includesDatabase(name string) bool {
+	if slices.Contains(systemDatabases, name) {
+		return false
+	}
+	return len(f.Databases) == 0 || slices.ContainsFunc(f.Databases, func(database string) bool {
+		return sdk.NewAccountObjectIdentifierFromFullyQualifiedName(database).Name() == name
+	})
+}
+
+func (f Filter) includesName(name string) bool {
+	return f.NamePattern == nil || f.NamePattern.MatchString(name)
+}
+
+// ImportedResource is a minimal resource block with the import ID of the existing object.
+type ImportedResource struct {
+	Type     string
+	Name     string
+	ImportId string
+	Body     []string
+}
+
+// Result is the configuration generated for the account.
+type Result struct {
+	Resources []ImportedResource
+	// Warnings describe the objects which were found, but could not be imported.
+	Warnings []string
+}
+
+func databaseResource(database sdk.Database) ImportedResource {
+	return ImportedResource{
+		Type:     "snowflake_database",
+		Name:     database.Name,
+		ImportId: helpers.EncodeSnowflakeID(database.ID()),
+		Body:     []string{attribute("name", database.Name)},
+	}
+}
+
+func schemaResource(schema sdk.Schema) ImportedResource {
+	return ImportedResource{
+		Type:     "snowflake_schema",
+		Name:     schema.DatabaseName + "_" + schema.Name,
+		ImportId: helpers.EncodeSnowflakeID(schema.DatabaseName, schema.Name),
+		Body: []string{
+			attribute("database", schema.DatabaseName),
+			attribute("name", schema.Name),
+		},
+	}
+}
+
+func warehouseResource(warehouse sdk.Warehouse) ImportedResource {
+	return ImportedResource{
+		Type:     "snowflake_warehouse",
+		Name:     warehouse.Name,
+		ImportId: helpers.EncodeSnowflakeID(warehouse.ID()),
+		Body:     []string{attribute("name", warehouse.Name)},
+	}
+}
+
+func roleResource(role sdk.Role) ImportedResource {
+	return ImportedResource{
+		Type:     "snowflake_role",
+		Name:     role.Name,
+		ImportId: helpers.EncodeSnowflakeID(role.ID()),
+		Body:     []string{attribute("name", role.Name)},
+	}
+}
+
+func userResource(user sdk.User) ImportedResource {
+	return ImportedResource{
+		Type:     "snowflake_user",
+		Name:     user.Name,
+		ImportId: helpers.EncodeSnowflakeID(user.ID()),
+		Body:     []string{attribute("name", user.Name)},
+	}
+}
+
+func tableResource(table sdk.Table, columns []sdk.TableColumnDetails) ImportedResource {
+	body := []string{
+		attribute("database", table.DatabaseName),
+		attribute("schema", table.SchemaName),
+		attribute("name", table.Name),
+	}
+	for _, column := range columns {
+		columnBody := []string{
+			attribute("name", column.Name),
+			attribute("type", string(column.Type)),
+		}
+		if !column.IsNullable {
+			columnBody = append(columnBody, "nullable = false")
+		}
+		body = append(body, block("column", columnBody)...)
+	}
+	return ImportedResource{
+		Type:     "snowflake_table",
+		Name:     table.DatabaseName + "_" + table.SchemaName + "_" + table.Name,
+		ImportId: helpers.EncodeSnowflakeID(table.DatabaseName, table.SchemaName, table.Name),
+		Body:     body,
+	}
+}
+
+func viewResource(view sdk.View) ImportedResource {
+	body := []string{
+		attribute("database", view.DatabaseName),
+		attribute("schema", view.SchemaName),
+		attribute("name", view.Name),
+		attribute("statement", viewStatement(view.Text)),
+	}
+	if view.IsSecure {
+		body = append(body, "is_secure = true")
+	}
+	return ImportedResource{
+		Type:     "snowflake_view",
+		Name:     view.DatabaseName + "_" + view.SchemaName + "_" + view.Name,
+		ImportId: helpers.EncodeSnowflakeID(view.DatabaseName, view.SchemaName, view.Name),
+		Body:     body,
+	}
+}
+
+var viewStatementPattern = regexp.MustCompile(`(?is)^\s*create\s.*?\sview\s.*?\sas\s+(.*)$`)
+
+// viewStatement returns the query of the view from its CREATE statement returned by SHOW VIEWS. Columns with comments
+// containing " as " are not handled, the statement is then fixed by the first terraform plan.
+func viewStatement(text string) string {
+	if matches := viewStatementPattern.FindStringSubmatch(text); matches != nil {
+		return strings.TrimSpace(matches[1])
+	}
+	return strings.TrimSpace(text)
+}
+
+func taskResource(task sdk.Task) ImportedResource {
+	body := []string{
+		attribute("database", task.DatabaseName),
+		attribute("schema", task.SchemaName),
+		attribute("name", task.Name),
+		attribute("sql_statement", task.Definition),
+	}
+	if task.Warehouse != "" {
+		body = append(body, attribute("warehouse", task.Warehouse))
+	}
+	if task.Schedule != "" {
+		body = append(body, attribute("schedule", task.Schedule))
+	}
+	return ImportedResource{
+		Type:     "snowflake_task",
+		Name:     task.DatabaseName + "_" + task.SchemaName + "_" + task.Name,
+		ImportId: helpers.EncodeSnowflakeID(task.DatabaseName, task.SchemaName, task.Name),
+		Body:     body,
+	}
+}
+
+// grantResources converts the grants to the account role (SHOW GRANTS TO ROLE) into snowflake_grant_privileges_to_account_role
+// and snowflake_grant_account_role resources. Privileges granted on the same object with the same grant option are merged
+// into a single resource. OWNERSHIP is not imported, because it is a property of the object rather than of the role.
+func grantResources(role sdk.AccountObjectIdentifier, grants []sdk.Grant, filter Filter) ([]ImportedResource, []string) {
+	type privilegesGrant struct {
+		objectName string
+		id         resources.GrantPrivilegesToAccountRoleId
+	}
+	var result []ImportedResource
+	var warnings []string
+	privilegesGrants := make(map[string]*privilegesGrant)
+	var keys []string
+
+	for _, grant := range grants {
+		if grant.Privilege == "OWNERSHIP" {
+			continue
+		}
+		rawName := grant.Name.Name()
+		if grant.GrantedOn == sdk.ObjectTypeRole {
+			grantedRole := sdk.NewAccountObjectIdentifier(rawName)
+			result = append(result, ImportedResource{
+				Type:     "snowflake_grant_account_role",
+				Name:     grantedRole.Name() + "_" + role.Name(),
+				ImportId: helpers.EncodeSnowflakeID(grantedRole.FullyQualifiedName(), sdk.ObjectTypeRole.String(), role.FullyQualifiedName()),
+				Body: []string{
+					attribute("role_name", grantedRole.FullyQualifiedName()),
+					attribute("parent_role_name", role.FullyQualifiedName()),
+				},
+			})
+			continue
+		}
+
+		id := resources.GrantPrivilegesToAccountRoleId{RoleName: role, WithGrantOption: grant.GrantOption}
+		var database string
+		switch {
+		case grant.GrantedOn == sdk.ObjectTypeAccount:
+			id.Kind, id.Data = resources.OnAccountAccountRoleGrantKind, new(resources.OnAccountGrantData)
+		case slices.Contains(grantAccountObjectTypes, grant.GrantedOn):
+			objectName := sdk.NewAccountObjectIdentifier(rawName)
+			if grant.GrantedOn == sdk.ObjectTypeDatabase {
+				database = objectName.Name()
+			}
+			id.Kind, id.Data = resources.OnAccountObjectAccountRoleGrantKind, &resources.OnAccountObjectGrantData{ObjectType: grant.GrantedOn, ObjectName: objectName}
+		case grant.GrantedOn == sdk.ObjectTypeSchema:
+			schemaName := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(rawName)
+			database = schemaName.DatabaseName()
+			id.Kind, id.Data = resources.OnSchemaAccountRoleGrantKind, &resources.OnSchemaGrantData{Kind: resources.OnSchemaSchemaGrantKind, SchemaName: &schemaName}
+		case slices.Contains(sdk.ValidGrantToObjectTypesString, grant.GrantedOn.String()) &&
+			grant.GrantedOn != sdk.ObjectTypeFunction && grant.GrantedOn != sdk.ObjectTypeProcedure && strings.Count(rawName, ".") == 2:
+			objectName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(rawName)
+			database = objectName.DatabaseName()
+			id.Kind, id.Data = resources.OnSchemaObjectAccountRoleGrantKind, &resources.OnSchemaObjectGrantData{
+				Kind:   resources.OnObjectSchemaObjectGrantKind,
+				Object: &sdk.Object{ObjectType: grant.GrantedOn, Name: objectName},
+			}
+		default:
+			warnings = append(warnings, fmt.Sprintf("%s granted on %s %s to role %s is not imported", grant.Privilege, grant.GrantedOn, rawName, role.Name()))
+			continue
+		}
+		if database != "" && !filter.includesDatabase(database) {
+			continue
+		}
+
+		key := id.String()
+		if existing, ok := privilegesGrants[key]; ok {
+			existing.id.Privileges = append(existing.id.Privileges, grant.Privilege)
+			continue
+		}
+		id.Privileges = []string{grant.Privilege}
+		privilegesGrants[key] = &privilegesGrant{objectName: rawName, id: id}
+		keys = append(keys, key)
+	}
+
+	for _, key := range keys {
+		grant := privilegesGrants[key]
+		result = append(result, privilegesResource(grant.objectName, grant.id))
+	}
+	return result, warnings
+}
+
+func privilegesResource(objectName string, id resources.GrantPrivilegesToAccountRoleId) ImportedResource {
+	sort.Strings(id.Privileges)
+	body := []string{
+		attribute("account_role_name", id.RoleName.FullyQualifiedName()),
+		listAttribute("privileges", id.Privileges),
+	}
+	if id.WithGrantOption {
+		body = append(body, "with_grant_option = true")
+	}
+	name := id.RoleName.Name()
+	switch data := id.Data.(type) {
+	case *resources.OnAccountGrantData:
+		name += "_account"
+		body = append(body, "on_account = true")
+	case *resources.OnAccountObjectGrantData:
+		name += "_" + objectName
+		body = append(body, block("on_account_object", []string{
+			attribute("object_type", data.ObjectType.String()),
+			attribute("object_name", data.ObjectName.FullyQualifiedName()),
+		})...)
+	case *resources.OnSchemaGrantData:
+		name += "_" + objectName
+		body = append(body, block("on_schema", []string{attribute("schema_name", data.SchemaName.FullyQualifiedName())})...)
+	case *resources.OnSchemaObjectGrantData:
+		name += "_" + objectName
+		body = append(body, block("on_schema_object", []string{
+			attribute("object_type", data.Object.ObjectType.String()),
+			attribute("object_name", data.Object.Name.FullyQualifiedName()),
+		})...)
+	}
+	return ImportedResource{
+		Type:     "snowflake_grant_privileges_to_account_role",
+		Name:     name,
+		ImportId: id.String(),
+		Body:     body,
+	}
+}
+
+// Generate walks the account objects included by the filter and returns the resources importing them.
+func Generate(ctx context.Context, client *sdk.Client, filter Filter) (*Result, error) {
+	result := &Result{}
+
+	databases, err := client.Databases.Show(ctx, &sdk.ShowDatabasesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error showing databases: %w", err)
+	}
+	for _, database := range databases {
+		if !filter.includesDatabase(database.Name) {
+			continue
+		}
+		if database.Origin != "" {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("database %s created from share %s is not imported", database.Name, database.Origin))
+			continue
+		}
+		if filter.includesKind(databasesKind) && filter.includesName(database.Name) {
+			result.Resources = append(result.Resources, databaseResource(database))
+		}
+		if err := generateDatabaseObjects(ctx, client, filter, database.ID(), result); err != nil {
+			return nil, err
+		}
+	}
+
+	if filter.includesKind(warehousesKind) {
+		warehouses, err := client.Warehouses.Show(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error showing warehouses: %w", err)
+		}
+		for _, warehouse := range warehouses {
+			if filter.includesName(warehouse.Name) {
+				result.Resources = append(result.Resources, warehouseResource(warehouse))
+			}
+		}
+	}
+
+	if filter.includesKind(usersKind) {
+		users, err := client.Users.Show(ctx, &sdk.ShowUserOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error showing users: %w", err)
+		}
+		for _, user := range users {
+			if user.Name != "SNOWFLAKE" && filter.includesName(user.Name) {
+				result.Resources = append(result.Resources, userResource(user))
+			}
+		}
+	}
+
+	if filter.includesKind(rolesKind) || filter.includesKind(grantsKind) {
+		roles, err := client.Roles.Show(ctx, sdk.NewShowRoleRequest())
+		if err != nil {
+			return nil, fmt.Errorf("error showing roles: %w", err)
+		}
+		for _, role := range roles {
+			if !filter.includesName(role.Name) {
+				continue
+			}
+			if filter.includesKind(rolesKind) && !slices.Contains(systemAccountRoles, role.Name) {
+				result.Resources = append(result.Resources, roleResource(role))
+			}
+			if filter.includesKind(grantsKind) {
+				grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: role.ID()}})
+				if err != nil {
+					return nil, fmt.Errorf("error showing grants to role %s: %w", role.Name, err)
+				}
+				grantResources, warnings := grantResources(role.ID(), grants, filter)
+				result.Resources = append(result.Resources, grantResources...)
+				result.Warnings = append(result.Warnings, warnings...)
+			}
+		}
+	}
+
+	assignNames(result.Resources)
+	return result, nil
+}
+
+func generateDatabaseObjects(ctx context.Context, client *sdk.Client, filter Filter, databaseId sdk.AccountObjectIdentifier, result *Result) error {
+	if !filter.includesKind(schemasKind) && !filter.includesKind(tablesKind) && !filter.includesKind(viewsKind) && !filter.includesKind(tasksKind) {
+		return nil
+	}
+	schemas, err := client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{In: &sdk.SchemaIn{Database: sdk.Bool(true), Name: databaseId}})
+	if err != nil {
+		return fmt.Errorf("error showing schemas in database %s: %w", databaseId.FullyQualifiedName(), err)
+	}
+	for _, schema := range schemas {
+		if schema.Name == "INFORMATION_SCHEMA" {
+			continue
+		}
+		schemaId := sdk.NewDatabaseObjectIdentifier(schema.DatabaseName, schema.Name)
+		if filter.includesKind(schemasKind) && filter.includesName(schema.Name) {
+			result.Resources = append(result.Resources, schemaResource(schema))
+		}
+
+		if filter.includesKind(tablesKind) {
+			tables, err := client.Tables.Show(ctx, sdk.NewShowTableRequest().WithIn(&sdk.In{Schema: schemaId}))
+			if err != nil {
+				return fmt.Errorf("error showing tables in schema %s: %w", schemaId.FullyQualifiedName(), err)
+			}
+			for _, table := range tables {
+				if table.IsExternal || table.IsEvent || !filter.includesName(table.Name) {
+					continue
+				}
+				columns, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(table.ID()))
+				if err != nil {
+					return fmt.Errorf("error describing columns of table %s: %w", table.ID().FullyQualifiedName(), err)
+				}
+				result.Resources = append(result.Resources, tableResource(table, columns))
+			}
+		}
+
+		if filter.includesKind(viewsKind) {
+			views, err := client.Views.Show(ctx, sdk.NewShowViewRequest().WithIn(&sdk.In{Schema: schemaId}))
+			if err != nil {
+				return fmt.Errorf("error showing views in schema %s: %w", schemaId.FullyQualifiedName(), err)
+			}
+			for _, view := range views {
+				if !view.IsMaterialized && filter.includesName(view.Name) {
+					result.Resources = append(result.Resources, viewResource(view))
+				}
+			}
+		}
+
+		if filter.includesKind(tasksKind) {
+			tasks, err := client.Tasks.Show(ctx, sdk.NewShowTaskRequest().WithIn(&sdk.In{Schema: schemaId}))
+			if err != nil {
+				return fmt.Errorf("error showing tasks in schema %s: %w", schemaId.FullyQualifiedName(), err)
+			}
+			for _, task := range tasks {
+				if filter.includesName(task.Name) {
+					result.Resources = append(result.Resources, taskResource(task))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// assignNames turns the proposed names into valid, unique resource names.
+func assignNames(imported []ImportedResource) {
+	used := make(map[string]int)
+	for i := range imported {
+		name := resourceName(imported[i].Name)
+		key := imported[i].Type + "|" + name
+		used[key]++
+		if used[key] > 1 {
+			name = fmt.Sprintf("%s_%d", name, used[key])
+		}
+		imported[i].Name = name
+	}
+}
+
+func resourceName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-':
+			b.WriteRune(r)
+		case !strings.HasSuffix(b.String(), "_"):
+			b.WriteRune('_')
+		}
+	}
+	result := strings.Trim(b.String(), "_")
+	if result == "" || (result[0] >= '0' && result[0] <= '9') || result[0] == '-' {
+		result = "object_" + result
+	}
+	return result
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	filter := Filter{
+		Databases:   []string{`"DB"`, "OTHER"},
+		NamePattern: regexp.MustCompile("^APP_"),
+		ObjectKinds: []string{"Tables"},
+	}
+
+	assert.True(t, filter.includesDatabase("DB"))
+	assert.True(t, filter.includesDatabase("OTHER"))
+	assert.False(t, filter.includesDatabase("UNKNOWN"))
+	assert.False(t, Filter{}.includesDatabase("SNOWFLAKE"))
+	assert.True(t, filter.includesName("APP_TABLE"))
+	assert.False(t, filter.includesName("TABLE"))
+	assert.True(t, filter.includesKind(tablesKind))
+	assert.False(t, filter.includesKind(viewsKind))
+	assert.True(t, Filter{}.includesKind(viewsKind))
+
+	assert.NoError(t, filter.Validate())
+	assert.ErrorContains(t, Filter{ObjectKinds: []string{"pipes"}}.Validate(), "unsupported object kind pipes")
+}
+
+func TestViewStatement(t *testing.T) {
+	assert.Equal(t, "select id from t", viewStatement("create or replace view DB.PUBLIC.V as select id from t"))
+	assert.Equal(t, "SELECT 1", viewStatement("CREATE SECURE VIEW \"V\" (ID) COMMENT = 'x' AS\n  SELECT 1"))
+}
+
+func TestGrantResources(t *testing.T) {
+	role := sdk.NewAccountObjectIdentifier("ANALYST")
+	grants := []sdk.Grant{
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier("DB")},
+		{Privilege: "MONITOR", GrantedOn: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier("DB")},
+		{Privilege: "OWNERSHIP", GrantedOn: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier("DB")},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier("EXCLUDED")},
+		{Privilege: "SELECT", GrantedOn: sdk.ObjectTypeTable, Name: sdk.NewAccountObjectIdentifier("DB.PUBLIC.T"), GrantOption: true},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeSchema, Name: sdk.NewAccountObjectIdentifier("DB.PUBLIC")},
+		{Privilege: "CREATE DATABASE", GrantedOn: sdk.ObjectTypeAccount, Name: sdk.NewAccountObjectIdentifier("ACCOUNT")},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeRole, Name: sdk.NewAccountObjectIdentifier("LOADER")},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeFunction, Name: sdk.NewAccountObjectIdentifier("DB.PUBLIC.F(A NUMBER):NUMBER")},
+	}
+
+	imported, warnings := grantResources(role, grants, Filter{Databases: []string{"DB"}})
+	require.Len(t, imported, 5)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "USAGE granted on FUNCTION")
+
+	assert.Equal(t, "snowflake_grant_account_role", imported[0].Type)
+	assert.Equal(t, `"LOADER"|ROLE|"ANALYST"`, imported[0].ImportId)
+
+	assert.Equal(t, "snowflake_grant_privileges_to_account_role", imported[1].Type)
+	assert.Equal(t, `"ANALYST"|false|false|MONITOR,USAGE|OnAccountObject|DATABASE|"DB"`, imported[1].ImportId)
+	assert.Contains(t, imported[1].Body, `privileges = ["MONITOR", "USAGE"]`)
+
+	assert.Equal(t, `"ANALYST"|true|false|SELECT|OnSchemaObject|OnObject|TABLE|"DB"."PUBLIC"."T"`, imported[2].ImportId)
+	assert.Contains(t, imported[2].Body, "with_grant_option = true")
+	assert.Equal(t, `"ANALYST"|false|false|USAGE|OnSchema|OnSchema|"DB"."PUBLIC"`, imported[3].ImportId)
+	assert.Equal(t, `"ANALYST"|false|false|CREATE DATABASE|OnAccount`, imported[4].ImportId)
+	assert.Contains(t, imported[4].Body, "on_account = true")
+}
+
+func TestWriteConfiguration(t *testing.T) {
+	result := &Result{
+		Resources: []ImportedResource{
+			databaseResource(sdk.Database{Name: "DB"}),
+			tableResource(sdk.Table{DatabaseName: "DB", SchemaName: "PUBLIC", Name: "T"}, []sdk.TableColumnDetails{
+				{Name: "ID", Type: "NUMBER(38,0)"},
+				{Name: "NAME", Type: "VARCHAR(16777216)", IsNullable: true},
+			}),
+			taskResource(sdk.Task{DatabaseName: "DB", SchemaName: "PUBLIC", Name: "T", Definition: "select '${x}'", Warehouse: "WH"}),
+			roleResource(sdk.Role{Name: "db"}),
+		},
+		Warnings: []string{"something is not imported"},
+	}
+	assignNames(result.Resources)
+
+	var b strings.Builder
+	require.NoError(t, WriteConfiguration(&b, result))
+	assert.Equal(t, `# WARNING: something is not imported
+
+resource "snowflake_database" "db" {
+  name = "DB"
+}
+
+import {
+  to = snowflake_database.db
+  id = "DB"
+}
+
+resource "snowflake_table" "db_public_t" {
+  database = "DB"
+  schema = "PUBLIC"
+  name = "T"
+  column {
+    name = "ID"
+    type = "NUMBER(38,0)"
+    nullable = false
+  }
+  column {
+    name = "NAME"
+    type = "VARCHAR(16777216)"
+  }
+}
+
+import {
+  to = snowflake_table.db_public_t
+  id = "DB|PUBLIC|T"
+}
+
+resource "snowflake_task" "db_public_t" {
+  database = "DB"
+  schema = "PUBLIC"
+  name = "T"
+  sql_statement = "select '$${x}'"
+  warehouse = "WH"
+}
+
+import {
+  to = snowflake_task.db_public_t
+  id = "DB|PUBLIC|T"
+}
+
+resource "snowflake_role" "db" {
+  name = "db"
+}
+
+import {
+  to = snowflake_role.db
+  id = "db"
+}
+`, b.String())
+}
+
+func TestAssignNames(t *testing.T) {
+	imported := []ImportedResource{
+		{Type: "snowflake_role", Name: "My Role"},
+		{Type: "snowflake_role", Name: "my-role"},
+		{Type: "snowflake_role", Name: "MY_ROLE"},
+		{Type: "snowflake_user", Name: "1st"},
+	}
+	assignNames(imported)
+	assert.Equal(t, "my_role", imported[0].Name)
+	assert.Equal(t, "my-role", imported[1].Name)
+	assert.Equal(t, "my_role_2", imported[2].Name)
+	assert.Equal(t, "object_1st", imported[3].Name)
+}
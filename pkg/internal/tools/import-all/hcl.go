@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteConfiguration writes the resource and import blocks of the generated resources to the writer.
+func WriteConfiguration(w io.Writer, result *Result) error {
+	var b strings.Builder
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(&b, "# WARNING: %s\n", warning)
+	}
+	if len(result.Warnings) > 0 {
+		b.WriteString("\n")
+	}
+
+	for _, resource := range result.Resources {
+		fmt.Fprintf(&b, "resource %q %q {\n", resource.Type, resource.Name)
+		for _, line := range resource.Body {
+			fmt.Fprintf(&b, "  %s\n", line)
+		}
+		b.WriteString("}\n\n")
+
+		b.WriteString("import {\n")
+		fmt.Fprintf(&b, "  to = %s.%s\n", resource.Type, resource.Name)
+		fmt.Fprintf(&b, "  id = %s\n", quote(resource.ImportId))
+		b.WriteString("}\n\n")
+	}
+
+	_, err := io.WriteString(w, strings.TrimSuffix(b.String(), "\n"))
+	return err
+}
+
+func attribute(name string, value string) string {
+	return fmt.Sprintf("%s = %s", name, quote(value))
+}
+
+func listAttribute(name string, values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = quote(value)
+	}
+	return fmt.Sprintf("%s = [%s]", name, strings.Join(quoted, ", "))
+}
+
+func block(name string, body []string) []string {
+	lines := []string{name + " {"}
+	for _, line := range body {
+		lines = append(lines, "  "+line)
+	}
+	return append(lines, "}")
+}
+
+// quote returns the value as an HCL string literal; template sequences are escaped, so they are not interpolated.
+func quote(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"${", "$${",
+		"%{", "%%{",
+	)
+	return `"` + replacer.Replace(value) + `"`
+}
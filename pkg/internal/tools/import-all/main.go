@@ -0,0 +1,69 @@
+// Command import-all walks the objects of an existing Snowflake account with the SDK and prints the Terraform configuration
+// importing them: a minimal resource block (only the required attributes) and an import block (Terraform 1.5+) for every
+// database, schema, warehouse, account role, user, table, view, task and privileges granted to the account roles.
+// After the import, run terraform plan and complete the resource blocks with the attributes reported as changes.
+//
+// The connection is configured the same way as for the SDK integration tests (~/.snowflake/config, default profile).
+//
+// Usage:
+//
+//	go run ./pkg/internal/tools/import-all/ [-databases DB1,DB2] [-name-pattern REGEX] [-object-types databases,schemas,...] > import.tf
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func main() {
+	databases := flag.String("databases", "", "comma separated names of the databases to walk; all databases are walked when empty")
+	namePattern := flag.String("name-pattern", "", "regular expression the object names have to match (the grants are included for the account roles matching it)")
+	objectTypes := flag.String("object-types", strings.Join(AllObjectKinds, ","), "comma separated kinds of the objects to import")
+	flag.Parse()
+
+	filter := Filter{
+		Databases:   splitList(*databases),
+		ObjectKinds: splitList(*objectTypes),
+	}
+	if *namePattern != "" {
+		pattern, err := regexp.Compile(*namePattern)
+		if err != nil {
+			log.Fatal(err)
+		}
+		filter.NamePattern = pattern
+	}
+	if err := filter.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := sdk.NewDefaultClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+	result, err := Generate(context.Background(), client, filter)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, warning := range result.Warnings {
+		log.Println("WARNING:", warning)
+	}
+	if err := WriteConfiguration(os.Stdout, result); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func splitList(value string) []string {
+	values := make([]string, 0)
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
@@ -451,67 +451,99 @@ func GetGrantResources() resources.TerraformGrantResources {
 func getResources() map[string]*schema.Resource {
 	// NOTE(): do not add grant resources here
 	others := map[string]*schema.Resource{
-		"snowflake_account":                                 resources.Account(),
-		"snowflake_account_password_policy_attachment":      resources.AccountPasswordPolicyAttachment(),
-		"snowflake_account_parameter":                       resources.AccountParameter(),
-		"snowflake_alert":                                   resources.Alert(),
-		"snowflake_api_integration":                         resources.APIIntegration(),
-		"snowflake_database":                                resources.Database(),
-		"snowflake_database_role":                           resources.DatabaseRole(),
-		"snowflake_dynamic_table":                           resources.DynamicTable(),
-		"snowflake_email_notification_integration":          resources.EmailNotificationIntegration(),
-		"snowflake_external_function":                       resources.ExternalFunction(),
-		"snowflake_external_oauth_integration":              resources.ExternalOauthIntegration(),
-		"snowflake_external_table":                          resources.ExternalTable(),
-		"snowflake_failover_group":                          resources.FailoverGroup(),
-		"snowflake_file_format":                             resources.FileFormat(),
-		"snowflake_function":                                resources.Function(),
-		"snowflake_grant_account_role":                      resources.GrantAccountRole(),
-		"snowflake_grant_database_role":                     resources.GrantDatabaseRole(),
-		"snowflake_grant_ownership":                         resources.GrantOwnership(),
-		"snowflake_grant_privileges_to_role":                resources.GrantPrivilegesToRole(),
-		"snowflake_grant_privileges_to_account_role":        resources.GrantPrivilegesToAccountRole(),
-		"snowflake_grant_privileges_to_database_role":       resources.GrantPrivilegesToDatabaseRole(),
-		"snowflake_grant_privileges_to_share":               resources.GrantPrivilegesToShare(),
-		"snowflake_managed_account":                         resources.ManagedAccount(),
-		"snowflake_masking_policy":                          resources.MaskingPolicy(),
-		"snowflake_materialized_view":                       resources.MaterializedView(),
-		"snowflake_network_policy":                          resources.NetworkPolicy(),
-		"snowflake_network_policy_attachment":               resources.NetworkPolicyAttachment(),
-		"snowflake_notification_integration":                resources.NotificationIntegration(),
-		"snowflake_oauth_integration":                       resources.OAuthIntegration(),
-		"snowflake_object_parameter":                        resources.ObjectParameter(),
-		"snowflake_password_policy":                         resources.PasswordPolicy(),
-		"snowflake_pipe":                                    resources.Pipe(),
-		"snowflake_procedure":                               resources.Procedure(),
-		"snowflake_resource_monitor":                        resources.ResourceMonitor(),
-		"snowflake_role":                                    resources.Role(),
-		"snowflake_role_grants":                             resources.RoleGrants(),
-		"snowflake_role_ownership_grant":                    resources.RoleOwnershipGrant(),
-		"snowflake_row_access_policy":                       resources.RowAccessPolicy(),
-		"snowflake_saml_integration":                        resources.SAMLIntegration(),
-		"snowflake_schema":                                  resources.Schema(),
-		"snowflake_scim_integration":                        resources.SCIMIntegration(),
-		"snowflake_sequence":                                resources.Sequence(),
-		"snowflake_session_parameter":                       resources.SessionParameter(),
-		"snowflake_share":                                   resources.Share(),
-		"snowflake_stage":                                   resources.Stage(),
-		"snowflake_storage_integration":                     resources.StorageIntegration(),
-		"snowflake_stream":                                  resources.Stream(),
-		"snowflake_table":                                   resources.Table(),
-		"snowflake_table_column_masking_policy_application": resources.TableColumnMaskingPolicyApplication(),
-		"snowflake_table_constraint":                        resources.TableConstraint(),
-		"snowflake_tag":                                     resources.Tag(),
-		"snowflake_tag_association":                         resources.TagAssociation(),
-		"snowflake_tag_masking_policy_association":          resources.TagMaskingPolicyAssociation(),
-		"snowflake_task":                                    resources.Task(),
-		"snowflake_unsafe_execute":                          resources.UnsafeExecute(),
-		"snowflake_user":                                    resources.User(),
-		"snowflake_user_ownership_grant":                    resources.UserOwnershipGrant(),
-		"snowflake_user_password_policy_attachment":         resources.UserPasswordPolicyAttachment(),
-		"snowflake_user_public_keys":                        resources.UserPublicKeys(),
-		"snowflake_view":                                    resources.View(),
-		"snowflake_warehouse":                               resources.Warehouse(),
+		"snowflake_account":                                              resources.Account(),
+		"snowflake_account_password_policy_attachment":                   resources.AccountPasswordPolicyAttachment(),
+		"snowflake_account_parameter":                                    resources.AccountParameter(),
+		"snowflake_alert":                                                resources.Alert(),
+		"snowflake_api_integration":                                      resources.APIIntegration(),
+		"snowflake_database":                                             resources.Database(),
+		"snowflake_database_role":                                        resources.DatabaseRole(),
+		"snowflake_dynamic_table":                                        resources.DynamicTable(),
+		"snowflake_email_notification_integration":                       resources.EmailNotificationIntegration(),
+		"snowflake_external_function":                                    resources.ExternalFunction(),
+		"snowflake_external_oauth_integration":                           resources.ExternalOauthIntegration(),
+		"snowflake_external_table":                                       resources.ExternalTable(),
+		"snowflake_failover_group":                                       resources.FailoverGroup(),
+		"snowflake_file_format":                                          resources.FileFormat(),
+		"snowflake_file_format_avro":                                     resources.FileFormatAvro(),
+		"snowflake_file_format_csv":                                      resources.FileFormatCsv(),
+		"snowflake_file_format_json":                                     resources.FileFormatJson(),
+		"snowflake_file_format_orc":                                      resources.FileFormatOrc(),
+		"snowflake_file_format_parquet":                                  resources.FileFormatParquet(),
+		"snowflake_file_format_xml":                                      resources.FileFormatXml(),
+		"snowflake_function":                                             resources.Function(),
+		"snowflake_function_java":                                        resources.FunctionJava(),
+		"snowflake_function_javascript":                                  resources.FunctionJavascript(),
+		"snowflake_function_python":                                      resources.FunctionPython(),
+		"snowflake_function_scala":                                       resources.FunctionScala(),
+		"snowflake_function_sql":                                         resources.FunctionSQL(),
+		"snowflake_git_repository":                                       resources.GitRepository(),
+		"snowflake_grant_account_role":                                   resources.GrantAccountRole(),
+		"snowflake_grant_application_role":                               resources.GrantApplicationRole(),
+		"snowflake_grant_database_role":                                  resources.GrantDatabaseRole(),
+		"snowflake_grant_ownership":                                      resources.GrantOwnership(),
+		"snowflake_grant_privileges_on_all_and_future":                   resources.GrantPrivilegesOnAllAndFuture(),
+		"snowflake_grant_privileges_to_role":                             resources.GrantPrivilegesToRole(),
+		"snowflake_grant_privileges_to_account_role":                     resources.GrantPrivilegesToAccountRole(),
+		"snowflake_grant_privileges_to_application":                      resources.GrantPrivilegesToApplication(),
+		"snowflake_grant_privileges_to_database_role":                    resources.GrantPrivilegesToDatabaseRole(),
+		"snowflake_grant_privileges_to_share":                            resources.GrantPrivilegesToShare(),
+		"snowflake_managed_account":                                      resources.ManagedAccount(),
+		"snowflake_masking_policy":                                       resources.MaskingPolicy(),
+		"snowflake_materialized_view":                                    resources.MaterializedView(),
+		"snowflake_network_policy":                                       resources.NetworkPolicy(),
+		"snowflake_network_policy_attachment":                            resources.NetworkPolicyAttachment(),
+		"snowflake_network_rule":                                         resources.NetworkRule(),
+		"snowflake_notification_integration":                             resources.NotificationIntegration(),
+		"snowflake_notification_integration_inbound_azure_storage_queue": resources.NotificationIntegrationInboundAzureStorageQueue(),
+		"snowflake_notification_integration_inbound_gcp_pubsub":          resources.NotificationIntegrationInboundGcpPubsub(),
+		"snowflake_notification_integration_outbound_aws_sns":            resources.NotificationIntegrationOutboundAwsSns(),
+		"snowflake_notification_integration_outbound_azure_event_grid":   resources.NotificationIntegrationOutboundAzureEventGrid(),
+		"snowflake_notification_integration_outbound_gcp_pubsub":         resources.NotificationIntegrationOutboundGcpPubsub(),
+		"snowflake_oauth_integration":                                    resources.OAuthIntegration(),
+		"snowflake_object_grants":                                        resources.ObjectGrants(),
+		"snowflake_object_parameter":                                     resources.ObjectParameter(),
+		"snowflake_password_policy":                                      resources.PasswordPolicy(),
+		"snowflake_pipe":                                                 resources.Pipe(),
+		"snowflake_procedure":                                            resources.Procedure(),
+		"snowflake_procedure_java":                                       resources.ProcedureJava(),
+		"snowflake_procedure_javascript":                                 resources.ProcedureJavascript(),
+		"snowflake_procedure_python":                                     resources.ProcedurePython(),
+		"snowflake_procedure_scala":                                      resources.ProcedureScala(),
+		"snowflake_procedure_sql":                                        resources.ProcedureSQL(),
+		"snowflake_resource_monitor":                                     resources.ResourceMonitor(),
+		"snowflake_resource_monitor_assignment":                          resources.ResourceMonitorAssignment(),
+		"snowflake_role":                                                 resources.Role(),
+		"snowflake_role_grants":                                          resources.RoleGrants(),
+		"snowflake_role_ownership_grant":                                 resources.RoleOwnershipGrant(),
+		"snowflake_row_access_policy":                                    resources.RowAccessPolicy(),
+		"snowflake_saml_integration":                                     resources.SAMLIntegration(),
+		"snowflake_schema":                                               resources.Schema(),
+		"snowflake_scim_integration":                                     resources.SCIMIntegration(),
+		"snowflake_sequence":                                             resources.Sequence(),
+		"snowflake_session_parameter":                                    resources.SessionParameter(),
+		"snowflake_share":                                                resources.Share(),
+		"snowflake_stage":                                                resources.Stage(),
+		"snowflake_stage_file":                                           resources.StageFile(),
+		"snowflake_storage_integration":                                  resources.StorageIntegration(),
+		"snowflake_storage_integration_aws":                              resources.StorageIntegrationAws(),
+		"snowflake_storage_integration_azure":                            resources.StorageIntegrationAzure(),
+		"snowflake_storage_integration_gcs":                              resources.StorageIntegrationGcs(),
+		"snowflake_stream":                                               resources.Stream(),
+		"snowflake_table":                                                resources.Table(),
+		"snowflake_table_column_masking_policy_application":              resources.TableColumnMaskingPolicyApplication(),
+		"snowflake_table_constraint":                                     resources.TableConstraint(),
+		"snowflake_tag":                                                  resources.Tag(),
+		"snowflake_tag_association":                                      resources.TagAssociation(),
+		"snowflake_tag_masking_policy_association":                       resources.TagMaskingPolicyAssociation(),
+		"snowflake_task":                                                 resources.Task(),
+		"snowflake_unsafe_execute":                                       resources.UnsafeExecute(),
+		"snowflake_user":                                                 resources.User(),
+		"snowflake_user_ownership_grant":                                 resources.UserOwnershipGrant(),
+		"snowflake_user_password_policy_attachment":                      resources.UserPasswordPolicyAttachment(),
+		"snowflake_user_public_keys":                                     resources.UserPublicKeys(),
+		"snowflake_view":                                                 resources.View(),
+		"snowflake_warehouse":                                            resources.Warehouse(),
 	}
 
 	return mergeSchemas(
@@ -535,14 +567,21 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_functions":                          datasources.Functions(),
+		"snowflake_git_repository_refs":                datasources.GitRepositoryRefs(),
 		"snowflake_grants":                             datasources.Grants(),
+		"snowflake_inbound_share":                      datasources.InboundShare(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
+		"snowflake_network_policies":                   datasources.NetworkPolicies(),
+		"snowflake_network_rules":                      datasources.NetworkRules(),
 		"snowflake_parameters":                         datasources.Parameters(),
+		"snowflake_pipe_status":                        datasources.PipeStatus(),
 		"snowflake_pipes":                              datasources.Pipes(),
+		"snowflake_privileges":                         datasources.Privileges(),
 		"snowflake_procedures":                         datasources.Procedures(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
 		"snowflake_role":                               datasources.Role(),
+		"snowflake_role_hierarchy":                     datasources.RoleHierarchy(),
 		"snowflake_roles":                              datasources.Roles(),
 		"snowflake_row_access_policies":                datasources.RowAccessPolicies(),
 		"snowflake_schemas":                            datasources.Schemas(),
@@ -556,6 +595,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_system_get_privatelink_config":      datasources.SystemGetPrivateLinkConfig(),
 		"snowflake_system_get_snowflake_platform_info": datasources.SystemGetSnowflakePlatformInfo(),
 		"snowflake_tables":                             datasources.Tables(),
+		"snowflake_tag_references":                     datasources.TagReferences(),
 		"snowflake_tasks":                              datasources.Tasks(),
 		"snowflake_users":                              datasources.Users(),
 		"snowflake_views":                              datasources.Views(),
@@ -3,41 +3,68 @@ package resources
 type resource string
 
 const (
-	Account                      resource = "snowflake_account"
-	Alert                        resource = "snowflake_alert"
-	ApiIntegration               resource = "snowflake_api_integration"
-	Database                     resource = "snowflake_database"
-	DatabaseRole                 resource = "snowflake_database_role"
-	DynamicTable                 resource = "snowflake_dynamic_table"
-	EmailNotificationIntegration resource = "snowflake_email_notification_integration"
-	ExternalFunction             resource = "snowflake_external_function"
-	ExternalTable                resource = "snowflake_external_table"
-	FailoverGroup                resource = "snowflake_failover_group"
-	FileFormat                   resource = "snowflake_file_format"
-	Function                     resource = "snowflake_function"
-	ManagedAccount               resource = "snowflake_managed_account"
-	MaskingPolicy                resource = "snowflake_masking_policy"
-	MaterializedView             resource = "snowflake_materialized_view"
-	NetworkPolicy                resource = "snowflake_network_policy"
-	NotificationIntegration      resource = "snowflake_notification_integration"
-	PasswordPolicy               resource = "snowflake_password_policy"
-	Pipe                         resource = "snowflake_pipe"
-	Procedure                    resource = "snowflake_procedure"
-	ResourceMonitor              resource = "snowflake_resource_monitor"
-	Role                         resource = "snowflake_role"
-	RowAccessPolicy              resource = "snowflake_row_access_policy"
-	Schema                       resource = "snowflake_schema"
-	Sequence                     resource = "snowflake_sequence"
-	Share                        resource = "snowflake_share"
-	Stage                        resource = "snowflake_stage"
-	StorageIntegration           resource = "snowflake_storage_integration"
-	Stream                       resource = "snowflake_stream"
-	Table                        resource = "snowflake_table"
-	Tag                          resource = "snowflake_tag"
-	Task                         resource = "snowflake_task"
-	User                         resource = "snowflake_user"
-	View                         resource = "snowflake_view"
-	Warehouse                    resource = "snowflake_warehouse"
+	Account                                         resource = "snowflake_account"
+	Alert                                           resource = "snowflake_alert"
+	ApiIntegration                                  resource = "snowflake_api_integration"
+	Database                                        resource = "snowflake_database"
+	DatabaseRole                                    resource = "snowflake_database_role"
+	DynamicTable                                    resource = "snowflake_dynamic_table"
+	EmailNotificationIntegration                    resource = "snowflake_email_notification_integration"
+	ExternalFunction                                resource = "snowflake_external_function"
+	ExternalTable                                   resource = "snowflake_external_table"
+	FailoverGroup                                   resource = "snowflake_failover_group"
+	FileFormat                                      resource = "snowflake_file_format"
+	FileFormatAvro                                  resource = "snowflake_file_format_avro"
+	FileFormatCsv                                   resource = "snowflake_file_format_csv"
+	FileFormatJson                                  resource = "snowflake_file_format_json"
+	FileFormatOrc                                   resource = "snowflake_file_format_orc"
+	FileFormatParquet                               resource = "snowflake_file_format_parquet"
+	FileFormatXml                                   resource = "snowflake_file_format_xml"
+	Function                                        resource = "snowflake_function"
+	FunctionJava                                    resource = "snowflake_function_java"
+	FunctionJavascript                              resource = "snowflake_function_javascript"
+	FunctionPython                                  resource = "snowflake_function_python"
+	FunctionSQL                                     resource = "snowflake_function_sql"
+	FunctionScala                                   resource = "snowflake_function_scala"
+	GitRepository                                   resource = "snowflake_git_repository"
+	ManagedAccount                                  resource = "snowflake_managed_account"
+	MaskingPolicy                                   resource = "snowflake_masking_policy"
+	MaterializedView                                resource = "snowflake_materialized_view"
+	NetworkPolicy                                   resource = "snowflake_network_policy"
+	NetworkRule                                     resource = "snowflake_network_rule"
+	NotificationIntegration                         resource = "snowflake_notification_integration"
+	NotificationIntegrationInboundAzureStorageQueue resource = "snowflake_notification_integration_inbound_azure_storage_queue"
+	NotificationIntegrationInboundGcpPubsub         resource = "snowflake_notification_integration_inbound_gcp_pubsub"
+	NotificationIntegrationOutboundAwsSns           resource = "snowflake_notification_integration_outbound_aws_sns"
+	NotificationIntegrationOutboundAzureEventGrid   resource = "snowflake_notification_integration_outbound_azure_event_grid"
+	NotificationIntegrationOutboundGcpPubsub        resource = "snowflake_notification_integration_outbound_gcp_pubsub"
+	PasswordPolicy                                  resource = "snowflake_password_policy"
+	Pipe                                            resource = "snowflake_pipe"
+	Procedure                                       resource = "snowflake_procedure"
+	ProcedureJava                                   resource = "snowflake_procedure_java"
+	ProcedureJavascript                             resource = "snowflake_procedure_javascript"
+	ProcedurePython                                 resource = "snowflake_procedure_python"
+	ProcedureSQL                                    resource = "snowflake_procedure_sql"
+	ProcedureScala                                  resource = "snowflake_procedure_scala"
+	ResourceMonitor                                 resource = "snowflake_resource_monitor"
+	ResourceMonitorAssignment                       resource = "snowflake_resource_monitor_assignment"
+	Role                                            resource = "snowflake_role"
+	RowAccessPolicy                                 resource = "snowflake_row_access_policy"
+	Schema                                          resource = "snowflake_schema"
+	Sequence                                        resource = "snowflake_sequence"
+	Share                                           resource = "snowflake_share"
+	Stage                                           resource = "snowflake_stage"
+	StorageIntegration                              resource = "snowflake_storage_integration"
+	StorageIntegrationAws                           resource = "snowflake_storage_integration_aws"
+	StorageIntegrationAzure                         resource = "snowflake_storage_integration_azure"
+	StorageIntegrationGcs                           resource = "snowflake_storage_integration_gcs"
+	Stream                                          resource = "snowflake_stream"
+	Table                                           resource = "snowflake_table"
+	Tag                                             resource = "snowflake_tag"
+	Task                                            resource = "snowflake_task"
+	User                                            resource = "snowflake_user"
+	View                                            resource = "snowflake_view"
+	Warehouse                                       resource = "snowflake_warehouse"
 )
 
 type Resource interface {
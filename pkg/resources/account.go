@@ -3,6 +3,8 @@ package resources
 import (
 	"context"
 	"fmt"
+	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -11,6 +13,8 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -32,10 +36,9 @@ var accountSchema = map[string]*schema.Schema{
 	"admin_name": {
 		Type:         schema.TypeString,
 		Required:     true,
-		Description:  "Login name of the initial administrative user of the account. A new user is created in the new account with this name and password and granted the ACCOUNTADMIN role in the account. A login name can be any string consisting of letters, numbers, and underscores. Login names are always case-insensitive.",
+		Description:  "Login name of the initial administrative user of the account. A new user is created in the new account with this name and password and granted the ACCOUNTADMIN role in the account. A login name can be any string consisting of letters, numbers, and underscores. Login names are always case-insensitive. It cannot be changed after the creation; such a change fails the plan.",
 		ValidateFunc: snowflakeValidation.ValidateAdminName,
-		// We have no way of assuming a role into this account to change the admin user name so this has to be ForceNew even though it's not ideal
-		ForceNew:              true,
+		// We have no way of assuming a role into this account to change the admin user name, so it is used only during the creation and later changes fail the plan (see accountImmutableFieldsCustomizeDiff)
 		DiffSuppressOnRefresh: true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// For new resources always show the diff
@@ -50,10 +53,9 @@ var accountSchema = map[string]*schema.Schema{
 		Type:         schema.TypeString,
 		Optional:     true,
 		Sensitive:    true,
-		Description:  "Password for the initial administrative user of the account. Optional if the `ADMIN_RSA_PUBLIC_KEY` parameter is specified. For more information about passwords in Snowflake, see [Snowflake-provided Password Policy](https://docs.snowflake.com/en/sql-reference/sql/create-account.html#:~:text=Snowflake%2Dprovided%20Password%20Policy).",
+		Description:  "Password for the initial administrative user of the account. Optional if the `ADMIN_RSA_PUBLIC_KEY` parameter is specified. For more information about passwords in Snowflake, see [Snowflake-provided Password Policy](https://docs.snowflake.com/en/sql-reference/sql/create-account.html#:~:text=Snowflake%2Dprovided%20Password%20Policy). It cannot be changed after the creation; such a change fails the plan.",
 		AtLeastOneOf: []string{"admin_password", "admin_rsa_public_key"},
-		// We have no way of assuming a role into this account to change the password so this has to be ForceNew even though it's not ideal
-		ForceNew:              true,
+		// We have no way of assuming a role into this account to change the password, so it is used only during the creation and later changes fail the plan (see accountImmutableFieldsCustomizeDiff)
 		DiffSuppressOnRefresh: true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// For new resources always show the diff
@@ -68,10 +70,9 @@ var accountSchema = map[string]*schema.Schema{
 		Type:         schema.TypeString,
 		Optional:     true,
 		Sensitive:    true,
-		Description:  "Assigns a public key to the initial administrative user of the account in order to implement [key pair authentication](https://docs.snowflake.com/en/sql-reference/sql/create-account.html#:~:text=key%20pair%20authentication) for the user. Optional if the `ADMIN_PASSWORD` parameter is specified.",
+		Description:  "Assigns a public key to the initial administrative user of the account in order to implement [key pair authentication](https://docs.snowflake.com/en/sql-reference/sql/create-account.html#:~:text=key%20pair%20authentication) for the user. Optional if the `ADMIN_PASSWORD` parameter is specified. It cannot be changed after the creation; such a change fails the plan.",
 		AtLeastOneOf: []string{"admin_password", "admin_rsa_public_key"},
-		// We have no way of assuming a role into this account to change the admin rsa public key so this has to be ForceNew even though it's not ideal
-		ForceNew:              true,
+		// We have no way of assuming a role into this account to change the admin rsa public key, so it is used only during the creation and later changes fail the plan (see accountImmutableFieldsCustomizeDiff)
 		DiffSuppressOnRefresh: true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// For new resources always show the diff
@@ -86,10 +87,9 @@ var accountSchema = map[string]*schema.Schema{
 		Type:         schema.TypeString,
 		Required:     true,
 		Sensitive:    true,
-		Description:  "Email address of the initial administrative user of the account. This email address is used to send any notifications about the account.",
+		Description:  "Email address of the initial administrative user of the account. This email address is used to send any notifications about the account. It cannot be changed after the creation; such a change fails the plan.",
 		ValidateFunc: snowflakeValidation.ValidateEmail,
-		// We have no way of assuming a role into this account to change the admin email so this has to be ForceNew even though it's not ideal
-		ForceNew:              true,
+		// We have no way of assuming a role into this account to change the admin email, so it is used only during the creation and later changes fail the plan (see accountImmutableFieldsCustomizeDiff)
 		DiffSuppressOnRefresh: true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// For new resources always show the diff
@@ -103,17 +103,15 @@ var accountSchema = map[string]*schema.Schema{
 	"edition": {
 		Type:         schema.TypeString,
 		Required:     true,
-		ForceNew:     true,
-		Description:  "[Snowflake Edition](https://docs.snowflake.com/en/user-guide/intro-editions.html) of the account. Valid values are: STANDARD | ENTERPRISE | BUSINESS_CRITICAL",
+		Description:  "[Snowflake Edition](https://docs.snowflake.com/en/user-guide/intro-editions.html) of the account. Valid values are: STANDARD | ENTERPRISE | BUSINESS_CRITICAL. The edition cannot be changed after the creation; such a change fails the plan instead of replacing the account.",
 		ValidateFunc: validation.StringInSlice([]string{string(sdk.EditionStandard), string(sdk.EditionEnterprise), string(sdk.EditionBusinessCritical)}, false),
 	},
 	"first_name": {
 		Type:        schema.TypeString,
 		Optional:    true,
 		Sensitive:   true,
-		Description: "First name of the initial administrative user of the account",
-		// We have no way of assuming a role into this account to change the admin first name so this has to be ForceNew even though it's not ideal
-		ForceNew:              true,
+		Description: "First name of the initial administrative user of the account. It cannot be changed after the creation; such a change fails the plan.",
+		// We have no way of assuming a role into this account to change the admin first name, so it is used only during the creation and later changes fail the plan (see accountImmutableFieldsCustomizeDiff)
 		DiffSuppressOnRefresh: true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// For new resources always show the diff
@@ -128,9 +126,8 @@ var accountSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		Sensitive:   true,
-		Description: "Last name of the initial administrative user of the account",
-		// We have no way of assuming a role into this account to change the admin last name so this has to be ForceNew even though it's not ideal
-		ForceNew:              true,
+		Description: "Last name of the initial administrative user of the account. It cannot be changed after the creation; such a change fails the plan.",
+		// We have no way of assuming a role into this account to change the admin last name, so it is used only during the creation and later changes fail the plan (see accountImmutableFieldsCustomizeDiff)
 		DiffSuppressOnRefresh: true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// For new resources always show the diff
@@ -145,9 +142,8 @@ var accountSchema = map[string]*schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Specifies whether the new user created to administer the account is forced to change their password upon first login into the account.",
-		// We have no way of assuming a role into this account to change the admin password policy so this has to be ForceNew even though it's not ideal
-		ForceNew:              true,
+		Description: "Specifies whether the new user created to administer the account is forced to change their password upon first login into the account. It cannot be changed after the creation; such a change fails the plan.",
+		// We have no way of assuming a role into this account to change the admin password policy, so it is used only during the creation and later changes fail the plan (see accountImmutableFieldsCustomizeDiff)
 		DiffSuppressOnRefresh: true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// For new resources always show the diff
@@ -161,8 +157,7 @@ var accountSchema = map[string]*schema.Schema{
 	"region_group": {
 		Type:                  schema.TypeString,
 		Optional:              true,
-		Description:           "ID of the Snowflake Region where the account is created. If no value is provided, Snowflake creates the account in the same Snowflake Region as the current account (i.e. the account in which the CREATE ACCOUNT statement is executed.)",
-		ForceNew:              true,
+		Description:           "ID of the Snowflake Region where the account is created. If no value is provided, Snowflake creates the account in the same Snowflake Region as the current account (i.e. the account in which the CREATE ACCOUNT statement is executed.) It cannot be changed after the creation; such a change fails the plan instead of replacing the account.",
 		DiffSuppressOnRefresh: true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// For new resources always show the diff
@@ -176,8 +171,7 @@ var accountSchema = map[string]*schema.Schema{
 	"region": {
 		Type:                  schema.TypeString,
 		Optional:              true,
-		Description:           "ID of the Snowflake Region where the account is created. If no value is provided, Snowflake creates the account in the same Snowflake Region as the current account (i.e. the account in which the CREATE ACCOUNT statement is executed.)",
-		ForceNew:              true,
+		Description:           "ID of the Snowflake Region where the account is created. If no value is provided, Snowflake creates the account in the same Snowflake Region as the current account (i.e. the account in which the CREATE ACCOUNT statement is executed.) It cannot be changed after the creation; such a change fails the plan instead of replacing the account.",
 		DiffSuppressOnRefresh: true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// For new resources always show the diff
@@ -192,44 +186,145 @@ var accountSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		Description: "Specifies a comment for the account.",
-		ForceNew:    true,
+	},
+	"save_old_url": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the original account URL is kept when the account is renamed. If TRUE, the account can be accessed with the old URL until it is dropped with `ALTER ACCOUNT ... DROP OLD URL`.",
 	},
 	"is_org_admin": {
 		Type:        schema.TypeBool,
+		Optional:    true,
 		Computed:    true,
-		Description: "Indicates whether the ORGADMIN role is enabled in an account. If TRUE, the role is enabled.",
+		Description: "Indicates whether the ORGADMIN role is enabled in an account. If TRUE, the role is enabled. Only one account in the organization can have the ORGADMIN role enabled, so it has to be disabled in the other account first.",
 	},
-	"grace_period_in_days": {
-		Type:        schema.TypeInt,
+	"organization_parameters": {
+		Type:        schema.TypeMap,
 		Optional:    true,
-		Default:     3,
-		Description: "Specifies the number of days to wait before dropping the account. The default is 3 days.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: fmt.Sprintf("Organization-level parameters of the account set with SYSTEM$GLOBAL_ACCOUNT_SET_PARAMETER. Valid keys are: %v. The parameters cannot be read back from Snowflake, so they are only stored in the state; a removed parameter is set to `false`.", sdk.AllGlobalAccountParameters),
+		ValidateDiagFunc: func(v any, path cty.Path) diag.Diagnostics {
+			var diags diag.Diagnostics
+			for key := range v.(map[string]any) {
+				if !slices.Contains(sdk.AllGlobalAccountParameters, sdk.GlobalAccountParameter(strings.ToUpper(key))) {
+					diags = append(diags, diag.Diagnostic{
+						Severity:      diag.Error,
+						Summary:       fmt.Sprintf("invalid organization parameter %s, valid parameters are: %v", key, sdk.AllGlobalAccountParameters),
+						AttributePath: path,
+					})
+				}
+			}
+			return diags
+		},
+	},
+	"grace_period_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      3,
+		Description:  "Specifies the number of days to wait before dropping the account. The default is 3 days. A dropped account is restored with UNDROP ACCOUNT when an account with the same name is created again within this period.",
+		ValidateFunc: validation.IntAtLeast(3),
 	},
 }
 
 func Account() *schema.Resource {
 	return &schema.Resource{
-		Description: "The account resource allows you to create and manage Snowflake accounts.",
-		Create:      CreateAccount,
-		Read:        ReadAccount,
-		Update:      UpdateAccount,
-		Delete:      DeleteAccount,
+		Description:   "The account resource allows you to create and manage Snowflake accounts.",
+		CreateContext: CreateAccount,
+		ReadContext:   ReadAccount,
+		UpdateContext: UpdateAccount,
+		DeleteContext: DeleteAccount,
 
 		Schema: accountSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: accountImmutableFieldsCustomizeDiff,
 	}
 }
 
-// CreateAccount implements schema.CreateFunc.
-func CreateAccount(d *schema.ResourceData, meta interface{}) error {
+// accountImmutableFieldsCustomizeDiff fails the plan when a field that cannot be altered changes, because an account should never be replaced.
+func accountImmutableFieldsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	for _, field := range []string{"edition", "region_group", "region"} {
+		if diff.HasChange(field) {
+			o, n := diff.GetChange(field)
+			return fmt.Errorf("%s of the account cannot be changed from %v to %v; the account would have to be recreated, which is not supported, so the change has to be done by Snowflake support", field, o, n)
+		}
+	}
+	// the values of the initial administrative user are not printed, most of them are sensitive
+	for _, field := range []string{"admin_name", "admin_password", "admin_rsa_public_key", "email", "first_name", "last_name", "must_change_password"} {
+		if diff.HasChange(field) {
+			return fmt.Errorf("%s of the account cannot be changed after the creation; it is used only to create the initial administrative user, which has to be changed in the account itself", field)
+		}
+	}
+	return nil
+}
+
+// CreateAccount implements schema.CreateContextFunc.
+func CreateAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 
 	name := d.Get("name").(string)
 	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
 
+	// an account dropped within its grace period keeps its name, so it is restored instead of creating a new one
+	if dropped, err := client.Accounts.ShowDroppedByID(ctx, objectIdentifier); err == nil {
+		if edition := sdk.AccountEdition(d.Get("edition").(string)); dropped.Edition != edition {
+			return diag.Errorf("account %s was dropped and is scheduled for deletion, but it cannot be restored because its edition is %s instead of %s", name, dropped.Edition, edition)
+		}
+		log.Printf("[DEBUG] restoring dropped account %s", name)
+		if err := client.Accounts.Undrop(ctx, objectIdentifier); err != nil {
+			return diag.FromErr(fmt.Errorf("error restoring dropped account %s err = %w", name, err))
+		}
+	} else {
+		createOptions, err := createAccountOptions(ctx, client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.Accounts.Create(ctx, objectIdentifier, createOptions); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var account *sdk.Account
+	err := helpers.Retry(5, 3*time.Second, func() (error, bool) {
+		var err error
+		account, err = client.Accounts.ShowByID(ctx, objectIdentifier)
+		if err != nil {
+			return nil, false
+		}
+		return nil, true
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(account.AccountLocator))
+
+	// the comment of a restored account is the one it had before being dropped
+	if comment := d.Get("comment").(string); comment != account.Comment {
+		if err := setAccountComment(ctx, client, account.ID(), comment); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if v := d.GetRawConfig().GetAttr("is_org_admin"); !v.IsNull() && v.True() != account.IsOrgAdmin {
+		if err := setAccountIsOrgAdmin(ctx, client, account.ID(), v.True()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	for parameter, value := range d.Get("organization_parameters").(map[string]any) {
+		if err := client.Accounts.SetGlobalParameter(ctx, account.AccountID(), sdk.GlobalAccountParameter(strings.ToUpper(parameter)), value.(string)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting organization parameter %s of account %s err = %w", parameter, name, err))
+		}
+	}
+
+	return ReadAccount(ctx, d, meta)
+}
+
+func createAccountOptions(ctx context.Context, client *sdk.Client, d *schema.ResourceData) (*sdk.CreateAccountOptions, error) {
 	createOptions := &sdk.CreateAccountOptions{
 		AdminName: d.Get("admin_name").(string),
 		Email:     d.Get("email").(string),
@@ -260,7 +355,7 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 		// For organizations that have accounts in multiple region groups, returns <region_group>.<region> so we need to split on "."
 		currentRegion, err := client.ContextFunctions.CurrentRegion(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		regionParts := strings.Split(currentRegion, ".")
 		if len(regionParts) == 2 {
@@ -273,7 +368,7 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 		// For organizations that have accounts in multiple region groups, returns <region_group>.<region> so we need to split on "."
 		currentRegion, err := client.ContextFunctions.CurrentRegion(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		regionParts := strings.Split(currentRegion, ".")
 		if len(regionParts) == 2 {
@@ -285,32 +380,37 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("comment"); ok {
 		createOptions.Comment = sdk.String(v.(string))
 	}
+	return createOptions, nil
+}
 
-	err := client.Accounts.Create(ctx, objectIdentifier, createOptions)
+func setAccountComment(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, comment string) error {
+	err := client.Comments.Set(ctx, &sdk.SetCommentOptions{
+		ObjectType: sdk.ObjectTypeAccount,
+		ObjectName: id,
+		Value:      sdk.String(comment),
+	})
 	if err != nil {
-		return err
+		return fmt.Errorf("error setting comment of account %s err = %w", id.Name(), err)
 	}
+	return nil
+}
 
-	var account *sdk.Account
-	err = helpers.Retry(5, 3*time.Second, func() (error, bool) {
-		account, err = client.Accounts.ShowByID(ctx, objectIdentifier)
-		if err != nil {
-			return nil, false
-		}
-		return nil, true
+func setAccountIsOrgAdmin(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, isOrgAdmin bool) error {
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		OrgAdmin: &sdk.AccountOrgAdmin{
+			Name:       id,
+			IsOrgAdmin: sdk.Bool(isOrgAdmin),
+		},
 	})
 	if err != nil {
-		return err
+		return fmt.Errorf("error setting is_org_admin of account %s err = %w", id.Name(), err)
 	}
-
-	d.SetId(helpers.EncodeSnowflakeID(account.AccountLocator))
 	return nil
 }
 
-// ReadAccount implements schema.ReadFunc.
-func ReadAccount(d *schema.ResourceData, meta interface{}) error {
+// ReadAccount implements schema.ReadContextFunc.
+func ReadAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
@@ -324,69 +424,102 @@ func ReadAccount(d *schema.ResourceData, meta interface{}) error {
 		return nil, true
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("name", acc.AccountName); err != nil {
-		return fmt.Errorf("error setting name: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting name: %w", err))
 	}
 
 	if err = d.Set("edition", acc.Edition); err != nil {
-		return fmt.Errorf("error setting edition: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting edition: %w", err))
 	}
 
 	if err = d.Set("region_group", acc.RegionGroup); err != nil {
-		return fmt.Errorf("error setting region_group: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting region_group: %w", err))
 	}
 
 	if err = d.Set("region", acc.SnowflakeRegion); err != nil {
-		return fmt.Errorf("error setting region: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting region: %w", err))
 	}
 
 	if err = d.Set("comment", acc.Comment); err != nil {
-		return fmt.Errorf("error setting comment: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting comment: %w", err))
 	}
 
 	if err = d.Set("is_org_admin", acc.IsOrgAdmin); err != nil {
-		return fmt.Errorf("error setting is_org_admin: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting is_org_admin: %w", err))
 	}
 
 	return nil
 }
 
-// UpdateAccount implements schema.UpdateFunc.
-func UpdateAccount(d *schema.ResourceData, meta interface{}) error {
-	/*
-		todo: comments may eventually work again for accounts, so this can be uncommented when that happens
-		client := meta.(*provider.Context).Client
-		client := sdk.NewClientFromDB(db)
-		ctx := context.Background()
-
-		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
-
-		// Change comment
-		if d.HasChange("comment") {
-			// changing comment isn't supported for accounts
-			err := client.Comments.Set(ctx, &sdk.SetCommentOptions{
-				ObjectType: sdk.ObjectTypeAccount,
-				ObjectName: sdk.NewAccountObjectIdentifier(d.Get("name").(string)),
-				Value:      sdk.String(d.Get("comment").(string)),
-			})
-			if err != nil {
-				return err
+// UpdateAccount implements schema.UpdateContextFunc.
+func UpdateAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	// the account is identified by its locator, so the id does not change with the name
+	oldName, newName := d.GetChange("name")
+	id := sdk.NewAccountObjectIdentifier(oldName.(string))
+
+	if d.HasChange("name") {
+		newId := sdk.NewAccountObjectIdentifier(newName.(string))
+		err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+			Rename: &sdk.AccountRename{
+				Name:       id,
+				NewName:    newId,
+				SaveOldURL: sdk.Bool(d.Get("save_old_url").(bool)),
+			},
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming account %s err = %w", id.Name(), err))
+		}
+		id = newId
+	}
+
+	if d.HasChange("comment") {
+		if err := setAccountComment(ctx, client, id, d.Get("comment").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("is_org_admin") {
+		if err := setAccountIsOrgAdmin(ctx, client, id, d.Get("is_org_admin").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("organization_parameters") {
+		account, err := client.Accounts.ShowByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		o, n := d.GetChange("organization_parameters")
+		oldParameters, newParameters := o.(map[string]any), n.(map[string]any)
+		for parameter := range oldParameters {
+			if _, ok := newParameters[parameter]; !ok {
+				newParameters[parameter] = "false"
 			}
 		}
-	*/
-	return nil
+		for parameter, value := range newParameters {
+			if oldValue, ok := oldParameters[parameter]; ok && oldValue == value {
+				continue
+			}
+			if err := client.Accounts.SetGlobalParameter(ctx, account.AccountID(), sdk.GlobalAccountParameter(strings.ToUpper(parameter)), value.(string)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting organization parameter %s of account %s err = %w", parameter, id.Name(), err))
+			}
+		}
+	}
+
+	return ReadAccount(ctx, d, meta)
 }
 
-// DeleteAccount implements schema.DeleteFunc.
-func DeleteAccount(d *schema.ResourceData, meta interface{}) error {
+// DeleteAccount implements schema.DeleteContextFunc.
+func DeleteAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 	gracePeriodInDays := d.Get("grace_period_in_days").(int)
 	err := client.Accounts.Drop(ctx, helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier), gracePeriodInDays, &sdk.DropAccountOptions{
 		IfExists: sdk.Bool(true),
 	})
-	return err
+	return diag.FromErr(err)
 }
@@ -1,7 +1,9 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -9,8 +11,11 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -18,6 +23,7 @@ func TestAcc_Account_complete(t *testing.T) {
 	_ = testenvs.GetOrSkipTest(t, testenvs.TestAccountCreate)
 
 	accountName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newAccountName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	password := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha) + "123ABC"
 
 	resource.Test(t, resource.TestCase{
@@ -31,7 +37,7 @@ func TestAcc_Account_complete(t *testing.T) {
 		// unless we change the resource to return nil on destroy then this is unavoidable
 		Steps: []resource.TestStep{
 			{
-				Config: accountConfig(accountName, password, "Terraform acceptance test", 3),
+				Config: accountConfig(accountName, password, "BUSINESS_CRITICAL", "Terraform acceptance test", 3),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_account.test", "name", accountName),
 					resource.TestCheckResourceAttr("snowflake_account.test", "admin_name", "someadmin"),
@@ -47,11 +53,35 @@ func TestAcc_Account_complete(t *testing.T) {
 			},
 			// Change Grace Period In Days
 			{
-				Config: accountConfig(accountName, password, "Terraform acceptance test", 4),
+				Config: accountConfig(accountName, password, "BUSINESS_CRITICAL", "Terraform acceptance test", 4),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_account.test", "grace_period_in_days", "4"),
 				),
 			},
+			// Rename and change comment in place
+			{
+				Config: accountConfig(newAccountName, password, "BUSINESS_CRITICAL", "Terraform acceptance test - updated", 4),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_account.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account.test", "name", newAccountName),
+					resource.TestCheckResourceAttr("snowflake_account.test", "comment", "Terraform acceptance test - updated"),
+					resource.TestCheckResourceAttr("snowflake_account.test", "is_org_admin", "false"),
+				),
+			},
+			// Edition cannot be changed and the account is not replaced
+			{
+				Config:      accountConfig(newAccountName, password, "ENTERPRISE", "Terraform acceptance test - updated", 4),
+				ExpectError: regexp.MustCompile("edition of the account cannot be changed"),
+			},
+			// The initial administrative user cannot be changed after the creation
+			{
+				Config:      strings.Replace(accountConfig(newAccountName, password, "BUSINESS_CRITICAL", "Terraform acceptance test - updated", 4), "someadmin", "otheradmin", 1),
+				ExpectError: regexp.MustCompile("admin_name of the account cannot be changed after the creation"),
+			},
 			// IMPORT
 			{
 				ResourceName:      "snowflake_account.test",
@@ -66,13 +96,63 @@ func TestAcc_Account_complete(t *testing.T) {
 					"first_name",
 					"last_name",
 					"grace_period_in_days",
+					"save_old_url",
 				},
 			},
 		},
 	})
 }
 
-func accountConfig(name string, password string, comment string, gracePeriodInDays int) string {
+func TestAcc_Account_undropOnCreate(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestAccountCreate)
+
+	accountName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	password := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha) + "123ABC"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Account),
+		Steps: []resource.TestStep{
+			{
+				Config: accountConfig(accountName, password, "BUSINESS_CRITICAL", "Terraform acceptance test", 3),
+				Check:  resource.TestCheckResourceAttr("snowflake_account.test", "name", accountName),
+			},
+			// Drop the account, it stays within its grace period
+			{
+				Config: `data "snowflake_current_account" "current" {}`,
+				Check:  checkAccountDropped(t, accountName),
+			},
+			// Creating the account with the same name restores the dropped one
+			{
+				Config: accountConfig(accountName, password, "BUSINESS_CRITICAL", "Terraform acceptance test", 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account.test", "name", accountName),
+					resource.TestCheckResourceAttr("snowflake_account.test", "edition", "BUSINESS_CRITICAL"),
+				),
+			},
+		},
+	})
+}
+
+func checkAccountDropped(t *testing.T, accountName string) resource.TestCheckFunc {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		dropped, err := acc.Client(t).Accounts.ShowDroppedByID(context.Background(), sdk.NewAccountObjectIdentifier(accountName))
+		if err != nil {
+			return fmt.Errorf("dropped account %s not found: %w", accountName, err)
+		}
+		if dropped.DroppedOn == nil {
+			return fmt.Errorf("account %s is not dropped", accountName)
+		}
+		return nil
+	}
+}
+
+func accountConfig(name string, password string, edition string, comment string, gracePeriodInDays int) string {
 	return fmt.Sprintf(`
 data "snowflake_current_account" "current" {}
 
@@ -84,10 +164,10 @@ resource "snowflake_account" "test" {
   last_name = "Min"
   email = "admin@example.com"
   must_change_password = false
-  edition = "BUSINESS_CRITICAL"
+  edition = "%s"
   comment = "%s"
   region = data.snowflake_current_account.current.region
   grace_period_in_days = %d
 }
-`, name, password, comment, gracePeriodInDays)
+`, name, password, edition, comment, gracePeriodInDays)
 }
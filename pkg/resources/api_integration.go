@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -24,9 +25,9 @@ var apiIntegrationSchema = map[string]*schema.Schema{
 	"api_provider": {
 		Type:         schema.TypeString,
 		Required:     true,
-		ValidateFunc: validation.StringInSlice([]string{"aws_api_gateway", "aws_private_api_gateway", "azure_api_management", "aws_gov_api_gateway", "aws_gov_private_api_gateway", "google_api_gateway"}, false),
+		ValidateFunc: validation.StringInSlice([]string{"aws_api_gateway", "aws_private_api_gateway", "azure_api_management", "aws_gov_api_gateway", "aws_gov_private_api_gateway", "google_api_gateway", "git_https_api"}, false),
 		ForceNew:     true,
-		Description:  "Specifies the HTTPS proxy service type.",
+		Description:  "Specifies the HTTPS proxy service type, or `git_https_api` for an integration used by Git repositories.",
 	},
 	"api_aws_role_arn": {
 		Type:        schema.TypeString,
@@ -80,6 +81,15 @@ var apiIntegrationSchema = map[string]*schema.Schema{
 		Description: "The service account used for communication with the Google API Gateway.",
 		Computed:    true,
 	},
+	"allowed_authentication_secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Optional:    true,
+		Description: "Specifies the fully qualified names of the secrets that the Git repositories using the integration can authenticate with. Only used with the `git_https_api` provider. Removing all the secrets recreates the integration.",
+	},
 	"api_allowed_prefixes": {
 		Type:        schema.TypeList,
 		Elem:        &schema.Schema{Type: schema.TypeString},
@@ -125,12 +135,47 @@ func APIIntegration() *schema.Resource {
 		Delete: DeleteAPIIntegration,
 
 		Schema: apiIntegrationSchema,
+		// Snowflake cannot unset the allowed authentication secrets, so the integration has to be recreated without them.
+		CustomizeDiff: customdiff.ForceNewIfChange("allowed_authentication_secrets", func(ctx context.Context, old, new, meta any) bool {
+			return old.(*schema.Set).Len() > 0 && new.(*schema.Set).Len() == 0
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+func toApiIntegrationSecrets(secrets []any) []sdk.SchemaObjectIdentifier {
+	ids := make([]sdk.SchemaObjectIdentifier, len(secrets))
+	for i, secret := range secrets {
+		ids[i] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(secret.(string))
+	}
+	return ids
+}
+
+// apiIntegrationSecretNames parses the ALLOWED_AUTHENTICATION_SECRETS property returned by DESCRIBE INTEGRATION.
+// The secrets already in the state are kept in the form used in the configuration.
+func apiIntegrationSecretNames(current []any, value string) []string {
+	currentNames := make(map[string]string, len(current))
+	for _, name := range current {
+		currentNames[sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name.(string)).FullyQualifiedName()] = name.(string)
+	}
+	names := make([]string, 0)
+	for _, secret := range strings.Split(strings.Trim(value, "[]"), ",") {
+		secret = strings.TrimSpace(secret)
+		if secret == "" {
+			continue
+		}
+		fullyQualifiedName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(secret).FullyQualifiedName()
+		if name, ok := currentNames[fullyQualifiedName]; ok {
+			names = append(names, name)
+		} else {
+			names = append(names, fullyQualifiedName)
+		}
+	}
+	return names
+}
+
 func toApiIntegrationEndpointPrefix(paths []string) []sdk.ApiIntegrationEndpointPrefix {
 	allowedPrefixes := make([]sdk.ApiIntegrationEndpointPrefix, len(paths))
 	for i, prefix := range paths {
@@ -195,6 +240,12 @@ func CreateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 		}
 		googleParams := sdk.NewGoogleApiParamsRequest(audience.(string))
 		createRequest.WithGoogleApiProviderParams(googleParams)
+	case "git_https_api":
+		gitHttpsParams := sdk.NewGitHttpsApiParamsRequest()
+		if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+			gitHttpsParams.WithAllowedAuthenticationSecrets(toApiIntegrationSecrets(v.(*schema.Set).List()))
+		}
+		createRequest.WithGitHttpsApiProviderParams(gitHttpsParams)
 	default:
 		return fmt.Errorf("unexpected provider %v", apiProvider)
 	}
@@ -301,6 +352,10 @@ func ReadAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("api_gcp_service_account", value); err != nil {
 				return err
 			}
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			if err := d.Set("allowed_authentication_secrets", apiIntegrationSecretNames(d.Get("allowed_authentication_secrets").(*schema.Set).List(), value)); err != nil {
+				return err
+			}
 		case "API_PROVIDER":
 			if err := d.Set("api_provider", strings.ToLower(value)); err != nil {
 				return err
@@ -385,6 +440,12 @@ func UpdateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 			googleParams := sdk.NewSetGoogleApiParamsRequest(d.Get("google_audience").(string))
 			setRequest.WithGoogleParams(googleParams)
 		}
+	case "git_https_api":
+		// Removing all the secrets recreates the integration, see CustomizeDiff.
+		if v := d.Get("allowed_authentication_secrets").(*schema.Set).List(); d.HasChange("allowed_authentication_secrets") && len(v) > 0 {
+			runSetStatement = true
+			setRequest.WithGitHttpsParams(sdk.NewSetGitHttpsApiParamsRequest(toApiIntegrationSecrets(v)))
+		}
 	default:
 		return fmt.Errorf("unexpected provider %v", apiProvider)
 	}
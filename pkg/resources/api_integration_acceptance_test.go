@@ -286,6 +286,75 @@ func TestAcc_ApiIntegration_google(t *testing.T) {
 	})
 }
 
+func TestAcc_ApiIntegration_git(t *testing.T) {
+	const dummyGitPrefix = "https://github.com/my-account/"
+	const dummyGitOtherPrefix = "https://github.com/my-other-account/"
+
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	comment := "acceptance test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name": config.StringVariable(name),
+			"api_allowed_prefixes": config.ListVariable(
+				config.StringVariable(dummyGitPrefix),
+			),
+			"comment": config.StringVariable(comment),
+			"enabled": config.BoolVariable(true),
+		}
+	}
+	m2 := m()
+	m2["api_allowed_prefixes"] = config.ListVariable(
+		config.StringVariable(dummyGitOtherPrefix),
+	)
+	m2["comment"] = config.StringVariable("different comment")
+	m2["enabled"] = config.BoolVariable(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ApiIntegration),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "name", name),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "api_provider", "git_https_api"),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "api_allowed_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "api_allowed_prefixes.0", dummyGitPrefix),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "allowed_authentication_secrets.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "comment", comment),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("snowflake_api_integration.test_git_int", "created_on"),
+				),
+			},
+			// change parameters
+			{
+				ConfigDirectory: acc.ConfigurationSameAsStepN(1),
+				ConfigVariables: m2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "name", name),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "api_provider", "git_https_api"),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "api_allowed_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "api_allowed_prefixes.0", dummyGitOtherPrefix),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "comment", "different comment"),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "enabled", "false"),
+				),
+			},
+			// IMPORT
+			{
+				ConfigVariables:   m2,
+				ResourceName:      "snowflake_api_integration.test_git_int",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAcc_ApiIntegration_changeApiProvider(t *testing.T) {
 	const dummyAwsPrefix = "https://123456.execute-api.us-west-2.amazonaws.com/dev/"
 	const dummyAwsOtherPrefix = "https://123456.execute-api.us-west-2.amazonaws.com/prod/"
@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_apiIntegrationSecretNames(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, apiIntegrationSecretNames([]any{}, ""))
+		assert.Empty(t, apiIntegrationSecretNames([]any{}, "[]"))
+	})
+
+	t.Run("keeps the names from the configuration", func(t *testing.T) {
+		names := apiIntegrationSecretNames(
+			[]any{"DB.SCHEMA.SECRET_1"},
+			`["DB"."SCHEMA"."SECRET_1", DB.SCHEMA.SECRET_2]`,
+		)
+
+		assert.Equal(t, []string{"DB.SCHEMA.SECRET_1", `"DB"."SCHEMA"."SECRET_2"`}, names)
+	})
+}
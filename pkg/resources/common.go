@@ -1,7 +1,9 @@
 package resources
 
 import (
+	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +27,135 @@ func normalizeQuery(str string) string {
 	return strings.TrimSpace(space.ReplaceAllString(str, " "))
 }
 
+// DiffSuppressSQLQuery suppresses diffs between SQL queries which differ only in formatting, comments,
+// case of unquoted words or a trailing semicolon. Contrary to DiffSuppressStatement, string literals
+// and quoted identifiers have to match exactly.
+func DiffSuppressSQLQuery(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeSQLQuery(old) == normalizeSQLQuery(new)
+}
+
+// sqlTokens splits a query into tokens, dropping whitespace and comments. Quoted strings, quoted identifiers
+// and $$ literals are returned as single tokens.
+func sqlTokens(query string) []string {
+	tokens := make([]string, 0)
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-', r == '/' && i+1 < len(runes) && runes[i+1] == '/':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := strings.Index(string(runes[i+2:]), "*/")
+			if end < 0 {
+				i = len(runes)
+			} else {
+				i += 2 + len([]rune(string(runes[i+2:])[:end])) + 2
+			}
+		case r == '$' && i+1 < len(runes) && runes[i+1] == '$':
+			end := strings.Index(string(runes[i+2:]), "$$")
+			if end < 0 {
+				tokens = append(tokens, string(runes[i:]))
+				i = len(runes)
+			} else {
+				length := 2 + len([]rune(string(runes[i+2:])[:end])) + 2
+				tokens = append(tokens, string(runes[i:i+length]))
+				i += length
+			}
+		case r == '\'' || r == '"':
+			j := i + 1
+			for j < len(runes) {
+				if runes[j] == '\\' && r == '\'' {
+					j += 2
+					continue
+				}
+				if runes[j] == r {
+					// doubled quote is an escaped quote
+					if j+1 < len(runes) && runes[j+1] == r {
+						j += 2
+						continue
+					}
+					break
+				}
+				j++
+			}
+			if j >= len(runes) {
+				j = len(runes) - 1
+			}
+			tokens = append(tokens, string(runes[i:j+1]))
+			i = j + 1
+		case r == '_' || r == '@' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			j := i
+			for j < len(runes) && (runes[j] == '_' || runes[j] == '$' || runes[j] == '@' || unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		default:
+			tokens = append(tokens, string(r))
+			i++
+		}
+	}
+	return tokens
+}
+
+func isSQLWordToken(token string) bool {
+	r := []rune(token)[0]
+	return r == '_' || r == '@' || r == '\'' || r == '"' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// normalizeSQLQuery returns a canonical form of the query used for comparisons: unquoted words are upper-cased,
+// comments and a trailing semicolon are removed, and words are separated with exactly one space.
+func normalizeSQLQuery(query string) string {
+	tokens := sqlTokens(query)
+	for len(tokens) > 0 && tokens[len(tokens)-1] == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+	var b strings.Builder
+	for i, token := range tokens {
+		if i > 0 && isSQLWordToken(tokens[i-1]) && isSQLWordToken(token) {
+			b.WriteString(" ")
+		}
+		if first := token[0]; first != '\'' && first != '"' && first != '$' {
+			token = strings.ToUpper(token)
+		}
+		b.WriteString(token)
+	}
+	return b.String()
+}
+
+// extractQueryFromCreateStatement returns the query following the first top-level AS keyword of a CREATE statement,
+// e.g. the text column of SHOW VIEWS or SHOW DYNAMIC TABLES.
+func extractQueryFromCreateStatement(statement string) (string, error) {
+	runes := []rune(statement)
+	depth := 0
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'' || r == '"':
+			for i++; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' && r == '\'' {
+					i++
+				}
+			}
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case depth == 0 && (r == 'a' || r == 'A') && i+1 < len(runes) && (runes[i+1] == 's' || runes[i+1] == 'S'):
+			before := i == 0 || unicode.IsSpace(runes[i-1]) || runes[i-1] == ')'
+			after := i+2 == len(runes) || unicode.IsSpace(runes[i+2]) || runes[i+2] == '('
+			if before && after {
+				return strings.TrimSpace(string(runes[i+2:])), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("could not find the query in statement: %s", statement)
+}
+
 // TODO [SNOW-999049]: address during identifiers rework
 func suppressIdentifierQuoting(_, oldValue, newValue string, _ *schema.ResourceData) bool {
 	if oldValue == "" || newValue == "" {
@@ -47,3 +47,65 @@ func Test_suppressIdentifierQuoting(t *testing.T) {
 		require.False(t, result)
 	})
 }
+
+func Test_normalizeSQLQuery(t *testing.T) {
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{name: "formatting", old: "select a, b from t where a > 1", new: "SELECT a,b\n  FROM t\n  WHERE a>1;", expected: true},
+		{name: "comments", old: "select a -- first column\nfrom t /* table */", new: "select a from t", expected: true},
+		{name: "quoted identifiers", old: `select "a" from t`, new: `select "A" from t`, expected: false},
+		{name: "string literals", old: "select 'a' from t", new: "select 'A' from t", expected: false},
+		{name: "string literal with spaces", old: "select 'a  b' from t", new: "select 'a b' from t", expected: false},
+		{name: "different queries", old: "select a from t", new: "select b from t", expected: false},
+		{name: "escaped quote", old: "select 'it''s -- not a comment' from t", new: "SELECT 'it''s -- not a comment' FROM t", expected: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, DiffSuppressSQLQuery("", tc.old, tc.new, nil))
+		})
+	}
+}
+
+func Test_extractQueryFromCreateStatement(t *testing.T) {
+	testCases := []struct {
+		name      string
+		statement string
+		expected  string
+	}{
+		{
+			name:      "dynamic table",
+			statement: `create or replace transient dynamic table "DB"."SCHEMA"."DT" target_lag = 'DOWNSTREAM' warehouse = "WH" cluster by (a, lower(b)) comment = 'as you like' copy grants as select a, b from t`,
+			expected:  "select a, b from t",
+		},
+		{
+			name:      "view with column list",
+			statement: "CREATE VIEW v (a COMMENT 'x as y', b) AS\nSELECT a, b FROM t",
+			expected:  "SELECT a, b FROM t",
+		},
+		{
+			name:      "quoted identifier",
+			statement: `CREATE VIEW "AS" AS SELECT 1`,
+			expected:  "SELECT 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := extractQueryFromCreateStatement(tc.statement)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, query)
+		})
+	}
+
+	t.Run("no query", func(t *testing.T) {
+		_, err := extractQueryFromCreateStatement("CREATE VIEW v")
+		require.Error(t, err)
+	})
+}
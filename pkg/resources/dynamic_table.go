@@ -11,18 +11,23 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var refreshModePattern = regexp.MustCompile(`refresh_mode = '(\w+)'`)
+var (
+	refreshModePattern = regexp.MustCompile(`(?i)refresh_mode\s*=\s*'?(\w+)'?`)
+	initializePattern  = regexp.MustCompile(`(?i)initialize\s*=\s*'?(\w+)'?`)
+	transientPattern   = regexp.MustCompile(`(?i)^\s*create\s+(or\s+replace\s+)?transient\s`)
+)
 
 var dynamicTableSchema = map[string]*schema.Schema{
 	"or_replace": {
 		Type:        schema.TypeBool,
 		Optional:    true,
-		Description: "Specifies whether to replace the dynamic table if it already exists.",
+		Description: "Specifies whether to replace the dynamic table if it already exists. It is used only during the creation; the query changes replace the table regardless of it, so it is not read back from Snowflake.",
 		Default:     false,
 	},
 	"name": {
@@ -35,11 +40,20 @@ var dynamicTableSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "The database in which to create the dynamic table.",
+		ForceNew:    true,
 	},
 	"schema": {
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "The schema in which to create the dynamic table.",
+		ForceNew:    true,
+	},
+	"transient": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies that the dynamic table is transient, i.e. it does not have a Fail-safe period.",
 	},
 	"target_lag": {
 		Type:        schema.TypeList,
@@ -51,14 +65,14 @@ var dynamicTableSchema = map[string]*schema.Schema{
 				"maximum_duration": {
 					Type:          schema.TypeString,
 					Optional:      true,
-					ConflictsWith: []string{"target_lag.downstream"},
+					ConflictsWith: []string{"target_lag.0.downstream"},
 					Description:   "Specifies the maximum target lag time for the dynamic table.",
 				},
 				"downstream": {
 					Type:          schema.TypeBool,
 					Optional:      true,
-					ConflictsWith: []string{"target_lag.maximum_duration"},
-					Description:   "Specifies whether the target lag time is downstream.",
+					ConflictsWith: []string{"target_lag.0.maximum_duration"},
+					Description:   "Specifies whether the target lag time is downstream, i.e. the dynamic table is refreshed only when its dependents need to be refreshed.",
 				},
 			},
 		},
@@ -71,15 +85,29 @@ var dynamicTableSchema = map[string]*schema.Schema{
 	"query": {
 		Type:             schema.TypeString,
 		Required:         true,
-		ForceNew:         true,
-		Description:      "Specifies the query to use to populate the dynamic table.",
-		DiffSuppressFunc: DiffSuppressStatement,
+		Description:      "Specifies the query to use to populate the dynamic table. Changes that only affect formatting, comments or keyword case are ignored; other changes replace the table in place with CREATE OR REPLACE.",
+		DiffSuppressFunc: DiffSuppressSQLQuery,
 	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
 		Description: "Specifies a comment for the dynamic table.",
 	},
+	"cluster_by": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			DiffSuppressFunc: DiffSuppressSQLQuery,
+		},
+		Optional:    true,
+		Description: "A list of one or more table columns/expressions to be used as clustering key(s) for the dynamic table.",
+	},
+	"copy_grants": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Retains the access permissions from the original dynamic table when it is recreated after a query change.",
+	},
 	"refresh_mode": {
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -96,16 +124,23 @@ var dynamicTableSchema = map[string]*schema.Schema{
 		ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllDynamicTableInitializes), true),
 		ForceNew:     true,
 	},
+	"suspended": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the scheduled refreshes of the dynamic table are suspended.",
+	},
+	"refresh_on_apply": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the dynamic table is refreshed manually after it is created or changed by Terraform.",
+	},
 	"created_on": {
 		Type:        schema.TypeString,
 		Description: "Time when this dynamic table was created.",
 		Computed:    true,
 	},
-	"cluster_by": {
-		Type:        schema.TypeString,
-		Description: "The clustering key for the dynamic table.",
-		Computed:    true,
-	},
 	"rows": {
 		Type:        schema.TypeInt,
 		Description: "Number of rows in the table.",
@@ -161,103 +196,102 @@ var dynamicTableSchema = map[string]*schema.Schema{
 // DynamicTable returns a pointer to the resource representing a dynamic table.
 func DynamicTable() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateDynamicTable,
-		Read:   ReadDynamicTable,
-		Update: UpdateDynamicTable,
-		Delete: DeleteDynamicTable,
+		CreateContext: CreateDynamicTable,
+		ReadContext:   ReadDynamicTable,
+		UpdateContext: UpdateDynamicTable,
+		DeleteContext: DeleteDynamicTable,
 
 		Schema: dynamicTableSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v089DynamicTableClusterByStateUpgrader,
+			},
+		},
 	}
 }
 
-// ReadDynamicTable implements schema.ReadFunc.
-func ReadDynamicTable(d *schema.ResourceData, meta interface{}) error {
+// ReadDynamicTable implements schema.ReadContextFunc.
+func ReadDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	dynamicTable, err := client.DynamicTables.ShowByID(context.Background(), id)
+	dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
 	if err != nil {
 		log.Printf("[DEBUG] dynamic table (%s) not found", d.Id())
 		d.SetId("")
 		return nil
 	}
 	if err := d.Set("name", dynamicTable.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("database", dynamicTable.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("schema", dynamicTable.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("warehouse", dynamicTable.Warehouse); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("comment", dynamicTable.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	tl := map[string]interface{}{}
 	if dynamicTable.TargetLag == "DOWNSTREAM" {
 		tl["downstream"] = true
-		if err := d.Set("target_lag", []interface{}{tl}); err != nil {
-			return err
-		}
 	} else {
 		tl["maximum_duration"] = dynamicTable.TargetLag
-		if err := d.Set("target_lag", []interface{}{tl}); err != nil {
-			return err
-		}
 	}
-	if strings.Contains(dynamicTable.Text, "OR REPLACE") {
-		if err := d.Set("or_replace", true); err != nil {
-			return err
-		}
-	} else {
-		if err := d.Set("or_replace", false); err != nil {
-			return err
-		}
+	if err := d.Set("target_lag", []interface{}{tl}); err != nil {
+		return diag.FromErr(err)
 	}
-	if strings.Contains(dynamicTable.Text, "initialize = 'ON_CREATE'") {
-		if err := d.Set("initialize", "ON_CREATE"); err != nil {
-			return err
-		}
-	} else if strings.Contains(dynamicTable.Text, "initialize = 'ON_SCHEDULE'") {
-		if err := d.Set("initialize", "ON_SCHEDULE"); err != nil {
-			return err
+	if err := d.Set("transient", transientPattern.MatchString(dynamicTable.Text)); err != nil {
+		return diag.FromErr(err)
+	}
+	if m := initializePattern.FindStringSubmatch(dynamicTable.Text); len(m) > 1 {
+		if err := d.Set("initialize", strings.ToUpper(m[1])); err != nil {
+			return diag.FromErr(err)
 		}
 	}
-	m := refreshModePattern.FindStringSubmatch(dynamicTable.Text)
-	if len(m) > 1 {
-		if err := d.Set("refresh_mode", m[1]); err != nil {
-			return err
+	if m := refreshModePattern.FindStringSubmatch(dynamicTable.Text); len(m) > 1 {
+		if err := d.Set("refresh_mode", strings.ToUpper(m[1])); err != nil {
+			return diag.FromErr(err)
 		}
 	}
-	if err := d.Set("created_on", dynamicTable.CreatedOn.Format(time.RFC3339)); err != nil {
-		return err
+	if err := d.Set("cluster_by", dynamicTable.GetClusterByKeys()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("suspended", dynamicTable.SchedulingState == sdk.DynamicTableSchedulingStateSuspended); err != nil {
+		return diag.FromErr(err)
 	}
-	if err := d.Set("cluster_by", dynamicTable.ClusterBy); err != nil {
-		return err
+	if err := d.Set("created_on", dynamicTable.CreatedOn.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
 	}
 	if err := d.Set("rows", dynamicTable.Rows); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("bytes", dynamicTable.Bytes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("owner", dynamicTable.Owner); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("refresh_mode_reason", dynamicTable.RefreshModeReason); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("automatic_clustering", dynamicTable.AutomaticClustering); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("scheduling_state", string(dynamicTable.SchedulingState)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	/*
 		guides on time formatting
@@ -266,25 +300,24 @@ func ReadDynamicTable(d *schema.ResourceData, meta interface{}) error {
 		note: format may depend on what the account parameter for TIMESTAMP_OUTPUT_FORMAT is set to. Perhaps we should return this as a string rather than a time.Time?
 	*/
 	if err := d.Set("last_suspended_on", dynamicTable.LastSuspendedOn.Format("2006-01-02T16:04:05.000 -0700")); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("is_clone", dynamicTable.IsClone); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("is_replica", dynamicTable.IsReplica); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("data_timestamp", dynamicTable.DataTimestamp.Format("2006-01-02T16:04:05.000 -0700")); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	extractor := snowflake.NewViewSelectStatementExtractor(dynamicTable.Text)
-	query, err := extractor.ExtractDynamicTable()
+	query, err := extractQueryFromCreateStatement(dynamicTable.Text)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("query", query); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -293,7 +326,7 @@ func ReadDynamicTable(d *schema.ResourceData, meta interface{}) error {
 func parseTargetLag(v interface{}) sdk.TargetLag {
 	var result sdk.TargetLag
 	tl := v.([]interface{})[0].(map[string]interface{})
-	if v, ok := tl["maximum_duration"]; ok {
+	if v, ok := tl["maximum_duration"]; ok && v.(string) != "" {
 		result.MaximumDuration = sdk.String(v.(string))
 	}
 	if v, ok := tl["downstream"]; ok && v.(bool) {
@@ -303,20 +336,13 @@ func parseTargetLag(v interface{}) sdk.TargetLag {
 	return result
 }
 
-// CreateDynamicTable implements schema.CreateFunc.
-func CreateDynamicTable(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*provider.Context).Client
-
-	databaseName := d.Get("database").(string)
-	schemaName := d.Get("schema").(string)
-	name := d.Get("name").(string)
-	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
-
+func createDynamicTableRequest(d *schema.ResourceData, id sdk.SchemaObjectIdentifier) *sdk.CreateDynamicTableRequest {
 	warehouse := sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string))
 	tl := parseTargetLag(d.Get("target_lag"))
 	query := d.Get("query").(string)
 
-	request := sdk.NewCreateDynamicTableRequest(id, warehouse, tl, query)
+	request := sdk.NewCreateDynamicTableRequest(id, warehouse, tl, query).
+		WithTransient(d.Get("transient").(bool))
 	if v, ok := d.GetOk("comment"); ok {
 		request.WithComment(sdk.String(v.(string)))
 	}
@@ -329,20 +355,66 @@ func CreateDynamicTable(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("initialize"); ok {
 		request.WithInitialize(sdk.DynamicTableInitialize(v.(string)))
 	}
-	if err := client.DynamicTables.Create(context.Background(), request); err != nil {
-		return err
+	if v, ok := d.GetOk("cluster_by"); ok {
+		request.WithClusterBy(expandStringList(v.([]interface{})))
+	}
+	return request
+}
+
+// applyDynamicTableState suspends the dynamic table and refreshes it on demand after it was created or changed.
+func applyDynamicTableState(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) error {
+	if d.Get("suspended").(bool) {
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithSuspend(sdk.Bool(true))); err != nil {
+			return err
+		}
+	}
+	if d.Get("refresh_on_apply").(bool) {
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithRefresh(sdk.Bool(true))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateDynamicTable implements schema.CreateContextFunc.
+func CreateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	if err := client.DynamicTables.Create(ctx, createDynamicTableRequest(d, id)); err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadDynamicTable(d, meta)
+	if err := applyDynamicTableState(ctx, client, d, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDynamicTable(ctx, d, meta)
 }
 
-// UpdateDynamicTable implements schema.UpdateFunc.
-func UpdateDynamicTable(d *schema.ResourceData, meta interface{}) error {
+// UpdateDynamicTable implements schema.UpdateContextFunc.
+func UpdateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	request := sdk.NewAlterDynamicTableRequest(id)
+
+	// there is no ALTER for the query, so the table is replaced, which also applies all the other properties
+	if d.HasChange("query") {
+		request := createDynamicTableRequest(d, id).
+			WithOrReplace(true).
+			WithCopyGrants(d.Get("copy_grants").(bool))
+		if err := client.DynamicTables.Create(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := applyDynamicTableState(ctx, client, d, id); err != nil {
+			return diag.FromErr(err)
+		}
+		return ReadDynamicTable(ctx, d, meta)
+	}
 
 	runSet := false
 	set := sdk.NewDynamicTableSetRequest()
@@ -359,9 +431,20 @@ func UpdateDynamicTable(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSet {
-		request.WithSet(set)
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		request := sdk.NewAlterDynamicTableRequest(id)
+		if clusterBy := expandStringList(d.Get("cluster_by").([]interface{})); len(clusterBy) > 0 {
+			request.WithClusterBy(clusterBy)
+		} else {
+			request.WithDropClusteringKey(sdk.Bool(true))
+		}
 		if err := client.DynamicTables.Alter(ctx, request); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -372,19 +455,38 @@ func UpdateDynamicTable(d *schema.ResourceData, meta interface{}) error {
 			Value:      sdk.String(d.Get("comment").(string)),
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return ReadDynamicTable(d, meta)
+
+	if d.HasChange("suspended") {
+		request := sdk.NewAlterDynamicTableRequest(id)
+		if d.Get("suspended").(bool) {
+			request.WithSuspend(sdk.Bool(true))
+		} else {
+			request.WithResume(sdk.Bool(true))
+		}
+		if err := client.DynamicTables.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("refresh_on_apply").(bool) {
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithRefresh(sdk.Bool(true))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadDynamicTable(ctx, d, meta)
 }
 
-// DeleteDynamicTable implements schema.DeleteFunc.
-func DeleteDynamicTable(d *schema.ResourceData, meta interface{}) error {
+// DeleteDynamicTable implements schema.DeleteContextFunc.
+func DeleteDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	if err := client.DynamicTables.Drop(context.Background(), sdk.NewDropDynamicTableRequest(id)); err != nil {
-		return err
+	if err := client.DynamicTables.Drop(ctx, sdk.NewDropDynamicTableRequest(id)); err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 
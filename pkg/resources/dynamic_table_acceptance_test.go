@@ -151,6 +151,85 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// not stored in Snowflake
+				ImportStateVerifyIgnore: []string{"copy_grants", "refresh_on_apply", "or_replace"},
+			},
+		},
+	})
+}
+
+func TestAcc_DynamicTable_clusteringAndSuspension(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_dynamic_table.dt"
+	tableName := name + "_table"
+	m := func(clusterBy []string, suspended bool) map[string]config.Variable {
+		clusterByVariables := make([]config.Variable, len(clusterBy))
+		for i, key := range clusterBy {
+			clusterByVariables[i] = config.StringVariable(key)
+		}
+		return map[string]config.Variable{
+			"name":       config.StringVariable(name),
+			"database":   config.StringVariable(acc.TestDatabaseName),
+			"schema":     config.StringVariable(acc.TestSchemaName),
+			"warehouse":  config.StringVariable(acc.TestWarehouseName),
+			"query":      config.StringVariable(fmt.Sprintf(`select "id", "data" from "%v"."%v"."%v"`, acc.TestDatabaseName, acc.TestSchemaName, tableName)),
+			"table_name": config.StringVariable(tableName),
+			"cluster_by": config.ListVariable(clusterByVariables...),
+			"suspended":  config.BoolVariable(suspended),
+		}
+	}
+
+	// used to check whether a dynamic table was replaced
+	var createdOn string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.DynamicTable),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_DynamicTable_clusteringAndSuspension"),
+				ConfigVariables: m([]string{`"id"`}, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transient", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.0", `"id"`),
+					resource.TestCheckResourceAttr(resourceName, "suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling_state", string(sdk.DynamicTableSchedulingStateActive)),
+					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+						createdOn = value
+						return nil
+					}),
+				),
+			},
+			// change clustering keys and suspend in place
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_DynamicTable_clusteringAndSuspension"),
+				ConfigVariables: m([]string{`"id"`, `"data"`}, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate)},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.1", `"data"`),
+					resource.TestCheckResourceAttr(resourceName, "suspended", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling_state", string(sdk.DynamicTableSchedulingStateSuspended)),
+					resource.TestCheckResourceAttr(resourceName, "created_on", createdOn),
+				),
+			},
+			// drop clustering keys and resume
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_DynamicTable_clusteringAndSuspension"),
+				ConfigVariables: m([]string{}, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling_state", string(sdk.DynamicTableSchedulingStateActive)),
+					resource.TestCheckResourceAttr(resourceName, "created_on", createdOn),
+				),
 			},
 		},
 	})
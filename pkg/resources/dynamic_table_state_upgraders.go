@@ -0,0 +1,26 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// v089DynamicTableClusterByStateUpgrader converts the computed cluster_by string (e.g. LINEAR(a, b)) to the list of clustering keys.
+func v089DynamicTableClusterByStateUpgrader(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	clusterBy, ok := rawState["cluster_by"].(string)
+	if !ok {
+		return rawState, nil
+	}
+	keys := make([]interface{}, 0)
+	for _, key := range (&sdk.DynamicTable{ClusterBy: clusterBy}).GetClusterByKeys() {
+		keys = append(keys, key)
+	}
+	rawState["cluster_by"] = keys
+
+	return rawState, nil
+}
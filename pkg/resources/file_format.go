@@ -315,6 +315,8 @@ func FileFormat() *schema.Resource {
 		Update: UpdateFileFormat,
 		Delete: DeleteFileFormat,
 
+		DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_file_format_csv, snowflake_file_format_json, snowflake_file_format_parquet, snowflake_file_format_avro, snowflake_file_format_orc or snowflake_file_format_xml instead.",
+
 		Schema: fileFormatSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -429,7 +431,7 @@ func CreateFileFormat(d *schema.ResourceData, meta interface{}) error {
 				}
 				nullIf = append(nullIf, sdk.NullString{S: s.(string)})
 			}
-			opts.JSONNullIf = nullIf
+			opts.JSONNullIf = &nullIf
 		}
 		if v, ok := d.GetOk("file_extension"); ok {
 			opts.JSONFileExtension = sdk.String(v.(string))
@@ -649,7 +651,7 @@ func ReadFileFormat(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 		nullIf := []string{}
-		for _, s := range fileFormat.Options.JSONNullIf {
+		for _, s := range *fileFormat.Options.JSONNullIf {
 			nullIf = append(nullIf, s.S)
 		}
 		if err := d.Set("null_if", nullIf); err != nil {
@@ -941,7 +943,7 @@ func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
 				}
 				nullIf = append(nullIf, sdk.NullString{S: s.(string)})
 			}
-			opts.Set.JSONNullIf = nullIf
+			opts.Set.JSONNullIf = &nullIf
 			runSet = true
 		}
 		if d.HasChange("file_extension") {
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fileFormatAvroOptionsSchema = map[string]*schema.Schema{
+	"compression":                fileFormatEnumOption("Specifies the compression algorithm of the data files.", sdk.AllAvroCompressions),
+	"trim_space":                 fileFormatBoolOption("Boolean that specifies whether to remove leading and trailing white space from strings."),
+	"replace_invalid_characters": fileFormatBoolOption("Boolean that specifies whether to replace invalid UTF-8 characters with the Unicode replacement character (�)."),
+	"null_if":                    fileFormatNullIfOption(),
+}
+
+func fileFormatAvroOptions(d *schema.ResourceData) sdk.FileFormatTypeOptions {
+	return sdk.FileFormatTypeOptions{
+		AvroCompression:              (*sdk.AvroCompression)(fileFormatOption[string](d, "compression")),
+		AvroTrimSpace:                fileFormatOption[bool](d, "trim_space"),
+		AvroReplaceInvalidCharacters: fileFormatOption[bool](d, "replace_invalid_characters"),
+		AvroNullIf:                   fileFormatNullIf(d),
+	}
+}
+
+func fileFormatAvroAttributes(options sdk.FileFormatTypeOptions) map[string]any {
+	attributes := map[string]any{
+		"compression":                (*string)(options.AvroCompression),
+		"trim_space":                 options.AvroTrimSpace,
+		"replace_invalid_characters": options.AvroReplaceInvalidCharacters,
+	}
+	if options.AvroNullIf != nil {
+		attributes["null_if"] = flattenFileFormatNullIf(*options.AvroNullIf)
+	}
+	return attributes
+}
+
+// FileFormatAvro returns a pointer to the resource representing a file format of the AVRO type.
+func FileFormatAvro() *schema.Resource {
+	read := readFileFormatForType(sdk.FileFormatTypeAvro, fileFormatAvroAttributes)
+	return &schema.Resource{
+		CreateContext: createFileFormatForType(sdk.FileFormatTypeAvro, read, fileFormatAvroOptions),
+		ReadContext:   read,
+		UpdateContext: updateFileFormatForType(read, fileFormatAvroOptionsSchema, fileFormatAvroOptions),
+		DeleteContext: deleteFileFormatForType,
+
+		Description: "Resource used to manage file formats for Avro files. For more information, check [file format documentation](https://docs.snowflake.com/en/sql-reference/sql/create-file-format#type-avro).",
+
+		Schema: fileFormatSchemaWithOptions(fileFormatAvroOptionsSchema),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: fileFormatOptionsCustomizeDiff(fileFormatAvroAttributes),
+	}
+}
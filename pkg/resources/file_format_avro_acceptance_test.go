@@ -0,0 +1,76 @@
+package resources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_FileFormatAvro_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_file_format_avro.test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":     config.StringVariable(name),
+			"database": config.StringVariable(acc.TestDatabaseName),
+			"schema":   config.StringVariable(acc.TestSchemaName),
+		}
+	}
+	variableSet2 := m()
+	variableSet2["compression"] = config.StringVariable("GZIP")
+	variableSet2["trim_space"] = config.BoolVariable(true)
+	variableSet2["null_if"] = config.ListVariable(config.StringVariable("NULL"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.FileFormatAvro),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_FileFormatAvro/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "compression", "AUTO"),
+					resource.TestCheckResourceAttr(resourceName, "trim_space", "false"),
+				),
+			},
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_FileFormatAvro/basic"),
+				ConfigVariables: variableSet2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compression", "GZIP"),
+					resource.TestCheckResourceAttr(resourceName, "trim_space", "true"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.0", "NULL"),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_FileFormatAvro/basic"),
+				ConfigVariables:   variableSet2,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// options removed from the configuration are reset to the defaults
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_FileFormatAvro/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compression", "AUTO"),
+					resource.TestCheckResourceAttr(resourceName, "trim_space", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,311 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// fileFormatSchemaWithOptions returns the attributes shared by all the per-type file format resources merged with the
+// given format type options. The options are optional and computed, so the defaults Snowflake reports for the given type
+// are read back from DESCRIBE FILE FORMAT instead of being hardcoded; the ones removed from the configuration are reset to
+// these defaults by fileFormatOptionsCustomizeDiff.
+func fileFormatSchemaWithOptions(options map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Specifies the identifier for the file format; must be unique for the database and schema in which the file format is created.",
+		},
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The database in which to create the file format.",
+		},
+		"schema": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The schema in which to create the file format.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies a comment for the file format.",
+		},
+	}
+	maps.Copy(s, options)
+	return s
+}
+
+func fileFormatStringOption(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+	}
+}
+
+func fileFormatEnumOption[T ~string](description string, values []T) *schema.Schema {
+	s := fileFormatStringOption(fmt.Sprintf("%s Valid values are (case-insensitive): %v.", description, sdk.AsStringList(values)))
+	s.ValidateDiagFunc = validation.ToDiagFunc(validation.StringInSlice(sdk.AsStringList(values), true))
+	s.DiffSuppressFunc = ignoreCaseSuppressFunc
+	return s
+}
+
+func fileFormatBoolOption(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+	}
+}
+
+func fileFormatNullIfOption() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Computed:    true,
+		Description: "Strings used to convert to and from SQL NULL.",
+	}
+}
+
+// fileFormatOption returns the value of the given option if it is set in the configuration. On update (when the id is
+// already set), it returns the value only if it changed, which includes the reset planned by fileFormatOptionsCustomizeDiff
+// for the options removed from the configuration.
+func fileFormatOption[T any](d *schema.ResourceData, attribute string) *T {
+	if d.Id() == "" && d.GetRawConfig().GetAttr(attribute).IsNull() {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange(attribute) {
+		return nil
+	}
+	value := d.Get(attribute).(T)
+	return &value
+}
+
+func fileFormatNullIf(d *schema.ResourceData) *[]sdk.NullString {
+	values := fileFormatOption[[]any](d, "null_if")
+	if values == nil {
+		return nil
+	}
+	nullIf := make([]sdk.NullString, len(*values))
+	for i, v := range *values {
+		nullIf[i] = sdk.NullString{S: v.(string)}
+	}
+	return &nullIf
+}
+
+// flattenFileFormatNullIf returns the null_if values; DESCRIBE FILE FORMAT reports no values as [], which the SDK parses
+// as a single empty string.
+func flattenFileFormatNullIf(nullIf []sdk.NullString) []string {
+	if len(nullIf) == 1 && nullIf[0].S == "" {
+		return []string{}
+	}
+	values := make([]string, len(nullIf))
+	for i, v := range nullIf {
+		values[i] = v.S
+	}
+	return values
+}
+
+func createFileFormatForType(formatType sdk.FileFormatType, read schema.ReadContextFunc, options func(d *schema.ResourceData) sdk.FileFormatTypeOptions) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+		opts := &sdk.CreateFileFormatOptions{
+			Type:                  formatType,
+			FileFormatTypeOptions: options(d),
+		}
+		if v, ok := d.GetOk("comment"); ok {
+			opts.Comment = sdk.String(v.(string))
+		}
+		if err := client.FileFormats.Create(ctx, id, opts); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating file format %v err = %w", id.FullyQualifiedName(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(id))
+
+		return read(ctx, d, meta)
+	}
+}
+
+// readFileFormatForType returns a read function setting the common attributes from SHOW FILE FORMATS and the format type
+// options from DESCRIBE FILE FORMAT; setOptions returns the values of the options by attribute name.
+func readFileFormatForType(formatType sdk.FileFormatType, setOptions func(options sdk.FileFormatTypeOptions) map[string]any) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+		fileFormat, err := client.FileFormats.ShowByID(ctx, id)
+		if err != nil {
+			log.Printf("[DEBUG] file format (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		if fileFormat.Type != formatType {
+			return diag.FromErr(fmt.Errorf("expected %v to be a %v file format, got %v", id.FullyQualifiedName(), formatType, fileFormat.Type))
+		}
+		if err := d.Set("name", id.Name()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("database", id.DatabaseName()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("schema", id.SchemaName()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("comment", fileFormat.Comment); err != nil {
+			return diag.FromErr(err)
+		}
+
+		details, err := client.FileFormats.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("could not describe file format: %w", err))
+		}
+		for attribute, value := range setOptions(details.Options) {
+			if err := d.Set(attribute, value); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return nil
+	}
+}
+
+// fileFormatOptionsCustomizeDiff resets the options removed from the configuration to the defaults DESCRIBE FILE FORMAT
+// reports for the format type. The options are computed, so without it removing an option would not plan any change and
+// the value set before would stay in Snowflake.
+func fileFormatOptionsCustomizeDiff(setOptions func(options sdk.FileFormatTypeOptions) map[string]any) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		if diff.Id() == "" {
+			return nil
+		}
+		client := meta.(*provider.Context).Client
+		id := helpers.DecodeSnowflakeID(diff.Id()).(sdk.SchemaObjectIdentifier)
+
+		details, err := client.FileFormats.Describe(ctx, id)
+		if err != nil {
+			// the file format dropped outside of Terraform is re-created with the configured options only
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				return nil
+			}
+			return fmt.Errorf("could not describe file format: %w", err)
+		}
+		for attribute, value := range setOptions(details.Defaults) {
+			if !diff.GetRawConfig().GetAttr(attribute).IsNull() {
+				continue
+			}
+			defaultValue, ok := fileFormatOptionValue(value)
+			if !ok || fileFormatOptionEqual(diff.Get(attribute), defaultValue) {
+				continue
+			}
+			if err := diff.SetNew(attribute, defaultValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// fileFormatOptionValue dereferences the option value returned by the per-type attributes function; it returns false if
+// the value is not set.
+func fileFormatOptionValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case *string:
+		if v == nil {
+			return nil, false
+		}
+		return *v, true
+	case *bool:
+		if v == nil {
+			return nil, false
+		}
+		return *v, true
+	case *int:
+		if v == nil {
+			return nil, false
+		}
+		return *v, true
+	case []string:
+		return v, true
+	}
+	return nil, false
+}
+
+func fileFormatOptionEqual(current any, defaultValue any) bool {
+	switch v := defaultValue.(type) {
+	case string:
+		return strings.EqualFold(current.(string), v)
+	case []string:
+		values := current.([]any)
+		if len(values) != len(v) {
+			return false
+		}
+		for i := range v {
+			if values[i] != v[i] {
+				return false
+			}
+		}
+		return true
+	}
+	return current == defaultValue
+}
+
+// updateFileFormatForType returns an update function renaming the file format and setting the changed options returned
+// by options.
+func updateFileFormatForType(read schema.ReadContextFunc, optionsSchema map[string]*schema.Schema, options func(d *schema.ResourceData) sdk.FileFormatTypeOptions) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+		if d.HasChange("name") {
+			newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
+			if err := client.FileFormats.Alter(ctx, id, &sdk.AlterFileFormatOptions{Rename: &sdk.AlterFileFormatRenameOptions{NewName: newId}}); err != nil {
+				return diag.FromErr(fmt.Errorf("error renaming file format %v err = %w", id.FullyQualifiedName(), err))
+			}
+			d.SetId(helpers.EncodeSnowflakeID(newId))
+			id = newId
+		}
+
+		attributes := []string{"comment"}
+		for attribute := range optionsSchema {
+			attributes = append(attributes, attribute)
+		}
+		if d.HasChanges(attributes...) {
+			set := options(d)
+			if d.HasChange("comment") {
+				set.Comment = sdk.String(d.Get("comment").(string))
+			}
+			if err := client.FileFormats.Alter(ctx, id, &sdk.AlterFileFormatOptions{Set: &set}); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating file format %v err = %w", id.FullyQualifiedName(), err))
+			}
+		}
+
+		return read(ctx, d, meta)
+	}
+}
+
+func deleteFileFormatForType(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.FileFormats.Drop(ctx, id, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting file format %v err = %w", id.FullyQualifiedName(), err))
+	}
+	d.SetId("")
+	return nil
+}
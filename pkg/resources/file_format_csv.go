@@ -0,0 +1,117 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var fileFormatCsvOptionsSchema = map[string]*schema.Schema{
+	"compression":      fileFormatEnumOption("Specifies the compression algorithm of the data files.", sdk.AllCSVCompressions),
+	"record_delimiter": fileFormatStringOption("Specifies one or more singlebyte or multibyte characters that separate records in an input file (data loading) or unloaded file (data unloading)."),
+	"field_delimiter":  fileFormatStringOption("Specifies one or more singlebyte or multibyte characters that separate fields in an input file (data loading) or unloaded file (data unloading)."),
+	"file_extension":   fileFormatStringOption("Specifies the extension for files unloaded to a stage."),
+	"parse_header": func() *schema.Schema {
+		s := fileFormatBoolOption("Boolean that specifies whether to use the first row headers in the data files to determine column names.")
+		s.ConflictsWith = []string{"skip_header"}
+		return s
+	}(),
+	"skip_header": {
+		Type:          schema.TypeInt,
+		Optional:      true,
+		Computed:      true,
+		ValidateFunc:  validation.IntAtLeast(0),
+		ConflictsWith: []string{"parse_header"},
+		Description:   "Number of lines at the start of the file to skip.",
+	},
+	"skip_blank_lines":               fileFormatBoolOption("Boolean that specifies to skip any blank lines encountered in the data files."),
+	"date_format":                    fileFormatStringOption("Defines the format of date values in the data files (data loading) or table (data unloading)."),
+	"time_format":                    fileFormatStringOption("Defines the format of time values in the data files (data loading) or table (data unloading)."),
+	"timestamp_format":               fileFormatStringOption("Defines the format of timestamp values in the data files (data loading) or table (data unloading)."),
+	"binary_format":                  fileFormatEnumOption("Defines the encoding format for binary input or output.", sdk.AllBinaryFormats),
+	"escape":                         fileFormatStringOption("Single character string used as the escape character for field values."),
+	"escape_unenclosed_field":        fileFormatStringOption("Single character string used as the escape character for unenclosed field values only."),
+	"trim_space":                     fileFormatBoolOption("Boolean that specifies whether to remove white space from fields."),
+	"field_optionally_enclosed_by":   fileFormatStringOption("Character used to enclose strings: `NONE`, single quote character (`'`) or double quote character (`\"`)."),
+	"null_if":                        fileFormatNullIfOption(),
+	"error_on_column_count_mismatch": fileFormatBoolOption("Boolean that specifies whether to generate a parsing error if the number of delimited columns (i.e. fields) in an input file does not match the number of columns in the corresponding table."),
+	"replace_invalid_characters":     fileFormatBoolOption("Boolean that specifies whether to replace invalid UTF-8 characters with the Unicode replacement character (�)."),
+	"empty_field_as_null":            fileFormatBoolOption("Specifies whether to insert SQL NULL for empty fields in an input file, which are represented by two successive delimiters."),
+	"skip_byte_order_mark":           fileFormatBoolOption("Boolean that specifies whether to skip the BOM (byte order mark), if present in a data file."),
+	"encoding":                       fileFormatEnumOption("Specifies the character set of the source data when loading data into a table.", sdk.AllCSVEncodings),
+}
+
+func fileFormatCsvOptions(d *schema.ResourceData) sdk.FileFormatTypeOptions {
+	return sdk.FileFormatTypeOptions{
+		CSVCompression:                (*sdk.CSVCompression)(fileFormatOption[string](d, "compression")),
+		CSVRecordDelimiter:            fileFormatOption[string](d, "record_delimiter"),
+		CSVFieldDelimiter:             fileFormatOption[string](d, "field_delimiter"),
+		CSVFileExtension:              fileFormatOption[string](d, "file_extension"),
+		CSVParseHeader:                fileFormatOption[bool](d, "parse_header"),
+		CSVSkipHeader:                 fileFormatOption[int](d, "skip_header"),
+		CSVSkipBlankLines:             fileFormatOption[bool](d, "skip_blank_lines"),
+		CSVDateFormat:                 fileFormatOption[string](d, "date_format"),
+		CSVTimeFormat:                 fileFormatOption[string](d, "time_format"),
+		CSVTimestampFormat:            fileFormatOption[string](d, "timestamp_format"),
+		CSVBinaryFormat:               (*sdk.BinaryFormat)(fileFormatOption[string](d, "binary_format")),
+		CSVEscape:                     fileFormatOption[string](d, "escape"),
+		CSVEscapeUnenclosedField:      fileFormatOption[string](d, "escape_unenclosed_field"),
+		CSVTrimSpace:                  fileFormatOption[bool](d, "trim_space"),
+		CSVFieldOptionallyEnclosedBy:  fileFormatOption[string](d, "field_optionally_enclosed_by"),
+		CSVNullIf:                     fileFormatNullIf(d),
+		CSVErrorOnColumnCountMismatch: fileFormatOption[bool](d, "error_on_column_count_mismatch"),
+		CSVReplaceInvalidCharacters:   fileFormatOption[bool](d, "replace_invalid_characters"),
+		CSVEmptyFieldAsNull:           fileFormatOption[bool](d, "empty_field_as_null"),
+		CSVSkipByteOrderMark:          fileFormatOption[bool](d, "skip_byte_order_mark"),
+		CSVEncoding:                   (*sdk.CSVEncoding)(fileFormatOption[string](d, "encoding")),
+	}
+}
+
+func fileFormatCsvAttributes(options sdk.FileFormatTypeOptions) map[string]any {
+	attributes := map[string]any{
+		"compression":                    (*string)(options.CSVCompression),
+		"record_delimiter":               options.CSVRecordDelimiter,
+		"field_delimiter":                options.CSVFieldDelimiter,
+		"file_extension":                 options.CSVFileExtension,
+		"parse_header":                   options.CSVParseHeader,
+		"skip_header":                    options.CSVSkipHeader,
+		"skip_blank_lines":               options.CSVSkipBlankLines,
+		"date_format":                    options.CSVDateFormat,
+		"time_format":                    options.CSVTimeFormat,
+		"timestamp_format":               options.CSVTimestampFormat,
+		"binary_format":                  (*string)(options.CSVBinaryFormat),
+		"escape":                         options.CSVEscape,
+		"escape_unenclosed_field":        options.CSVEscapeUnenclosedField,
+		"trim_space":                     options.CSVTrimSpace,
+		"field_optionally_enclosed_by":   options.CSVFieldOptionallyEnclosedBy,
+		"error_on_column_count_mismatch": options.CSVErrorOnColumnCountMismatch,
+		"replace_invalid_characters":     options.CSVReplaceInvalidCharacters,
+		"empty_field_as_null":            options.CSVEmptyFieldAsNull,
+		"skip_byte_order_mark":           options.CSVSkipByteOrderMark,
+		"encoding":                       (*string)(options.CSVEncoding),
+	}
+	if options.CSVNullIf != nil {
+		attributes["null_if"] = flattenFileFormatNullIf(*options.CSVNullIf)
+	}
+	return attributes
+}
+
+// FileFormatCsv returns a pointer to the resource representing a file format of the CSV type.
+func FileFormatCsv() *schema.Resource {
+	read := readFileFormatForType(sdk.FileFormatTypeCSV, fileFormatCsvAttributes)
+	return &schema.Resource{
+		CreateContext: createFileFormatForType(sdk.FileFormatTypeCSV, read, fileFormatCsvOptions),
+		ReadContext:   read,
+		UpdateContext: updateFileFormatForType(read, fileFormatCsvOptionsSchema, fileFormatCsvOptions),
+		DeleteContext: deleteFileFormatForType,
+
+		Description: "Resource used to manage file formats for CSV (and other delimited) files. For more information, check [file format documentation](https://docs.snowflake.com/en/sql-reference/sql/create-file-format#type-csv).",
+
+		Schema: fileFormatSchemaWithOptions(fileFormatCsvOptionsSchema),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: fileFormatOptionsCustomizeDiff(fileFormatCsvAttributes),
+	}
+}
@@ -0,0 +1,84 @@
+package resources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_FileFormatCsv_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_file_format_csv.test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":     config.StringVariable(name),
+			"database": config.StringVariable(acc.TestDatabaseName),
+			"schema":   config.StringVariable(acc.TestSchemaName),
+		}
+	}
+	variableSet2 := m()
+	variableSet2["name"] = config.StringVariable(newName)
+	variableSet2["comment"] = config.StringVariable("some comment")
+	variableSet2["compression"] = config.StringVariable("gzip")
+	variableSet2["field_delimiter"] = config.StringVariable("|")
+	variableSet2["skip_header"] = config.IntegerVariable(1)
+	variableSet2["null_if"] = config.ListVariable(config.StringVariable("NULL"), config.StringVariable(""))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.FileFormatCsv),
+		Steps: []resource.TestStep{
+			// defaults are read back from Snowflake
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_FileFormatCsv/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "compression", "AUTO"),
+					resource.TestCheckResourceAttr(resourceName, "field_delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "skip_header", "0"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.0", "\\N"),
+					resource.TestCheckResourceAttr(resourceName, "encoding", "UTF8"),
+				),
+			},
+			// rename and set options in place
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_FileFormatCsv/basic"),
+				ConfigVariables: variableSet2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "compression", "GZIP"),
+					resource.TestCheckResourceAttr(resourceName, "field_delimiter", "|"),
+					resource.TestCheckResourceAttr(resourceName, "skip_header", "1"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.0", "NULL"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.1", ""),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_FileFormatCsv/basic"),
+				ConfigVariables:   variableSet2,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fileFormatJsonOptionsSchema = map[string]*schema.Schema{
+	"compression":                fileFormatEnumOption("Specifies the compression algorithm of the data files.", sdk.AllJSONCompressions),
+	"date_format":                fileFormatStringOption("Defines the format of date string values in the data files."),
+	"time_format":                fileFormatStringOption("Defines the format of time string values in the data files."),
+	"timestamp_format":           fileFormatStringOption("Defines the format of timestamp string values in the data files."),
+	"binary_format":              fileFormatEnumOption("Defines the encoding format for binary string values in the data files.", sdk.AllBinaryFormats),
+	"trim_space":                 fileFormatBoolOption("Boolean that specifies whether to remove leading and trailing white space from strings."),
+	"null_if":                    fileFormatNullIfOption(),
+	"file_extension":             fileFormatStringOption("Specifies the extension for files unloaded to a stage."),
+	"enable_octal":               fileFormatBoolOption("Boolean that enables parsing of octal numbers."),
+	"allow_duplicate":            fileFormatBoolOption("Boolean that specifies to allow duplicate object field names (only the last one will be preserved)."),
+	"strip_outer_array":          fileFormatBoolOption("Boolean that instructs the JSON parser to remove outer brackets."),
+	"strip_null_values":          fileFormatBoolOption("Boolean that instructs the JSON parser to remove object fields or array elements containing null values."),
+	"replace_invalid_characters": fileFormatBoolOption("Boolean that specifies whether to replace invalid UTF-8 characters with the Unicode replacement character (�). Cannot be enabled together with `ignore_utf8_errors`."),
+	"ignore_utf8_errors":         fileFormatBoolOption("Boolean that specifies whether UTF-8 encoding errors produce error conditions. Cannot be enabled together with `replace_invalid_characters`."),
+	"skip_byte_order_mark":       fileFormatBoolOption("Boolean that specifies whether to skip the BOM (byte order mark), if present in a data file."),
+}
+
+func fileFormatJsonOptions(d *schema.ResourceData) sdk.FileFormatTypeOptions {
+	return sdk.FileFormatTypeOptions{
+		JSONCompression:              (*sdk.JSONCompression)(fileFormatOption[string](d, "compression")),
+		JSONDateFormat:               fileFormatOption[string](d, "date_format"),
+		JSONTimeFormat:               fileFormatOption[string](d, "time_format"),
+		JSONTimestampFormat:          fileFormatOption[string](d, "timestamp_format"),
+		JSONBinaryFormat:             (*sdk.BinaryFormat)(fileFormatOption[string](d, "binary_format")),
+		JSONTrimSpace:                fileFormatOption[bool](d, "trim_space"),
+		JSONNullIf:                   fileFormatNullIf(d),
+		JSONFileExtension:            fileFormatOption[string](d, "file_extension"),
+		JSONEnableOctal:              fileFormatOption[bool](d, "enable_octal"),
+		JSONAllowDuplicate:           fileFormatOption[bool](d, "allow_duplicate"),
+		JSONStripOuterArray:          fileFormatOption[bool](d, "strip_outer_array"),
+		JSONStripNullValues:          fileFormatOption[bool](d, "strip_null_values"),
+		JSONReplaceInvalidCharacters: fileFormatOption[bool](d, "replace_invalid_characters"),
+		JSONIgnoreUTF8Errors:         fileFormatOption[bool](d, "ignore_utf8_errors"),
+		JSONSkipByteOrderMark:        fileFormatOption[bool](d, "skip_byte_order_mark"),
+	}
+}
+
+func fileFormatJsonAttributes(options sdk.FileFormatTypeOptions) map[string]any {
+	attributes := map[string]any{
+		"compression":                (*string)(options.JSONCompression),
+		"date_format":                options.JSONDateFormat,
+		"time_format":                options.JSONTimeFormat,
+		"timestamp_format":           options.JSONTimestampFormat,
+		"binary_format":              (*string)(options.JSONBinaryFormat),
+		"trim_space":                 options.JSONTrimSpace,
+		"file_extension":             options.JSONFileExtension,
+		"enable_octal":               options.JSONEnableOctal,
+		"allow_duplicate":            options.JSONAllowDuplicate,
+		"strip_outer_array":          options.JSONStripOuterArray,
+		"strip_null_values":          options.JSONStripNullValues,
+		"replace_invalid_characters": options.JSONReplaceInvalidCharacters,
+		"ignore_utf8_errors":         options.JSONIgnoreUTF8Errors,
+		"skip_byte_order_mark":       options.JSONSkipByteOrderMark,
+	}
+	if options.JSONNullIf != nil {
+		attributes["null_if"] = flattenFileFormatNullIf(*options.JSONNullIf)
+	}
+	return attributes
+}
+
+// FileFormatJson returns a pointer to the resource representing a file format of the JSON type.
+func FileFormatJson() *schema.Resource {
+	read := readFileFormatForType(sdk.FileFormatTypeJSON, fileFormatJsonAttributes)
+	return &schema.Resource{
+		CreateContext: createFileFormatForType(sdk.FileFormatTypeJSON, read, fileFormatJsonOptions),
+		ReadContext:   read,
+		UpdateContext: updateFileFormatForType(read, fileFormatJsonOptionsSchema, fileFormatJsonOptions),
+		DeleteContext: deleteFileFormatForType,
+
+		Description: "Resource used to manage file formats for JSON files. For more information, check [file format documentation](https://docs.snowflake.com/en/sql-reference/sql/create-file-format#type-json).",
+
+		Schema: fileFormatSchemaWithOptions(fileFormatJsonOptionsSchema),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: fileFormatOptionsCustomizeDiff(fileFormatJsonAttributes),
+	}
+}
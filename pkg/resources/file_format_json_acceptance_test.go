@@ -0,0 +1,72 @@
+package resources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_FileFormatJson_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_file_format_json.test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":     config.StringVariable(name),
+			"database": config.StringVariable(acc.TestDatabaseName),
+			"schema":   config.StringVariable(acc.TestSchemaName),
+		}
+	}
+	variableSet2 := m()
+	variableSet2["comment"] = config.StringVariable("some comment")
+	variableSet2["compression"] = config.StringVariable("ZSTD")
+	variableSet2["strip_outer_array"] = config.BoolVariable(true)
+	variableSet2["null_if"] = config.ListVariable(config.StringVariable("NULL"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.FileFormatJson),
+		Steps: []resource.TestStep{
+			// defaults are read back from Snowflake, in particular the empty null_if of JSON
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_FileFormatJson/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "compression", "AUTO"),
+					resource.TestCheckResourceAttr(resourceName, "strip_outer_array", "false"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "binary_format", "HEX"),
+				),
+			},
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_FileFormatJson/basic"),
+				ConfigVariables: variableSet2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "compression", "ZSTD"),
+					resource.TestCheckResourceAttr(resourceName, "strip_outer_array", "true"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.0", "NULL"),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_FileFormatJson/basic"),
+				ConfigVariables:   variableSet2,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
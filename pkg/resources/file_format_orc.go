@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fileFormatOrcOptionsSchema = map[string]*schema.Schema{
+	"trim_space":                 fileFormatBoolOption("Boolean that specifies whether to remove leading and trailing white space from strings."),
+	"replace_invalid_characters": fileFormatBoolOption("Boolean that specifies whether to replace invalid UTF-8 characters with the Unicode replacement character (�)."),
+	"null_if":                    fileFormatNullIfOption(),
+}
+
+func fileFormatOrcOptions(d *schema.ResourceData) sdk.FileFormatTypeOptions {
+	return sdk.FileFormatTypeOptions{
+		ORCTrimSpace:                fileFormatOption[bool](d, "trim_space"),
+		ORCReplaceInvalidCharacters: fileFormatOption[bool](d, "replace_invalid_characters"),
+		ORCNullIf:                   fileFormatNullIf(d),
+	}
+}
+
+func fileFormatOrcAttributes(options sdk.FileFormatTypeOptions) map[string]any {
+	attributes := map[string]any{
+		"trim_space":                 options.ORCTrimSpace,
+		"replace_invalid_characters": options.ORCReplaceInvalidCharacters,
+	}
+	if options.ORCNullIf != nil {
+		attributes["null_if"] = flattenFileFormatNullIf(*options.ORCNullIf)
+	}
+	return attributes
+}
+
+// FileFormatOrc returns a pointer to the resource representing a file format of the ORC type.
+func FileFormatOrc() *schema.Resource {
+	read := readFileFormatForType(sdk.FileFormatTypeORC, fileFormatOrcAttributes)
+	return &schema.Resource{
+		CreateContext: createFileFormatForType(sdk.FileFormatTypeORC, read, fileFormatOrcOptions),
+		ReadContext:   read,
+		UpdateContext: updateFileFormatForType(read, fileFormatOrcOptionsSchema, fileFormatOrcOptions),
+		DeleteContext: deleteFileFormatForType,
+
+		Description: "Resource used to manage file formats for ORC files. For more information, check [file format documentation](https://docs.snowflake.com/en/sql-reference/sql/create-file-format#type-orc).",
+
+		Schema: fileFormatSchemaWithOptions(fileFormatOrcOptionsSchema),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: fileFormatOptionsCustomizeDiff(fileFormatOrcAttributes),
+	}
+}
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fileFormatParquetOptionsSchema = map[string]*schema.Schema{
+	"compression":                fileFormatEnumOption("Specifies the compression algorithm of the data files.", sdk.AllParquetCompressions),
+	"binary_as_text":             fileFormatBoolOption("Boolean that specifies whether to interpret columns with no defined logical data type as UTF-8 text."),
+	"trim_space":                 fileFormatBoolOption("Boolean that specifies whether to remove leading and trailing white space from strings."),
+	"replace_invalid_characters": fileFormatBoolOption("Boolean that specifies whether to replace invalid UTF-8 characters with the Unicode replacement character (�)."),
+	"null_if":                    fileFormatNullIfOption(),
+}
+
+func fileFormatParquetOptions(d *schema.ResourceData) sdk.FileFormatTypeOptions {
+	return sdk.FileFormatTypeOptions{
+		ParquetCompression:              (*sdk.ParquetCompression)(fileFormatOption[string](d, "compression")),
+		ParquetBinaryAsText:             fileFormatOption[bool](d, "binary_as_text"),
+		ParquetTrimSpace:                fileFormatOption[bool](d, "trim_space"),
+		ParquetReplaceInvalidCharacters: fileFormatOption[bool](d, "replace_invalid_characters"),
+		ParquetNullIf:                   fileFormatNullIf(d),
+	}
+}
+
+func fileFormatParquetAttributes(options sdk.FileFormatTypeOptions) map[string]any {
+	attributes := map[string]any{
+		"compression":                (*string)(options.ParquetCompression),
+		"binary_as_text":             options.ParquetBinaryAsText,
+		"trim_space":                 options.ParquetTrimSpace,
+		"replace_invalid_characters": options.ParquetReplaceInvalidCharacters,
+	}
+	if options.ParquetNullIf != nil {
+		attributes["null_if"] = flattenFileFormatNullIf(*options.ParquetNullIf)
+	}
+	return attributes
+}
+
+// FileFormatParquet returns a pointer to the resource representing a file format of the PARQUET type.
+func FileFormatParquet() *schema.Resource {
+	read := readFileFormatForType(sdk.FileFormatTypeParquet, fileFormatParquetAttributes)
+	return &schema.Resource{
+		CreateContext: createFileFormatForType(sdk.FileFormatTypeParquet, read, fileFormatParquetOptions),
+		ReadContext:   read,
+		UpdateContext: updateFileFormatForType(read, fileFormatParquetOptionsSchema, fileFormatParquetOptions),
+		DeleteContext: deleteFileFormatForType,
+
+		Description: "Resource used to manage file formats for Parquet files. For more information, check [file format documentation](https://docs.snowflake.com/en/sql-reference/sql/create-file-format#type-parquet).",
+
+		Schema: fileFormatSchemaWithOptions(fileFormatParquetOptionsSchema),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: fileFormatOptionsCustomizeDiff(fileFormatParquetAttributes),
+	}
+}
@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var fileFormatXmlOptionsSchema = map[string]*schema.Schema{
+	"compression":                fileFormatEnumOption("Specifies the compression algorithm of the data files.", sdk.AllXMLCompressions),
+	"ignore_utf8_errors":         fileFormatBoolOption("Boolean that specifies whether UTF-8 encoding errors produce error conditions. Cannot be enabled together with `replace_invalid_characters`."),
+	"preserve_space":             fileFormatBoolOption("Boolean that specifies whether the XML parser preserves leading and trailing spaces in element content."),
+	"strip_outer_element":        fileFormatBoolOption("Boolean that specifies whether the XML parser strips out the outer XML element, exposing 2nd level elements as separate documents."),
+	"disable_snowflake_data":     fileFormatBoolOption("Boolean that specifies whether the XML parser disables recognition of Snowflake semi-structured data tags."),
+	"disable_auto_convert":       fileFormatBoolOption("Boolean that specifies whether the XML parser disables automatic conversion of numeric and Boolean values from text to native representation."),
+	"replace_invalid_characters": fileFormatBoolOption("Boolean that specifies whether to replace invalid UTF-8 characters with the Unicode replacement character (�). Cannot be enabled together with `ignore_utf8_errors`."),
+	"skip_byte_order_mark":       fileFormatBoolOption("Boolean that specifies whether to skip the BOM (byte order mark), if present in a data file."),
+}
+
+func fileFormatXmlOptions(d *schema.ResourceData) sdk.FileFormatTypeOptions {
+	return sdk.FileFormatTypeOptions{
+		XMLCompression:              (*sdk.XMLCompression)(fileFormatOption[string](d, "compression")),
+		XMLIgnoreUTF8Errors:         fileFormatOption[bool](d, "ignore_utf8_errors"),
+		XMLPreserveSpace:            fileFormatOption[bool](d, "preserve_space"),
+		XMLStripOuterElement:        fileFormatOption[bool](d, "strip_outer_element"),
+		XMLDisableSnowflakeData:     fileFormatOption[bool](d, "disable_snowflake_data"),
+		XMLDisableAutoConvert:       fileFormatOption[bool](d, "disable_auto_convert"),
+		XMLReplaceInvalidCharacters: fileFormatOption[bool](d, "replace_invalid_characters"),
+		XMLSkipByteOrderMark:        fileFormatOption[bool](d, "skip_byte_order_mark"),
+	}
+}
+
+func fileFormatXmlAttributes(options sdk.FileFormatTypeOptions) map[string]any {
+	return map[string]any{
+		"compression":                (*string)(options.XMLCompression),
+		"ignore_utf8_errors":         options.XMLIgnoreUTF8Errors,
+		"preserve_space":             options.XMLPreserveSpace,
+		"strip_outer_element":        options.XMLStripOuterElement,
+		"disable_snowflake_data":     options.XMLDisableSnowflakeData,
+		"disable_auto_convert":       options.XMLDisableAutoConvert,
+		"replace_invalid_characters": options.XMLReplaceInvalidCharacters,
+		"skip_byte_order_mark":       options.XMLSkipByteOrderMark,
+	}
+}
+
+// FileFormatXml returns a pointer to the resource representing a file format of the XML type.
+func FileFormatXml() *schema.Resource {
+	read := readFileFormatForType(sdk.FileFormatTypeXML, fileFormatXmlAttributes)
+	return &schema.Resource{
+		CreateContext: createFileFormatForType(sdk.FileFormatTypeXML, read, fileFormatXmlOptions),
+		ReadContext:   read,
+		UpdateContext: updateFileFormatForType(read, fileFormatXmlOptionsSchema, fileFormatXmlOptions),
+		DeleteContext: deleteFileFormatForType,
+
+		Description: "Resource used to manage file formats for XML files. For more information, check [file format documentation](https://docs.snowflake.com/en/sql-reference/sql/create-file-format#type-xml).",
+
+		Schema: fileFormatSchemaWithOptions(fileFormatXmlOptionsSchema),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: fileFormatOptionsCustomizeDiff(fileFormatXmlAttributes),
+	}
+}
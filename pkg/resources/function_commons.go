@@ -0,0 +1,767 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// functionCommonSchema returns attributes shared by all the per-language function and procedure resources.
+// objectType is used in descriptions only and should be either "function" or "procedure".
+func functionCommonSchema(objectType string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The database in which to create the %s. Don't use the | character.", objectType),
+		},
+		"schema": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The schema in which to create the %s. Don't use the | character.", objectType),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: fmt.Sprintf("Specifies the identifier for the %[1]s; does not have to be unique for the schema in which the %[1]s is created. Don't use the | character.", objectType),
+		},
+		"is_secure": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: fmt.Sprintf("Specifies that the %s is secure.", objectType),
+		},
+		"arguments": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("List of the arguments for the %s. Arguments are read from DESCRIBE, so the order and the types determine the overload managed by this resource.", objectType),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"arg_name": {
+						Type:     schema.TypeString,
+						Required: true,
+						// Snowflake returns argument names in upper case.
+						DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+							return strings.EqualFold(old, new)
+						},
+						Description: "The argument name.",
+					},
+					"arg_data_type": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateFunc:     dataTypeValidateFunc,
+						DiffSuppressFunc: dataTypeDiffSuppressFunc,
+						Description:      "The argument type.",
+					},
+					"arg_default_value": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Optional default value for the argument. Snowflake does not return it in DESCRIBE, so it is not checked for drift.",
+					},
+				},
+			},
+		},
+		"return_type": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: returnTypeDiffSuppressFunc,
+			Description:      fmt.Sprintf("Specifies the results returned by the %s, either as a data type (e.g. `NUMBER`) or as a table (e.g. `TABLE (id NUMBER, name VARCHAR)`).", objectType),
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Specifies a comment for the %s.", objectType),
+		},
+	}
+}
+
+func functionDefinitionSchema(objectType string, language string, required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         required,
+		Optional:         !required,
+		ForceNew:         true,
+		DiffSuppressFunc: DiffSuppressStatement,
+		Description:      fmt.Sprintf("Defines the %s code of the %s.", language, objectType),
+	}
+}
+
+func functionNullInputBehaviorSchema(objectType string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "CALLED ON NULL INPUT",
+		ForceNew: true,
+		// We do not use STRICT, because Snowflake then in the Read phase returns RETURNS NULL ON NULL INPUT
+		ValidateFunc: validation.StringInSlice([]string{"CALLED ON NULL INPUT", "RETURNS NULL ON NULL INPUT"}, false),
+		Description:  fmt.Sprintf("Specifies the behavior of the %s when called with null inputs.", objectType),
+	}
+}
+
+func functionReturnResultsBehaviorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      string(sdk.ReturnResultsBehaviorVolatile),
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.ReturnResultsBehaviorVolatile), string(sdk.ReturnResultsBehaviorImmutable)}, false),
+		Description:  "Specifies the behavior of the function when returning results.",
+	}
+}
+
+func functionRuntimeVersionSchema(language string, required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    required,
+		Optional:    !required,
+		Computed:    !required,
+		ForceNew:    true,
+		Description: fmt.Sprintf("Specifies the %s runtime version to use.", language),
+	}
+}
+
+func functionHandlerSchema(objectType string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: fmt.Sprintf("The name of the handler method or class of the %s.", objectType),
+	}
+}
+
+func functionImportsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The location (stage), path, and name of the files to import (e.g. `@my_stage/my_code.zip`).",
+	}
+}
+
+func functionPackagesSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    required,
+		Optional:    !required,
+		ForceNew:    true,
+		Description: "The names and version numbers of the packages required by the handler (e.g. `com.snowflake:snowpark:latest` or `numpy==1.24.3`).",
+	}
+}
+
+func functionTargetPathSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the location to which Snowflake should write the compiled code (JAR file) after compiling the in-line handler source code.",
+	}
+}
+
+func functionExternalAccessIntegrationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The names of external access integrations needed in order for the handler code to access external networks.",
+	}
+}
+
+func functionSecretsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Assigns the names of secrets to variables so that you can use the variables to reference the secrets when retrieving information from secrets in handler code.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"secret_variable_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The variable that will be used in handler code when retrieving information from the secret.",
+				},
+				"secret_id": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      "Fully qualified name of the secret (e.g. `\"db\".\"schema\".\"secret\"`).",
+				},
+			},
+		},
+	}
+}
+
+func procedureExecuteAsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "OWNER",
+		ValidateFunc: validation.StringInSlice([]string{"CALLER", "OWNER"}, true),
+		DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+		Description: "Sets execution context. Allowed values are CALLER and OWNER (consult a proper section in the [docs](https://docs.snowflake.com/en/sql-reference/sql/create-procedure#id1)).",
+	}
+}
+
+func procedureReturnNotNullSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies that the procedure can return only non-null values.",
+	}
+}
+
+var functionReturnsTableRegexp = regexp.MustCompile(`(?i)^\s*TABLE\s*\((.*)\)\s*$`)
+
+// returnTypeDiffSuppressFunc compares return types the way Snowflake does, e.g. NUMBER and NUMBER(38,0) or
+// TABLE (a NUMBER) and TABLE (A NUMBER) are treated as equal.
+func returnTypeDiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
+	oldColumns, oldIsTable := parseReturnsTableColumns(old)
+	newColumns, newIsTable := parseReturnsTableColumns(new)
+	if oldIsTable != newIsTable {
+		return false
+	}
+	if !oldIsTable {
+		return dataTypeDiffSuppressFunc("", old, new, nil)
+	}
+	if len(oldColumns) != len(newColumns) {
+		return false
+	}
+	for i := range oldColumns {
+		if !strings.EqualFold(oldColumns[i][0], newColumns[i][0]) || !dataTypeDiffSuppressFunc("", oldColumns[i][1], newColumns[i][1], nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseReturnsTableColumns returns [name, type] pairs for TABLE (...) return types.
+func parseReturnsTableColumns(returnType string) ([][2]string, bool) {
+	match := functionReturnsTableRegexp.FindStringSubmatch(returnType)
+	if match == nil {
+		return nil, false
+	}
+	columns := make([][2]string, 0)
+	for _, column := range splitFunctionArguments(match[1]) {
+		parts := strings.SplitN(strings.TrimSpace(column), " ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		columns = append(columns, [2]string{strings.Trim(parts[0], `"`), strings.TrimSpace(parts[1])})
+	}
+	return columns, true
+}
+
+// splitFunctionArguments splits a comma separated list of arguments ignoring commas inside parentheses, e.g. NUMBER(38,0).
+func splitFunctionArguments(s string) []string {
+	result := make([]string, 0)
+	depth := 0
+	current := strings.Builder{}
+	for _, r := range s {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			if item := strings.TrimSpace(current.String()); item != "" {
+				result = append(result, item)
+			}
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if item := strings.TrimSpace(current.String()); item != "" {
+		result = append(result, item)
+	}
+	return result
+}
+
+// parseFunctionSignature parses the signature property from DESCRIBE FUNCTION/PROCEDURE, e.g. (A NUMBER, B VARCHAR).
+func parseFunctionSignature(signature string) []map[string]any {
+	value := strings.TrimSpace(signature)
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "("), ")")
+	arguments := make([]map[string]any, 0)
+	for _, argument := range splitFunctionArguments(value) {
+		parts := strings.SplitN(argument, " ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		arguments = append(arguments, map[string]any{
+			"arg_name":      strings.Trim(parts[0], `"`),
+			"arg_data_type": strings.TrimSpace(parts[1]),
+		})
+	}
+	return arguments
+}
+
+// parseFunctionBracketedList parses list properties from DESCRIBE FUNCTION/PROCEDURE, e.g. ['numpy','pandas'] or [@stage/file.zip].
+func parseFunctionBracketedList(value string) []string {
+	value = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(value), "["), "]")
+	result := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.Trim(strings.TrimSpace(item), `'"`); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// parseFunctionSecrets parses the secrets property from DESCRIBE FUNCTION/PROCEDURE, e.g. {"cred":"\"db\".\"schema\".\"secret\""}.
+func parseFunctionSecrets(value string) ([]map[string]any, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	secrets := map[string]string{}
+	if err := json.Unmarshal([]byte(value), &secrets); err != nil {
+		return nil, err
+	}
+	result := make([]map[string]any, 0, len(secrets))
+	for variableName, secretId := range secrets {
+		result = append(result, map[string]any{
+			"secret_variable_name": variableName,
+			"secret_id":            secretId,
+		})
+	}
+	return result, nil
+}
+
+func getFunctionArgumentRequests(d *schema.ResourceData) ([]sdk.FunctionArgumentRequest, error) {
+	args := make([]sdk.FunctionArgumentRequest, 0)
+	for _, arg := range d.Get("arguments").([]any) {
+		argMap := arg.(map[string]any)
+		argDataType, err := sdk.ToDataType(argMap["arg_data_type"].(string))
+		if err != nil {
+			return nil, err
+		}
+		request := sdk.NewFunctionArgumentRequest(argMap["arg_name"].(string), argDataType)
+		if v := argMap["arg_default_value"].(string); v != "" {
+			request.WithDefaultValue(sdk.String(v))
+		}
+		args = append(args, *request)
+	}
+	return args, nil
+}
+
+func getProcedureArgumentRequests(d *schema.ResourceData) ([]sdk.ProcedureArgumentRequest, error) {
+	args := make([]sdk.ProcedureArgumentRequest, 0)
+	for _, arg := range d.Get("arguments").([]any) {
+		argMap := arg.(map[string]any)
+		argDataType, err := sdk.ToDataType(argMap["arg_data_type"].(string))
+		if err != nil {
+			return nil, err
+		}
+		request := sdk.NewProcedureArgumentRequest(argMap["arg_name"].(string), argDataType)
+		if v := argMap["arg_default_value"].(string); v != "" {
+			request.WithDefaultValue(sdk.String(v))
+		}
+		args = append(args, *request)
+	}
+	return args, nil
+}
+
+// getFunctionArgumentDataTypes returns argument data types in the form used for function and procedure identifiers.
+func getFunctionArgumentDataTypes(d *schema.ResourceData) ([]sdk.DataType, error) {
+	dataTypes := make([]sdk.DataType, 0)
+	for _, arg := range d.Get("arguments").([]any) {
+		dataType, err := sdk.ToDataType(arg.(map[string]any)["arg_data_type"].(string))
+		if err != nil {
+			return nil, err
+		}
+		dataTypes = append(dataTypes, dataType)
+	}
+	return dataTypes, nil
+}
+
+func getFunctionSecrets(d *schema.ResourceData) []sdk.Secret {
+	var secrets []sdk.Secret
+	for _, item := range d.Get("secrets").(*schema.Set).List() {
+		secret := item.(map[string]any)
+		secrets = append(secrets, sdk.Secret{
+			VariableName: secret["secret_variable_name"].(string),
+			Name:         secret["secret_id"].(string),
+		})
+	}
+	return secrets
+}
+
+func getFunctionExternalAccessIntegrations(d *schema.ResourceData) []sdk.AccountObjectIdentifier {
+	var integrations []sdk.AccountObjectIdentifier
+	for _, item := range expandStringList(d.Get("external_access_integrations").(*schema.Set).List()) {
+		integrations = append(integrations, sdk.NewAccountObjectIdentifier(item))
+	}
+	return integrations
+}
+
+func getFunctionImports(d *schema.ResourceData) []sdk.FunctionImportRequest {
+	var imports []sdk.FunctionImportRequest
+	for _, item := range expandStringList(d.Get("imports").(*schema.Set).List()) {
+		imports = append(imports, *sdk.NewFunctionImportRequest().WithImport(item))
+	}
+	return imports
+}
+
+func getFunctionPackages(d *schema.ResourceData) []sdk.FunctionPackageRequest {
+	var packages []sdk.FunctionPackageRequest
+	for _, item := range expandStringList(d.Get("packages").(*schema.Set).List()) {
+		packages = append(packages, *sdk.NewFunctionPackageRequest().WithPackage(item))
+	}
+	return packages
+}
+
+func getProcedureImports(d *schema.ResourceData) []sdk.ProcedureImportRequest {
+	var imports []sdk.ProcedureImportRequest
+	for _, item := range expandStringList(d.Get("imports").(*schema.Set).List()) {
+		imports = append(imports, *sdk.NewProcedureImportRequest(item))
+	}
+	return imports
+}
+
+func getProcedurePackages(d *schema.ResourceData) []sdk.ProcedurePackageRequest {
+	packages := make([]sdk.ProcedurePackageRequest, 0)
+	for _, item := range expandStringList(d.Get("packages").(*schema.Set).List()) {
+		packages = append(packages, *sdk.NewProcedurePackageRequest(item))
+	}
+	return packages
+}
+
+func getProcedureExecuteAs(d *schema.ResourceData) *sdk.ExecuteAs {
+	return sdk.Pointer(sdk.ExecuteAs("EXECUTE AS " + strings.ToUpper(d.Get("execute_as").(string))))
+}
+
+// setFunctionDetails sets attributes based on the DESCRIBE FUNCTION/PROCEDURE output. Only attributes present
+// in the resource schema are set, so the same logic can be shared between all the per-language resources.
+func setFunctionDetails(d *schema.ResourceData, resourceSchema map[string]*schema.Schema, properties map[string]string) error {
+	set := func(key string, value any) error {
+		if _, ok := resourceSchema[key]; !ok {
+			return nil
+		}
+		return d.Set(key, value)
+	}
+
+	for property, value := range properties {
+		var err error
+		switch property {
+		case "signature":
+			arguments := parseFunctionSignature(value)
+			// default values are not returned by Snowflake, so they are kept from the current state
+			current := d.Get("arguments").([]any)
+			for i := range arguments {
+				if i < len(current) && current[i] != nil {
+					arguments[i]["arg_default_value"] = current[i].(map[string]any)["arg_default_value"]
+				}
+			}
+			err = set("arguments", arguments)
+		case "returns":
+			returnType, notNull := strings.CutSuffix(value, " NOT NULL")
+			if err = set("return_type", returnType); err == nil {
+				err = set("return_not_null", notNull)
+			}
+		case "null handling":
+			err = set("null_input_behavior", value)
+		case "volatility":
+			err = set("return_results_behavior", value)
+		case "body":
+			if _, ok := resourceSchema["function_definition"]; ok {
+				err = set("function_definition", value)
+			} else {
+				err = set("procedure_definition", value)
+			}
+		case "imports":
+			err = set("imports", parseFunctionBracketedList(value))
+		case "packages":
+			err = set("packages", parseFunctionBracketedList(value))
+		case "handler":
+			err = set("handler", value)
+		case "target_path":
+			err = set("target_path", value)
+		case "runtime_version":
+			err = set("runtime_version", value)
+		case "external_access_integrations":
+			err = set("external_access_integrations", parseFunctionBracketedList(value))
+		case "secrets":
+			var secrets []map[string]any
+			if secrets, err = parseFunctionSecrets(value); err == nil {
+				err = set("secrets", secrets)
+			}
+		case "execute as":
+			err = set("execute_as", value)
+		case "language", "installed_packages":
+			// language is determined by the resource type
+		default:
+			log.Printf("[DEBUG] unexpected property %v returned from Snowflake with value %v", property, value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchesFunctionSignature checks if the arguments column from SHOW FUNCTIONS/PROCEDURES (e.g. MY_FUNC(NUMBER [, VARCHAR]) RETURN NUMBER)
+// describes the overload identified by id.
+func matchesFunctionSignature(arguments string, id sdk.SchemaObjectIdentifier) bool {
+	signature := strings.Split(arguments, " RETURN ")[0]
+	signature = strings.NewReplacer(" ", "", "[", "", "]", "").Replace(signature)
+	return signature == id.ArgumentsSignature()
+}
+
+func readFunctionForLanguage(resourceSchema map[string]*schema.Schema) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+
+		details, err := client.Functions.Describe(ctx, sdk.NewDescribeFunctionRequest(id.WithoutArguments(), id.Arguments()))
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				// if function is not found then mark resource to be removed from state file during apply or refresh
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Describe function failed.",
+						Detail:   fmt.Sprintf("Describe function failed: %v", err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+		properties := make(map[string]string)
+		for _, detail := range details {
+			properties[detail.Property] = detail.Value
+		}
+		if err := setFunctionDetails(d, resourceSchema, properties); err != nil {
+			return diag.FromErr(err)
+		}
+
+		functions, err := client.Functions.Show(ctx, sdk.NewShowFunctionRequest().
+			WithIn(&sdk.In{Schema: id.SchemaIdentifier()}).
+			WithLike(&sdk.Like{Pattern: sdk.String(id.Name())}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, function := range functions {
+			if !matchesFunctionSignature(function.Arguments, id) {
+				continue
+			}
+			if err := setFunctionIdentifierFields(d, id); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("is_secure", function.IsSecure); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("comment", function.Description); err != nil {
+				return diag.FromErr(err)
+			}
+			if _, ok := resourceSchema["is_memoizable"]; ok {
+				if err := d.Set("is_memoizable", function.IsMemoizable); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func setFunctionIdentifierFields(d *schema.ResourceData, id sdk.SchemaObjectIdentifier) error {
+	if err := d.Set("database", id.DatabaseName()); err != nil {
+		return err
+	}
+	if err := d.Set("schema", id.SchemaName()); err != nil {
+		return err
+	}
+	return d.Set("name", id.Name())
+}
+
+func updateFunctionForLanguage(read schema.ReadContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+
+		if d.HasChange("name") {
+			newId := sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), d.Get("name").(string), id.Arguments())
+			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments()).WithRenameTo(sdk.Pointer(newId.WithoutArguments()))); err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(newId.FullyQualifiedName())
+			id = newId
+		}
+
+		if d.HasChange("is_secure") {
+			request := sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments())
+			if d.Get("is_secure").(bool) {
+				request.WithSetSecure(sdk.Bool(true))
+			} else {
+				request.WithUnsetSecure(sdk.Bool(true))
+			}
+			if err := client.Functions.Alter(ctx, request); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if d.HasChange("comment") {
+			request := sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments())
+			if comment := d.Get("comment").(string); comment != "" {
+				request.WithSetComment(sdk.String(comment))
+			} else {
+				request.WithUnsetComment(sdk.Bool(true))
+			}
+			if err := client.Functions.Alter(ctx, request); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		return read(ctx, d, meta)
+	}
+}
+
+func deleteFunctionForLanguage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+
+	if err := client.Functions.Drop(ctx, sdk.NewDropFunctionRequest(id.WithoutArguments(), id.Arguments())); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func readProcedureForLanguage(resourceSchema map[string]*schema.Schema) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+
+		details, err := client.Procedures.Describe(ctx, sdk.NewDescribeProcedureRequest(id.WithoutArguments(), id.Arguments()))
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				// if procedure is not found then mark resource to be removed from state file during apply or refresh
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Describe procedure failed.",
+						Detail:   fmt.Sprintf("Describe procedure failed: %v", err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+		properties := make(map[string]string)
+		for _, detail := range details {
+			properties[detail.Property] = detail.Value
+		}
+		if err := setFunctionDetails(d, resourceSchema, properties); err != nil {
+			return diag.FromErr(err)
+		}
+
+		procedures, err := client.Procedures.Show(ctx, sdk.NewShowProcedureRequest().
+			WithIn(&sdk.In{Schema: id.SchemaIdentifier()}).
+			WithLike(&sdk.Like{Pattern: sdk.String(id.Name())}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, procedure := range procedures {
+			if !matchesFunctionSignature(procedure.Arguments, id) {
+				continue
+			}
+			if err := setFunctionIdentifierFields(d, id); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("is_secure", procedure.IsSecure); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("comment", procedure.Description); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return nil
+	}
+}
+
+func updateProcedureForLanguage(read schema.ReadContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+
+		if d.HasChange("name") {
+			newId := sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), d.Get("name").(string), id.Arguments())
+			if err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments()).WithRenameTo(sdk.Pointer(newId.WithoutArguments()))); err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(newId.FullyQualifiedName())
+			id = newId
+		}
+
+		if d.HasChange("comment") {
+			request := sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments())
+			if comment := d.Get("comment").(string); comment != "" {
+				request.WithSetComment(sdk.String(comment))
+			} else {
+				request.WithUnsetComment(sdk.Bool(true))
+			}
+			if err := client.Procedures.Alter(ctx, request); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if d.HasChange("execute_as") {
+			if err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments()).WithExecuteAs(getProcedureExecuteAs(d))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		return read(ctx, d, meta)
+	}
+}
+
+func deleteProcedureForLanguage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+
+	if err := client.Procedures.Drop(ctx, sdk.NewDropProcedureRequest(id.WithoutArguments(), id.Arguments())); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// functionIdentifierFromData builds the identifier (with argument types) of the function or procedure described in the configuration.
+func functionIdentifierFromData(d *schema.ResourceData) (sdk.SchemaObjectIdentifier, error) {
+	argumentDataTypes, err := getFunctionArgumentDataTypes(d)
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, err
+	}
+	return sdk.NewSchemaObjectIdentifierWithArguments(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string), argumentDataTypes), nil
+}
+
+// getProcedureReturnsRequest parses return_type and return_not_null attributes for Java, Python and Scala procedures.
+func getProcedureReturnsRequest(d *schema.ResourceData) (*sdk.ProcedureReturnsRequest, diag.Diagnostics) {
+	returns, diags := parseProcedureReturnsRequest(d.Get("return_type").(string))
+	if diags != nil {
+		return nil, diags
+	}
+	if returns.ResultDataType != nil && d.Get("return_not_null").(bool) {
+		returns.ResultDataType.WithNotNull(sdk.Bool(true))
+	}
+	return returns, nil
+}
@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_splitFunctionArguments(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "", expected: []string{}},
+		{input: "NUMBER", expected: []string{"NUMBER"}},
+		{input: "A NUMBER(38,0), B VARCHAR", expected: []string{"A NUMBER(38,0)", "B VARCHAR"}},
+		{input: "NUMBER(38, 0),VARCHAR(100) , FLOAT", expected: []string{"NUMBER(38, 0)", "VARCHAR(100)", "FLOAT"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, splitFunctionArguments(tc.input))
+		})
+	}
+}
+
+func Test_parseFunctionSignature(t *testing.T) {
+	assert.Empty(t, parseFunctionSignature("()"))
+	assert.Equal(t, []map[string]any{
+		{"arg_name": "A", "arg_data_type": "NUMBER(38,0)"},
+		{"arg_name": "B", "arg_data_type": "VARCHAR"},
+	}, parseFunctionSignature(`("A" NUMBER(38,0), B VARCHAR)`))
+}
+
+func Test_parseFunctionBracketedList(t *testing.T) {
+	assert.Empty(t, parseFunctionBracketedList("[]"))
+	assert.Equal(t, []string{"numpy", "pandas==1.5.3"}, parseFunctionBracketedList("['numpy','pandas==1.5.3']"))
+	assert.Equal(t, []string{"@stage/file.zip"}, parseFunctionBracketedList("[@stage/file.zip]"))
+}
+
+func Test_parseFunctionSecrets(t *testing.T) {
+	secrets, err := parseFunctionSecrets("")
+	require.NoError(t, err)
+	assert.Nil(t, secrets)
+
+	secrets, err = parseFunctionSecrets(`{"cred":"\"db\".\"schema\".\"secret\""}`)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"secret_variable_name": "cred", "secret_id": `"db"."schema"."secret"`}}, secrets)
+
+	_, err = parseFunctionSecrets("not json")
+	require.Error(t, err)
+}
+
+func Test_returnTypeDiffSuppressFunc(t *testing.T) {
+	testCases := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{old: "NUMBER(38,0)", new: "NUMBER", suppress: true},
+		{old: "VARCHAR(16777216)", new: "varchar", suppress: true},
+		{old: "TABLE (A NUMBER, B VARCHAR)", new: "TABLE (a NUMBER, b VARCHAR)", suppress: true},
+		{old: "TABLE (A NUMBER)", new: "TABLE (A NUMBER, B VARCHAR)", suppress: false},
+		{old: "NUMBER", new: "VARCHAR", suppress: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.old+" "+tc.new, func(t *testing.T) {
+			assert.Equal(t, tc.suppress, returnTypeDiffSuppressFunc("", tc.old, tc.new, nil))
+		})
+	}
+}
+
+func Test_matchesFunctionSignature(t *testing.T) {
+	id := sdk.NewSchemaObjectIdentifierWithArguments("db", "schema", "FN", []sdk.DataType{sdk.DataTypeNumber, sdk.DataTypeVARCHAR})
+
+	assert.True(t, matchesFunctionSignature("FN(NUMBER, VARCHAR) RETURN NUMBER", id))
+	assert.False(t, matchesFunctionSignature("FN(NUMBER) RETURN NUMBER", id))
+}
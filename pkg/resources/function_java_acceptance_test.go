@@ -0,0 +1,83 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_FunctionJava(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	id := sdk.NewSchemaObjectIdentifierWithArguments(acc.TestDatabaseName, acc.TestSchemaName, name, []sdk.DataType{sdk.DataType("VARCHAR")})
+	resourceName := "snowflake_function_java.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.FunctionJava),
+		Steps: []resource.TestStep{
+			{
+				Config: functionJavaConfig(name, acc.TestDatabaseName, acc.TestSchemaName, "Terraform acceptance test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "arguments.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "function_definition"),
+				),
+			},
+			// change comment
+			{
+				Config: functionJavaConfig(name, acc.TestDatabaseName, acc.TestSchemaName, "Terraform acceptance test - updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Terraform acceptance test - updated"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func functionJavaConfig(name string, databaseName string, schemaName string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_function_java" "test" {
+  database = "%[2]s"
+  schema   = "%[3]s"
+  name     = "%[1]s"
+  comment  = "%[4]s"
+  runtime_version = "11"
+  handler         = "TestFunc.echoVarchar"
+  return_type     = "VARCHAR"
+  arguments {
+    arg_name      = "x"
+    arg_data_type = "VARCHAR"
+  }
+  function_definition = <<EOT
+class TestFunc {
+  public static String echoVarchar(String x) {
+    return x;
+  }
+}
+EOT
+}
+`, name, databaseName, schemaName, comment)
+}
@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var functionJavascriptSchema = func() map[string]*schema.Schema {
+	s := functionCommonSchema("function")
+	s["null_input_behavior"] = functionNullInputBehaviorSchema("function")
+	s["return_results_behavior"] = functionReturnResultsBehaviorSchema()
+	s["function_definition"] = functionDefinitionSchema("function", "JavaScript", true)
+	return s
+}()
+
+// FunctionJavascript returns a pointer to the resource representing a JavaScript function.
+func FunctionJavascript() *schema.Resource {
+	read := readFunctionForLanguage(functionJavascriptSchema)
+	return &schema.Resource{
+		CreateContext: CreateContextFunctionJavascript,
+		ReadContext:   read,
+		UpdateContext: updateFunctionForLanguage(read),
+		DeleteContext: deleteFunctionForLanguage,
+
+		Description: "Resource used to manage JavaScript functions. For more information, check [function documentation](https://docs.snowflake.com/en/sql-reference/sql/create-function).",
+
+		Schema: functionJavascriptSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextFunctionJavascript(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := functionIdentifierFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	arguments, err := getFunctionArgumentRequests(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	returns, diags := parseFunctionReturnsRequest(d.Get("return_type").(string))
+	if diags != nil {
+		return diags
+	}
+
+	request := sdk.NewCreateForJavascriptFunctionRequest(id.WithoutArguments(), *returns, d.Get("function_definition").(string)).
+		WithArguments(arguments).
+		WithSecure(sdk.Bool(d.Get("is_secure").(bool))).
+		WithNullInputBehavior(sdk.Pointer(sdk.NullInputBehavior(d.Get("null_input_behavior").(string)))).
+		WithReturnResultsBehavior(sdk.Pointer(sdk.ReturnResultsBehavior(d.Get("return_results_behavior").(string))))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Functions.CreateForJavascript(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	return readFunctionForLanguage(functionJavascriptSchema)(ctx, d, meta)
+}
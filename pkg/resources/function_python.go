@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var functionPythonSchema = func() map[string]*schema.Schema {
+	s := functionCommonSchema("function")
+	s["null_input_behavior"] = functionNullInputBehaviorSchema("function")
+	s["return_results_behavior"] = functionReturnResultsBehaviorSchema()
+	s["runtime_version"] = functionRuntimeVersionSchema("Python", true)
+	s["imports"] = functionImportsSchema()
+	s["packages"] = functionPackagesSchema(false)
+	s["handler"] = functionHandlerSchema("function")
+	s["external_access_integrations"] = functionExternalAccessIntegrationsSchema()
+	s["secrets"] = functionSecretsSchema()
+	s["function_definition"] = functionDefinitionSchema("function", "Python", false)
+	return s
+}()
+
+// FunctionPython returns a pointer to the resource representing a Python function.
+func FunctionPython() *schema.Resource {
+	read := readFunctionForLanguage(functionPythonSchema)
+	return &schema.Resource{
+		CreateContext: CreateContextFunctionPython,
+		ReadContext:   read,
+		UpdateContext: updateFunctionForLanguage(read),
+		DeleteContext: deleteFunctionForLanguage,
+
+		Description: "Resource used to manage Python functions. For more information, check [function documentation](https://docs.snowflake.com/en/sql-reference/sql/create-function).",
+
+		Schema: functionPythonSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextFunctionPython(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := functionIdentifierFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	arguments, err := getFunctionArgumentRequests(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	returns, diags := parseFunctionReturnsRequest(d.Get("return_type").(string))
+	if diags != nil {
+		return diags
+	}
+
+	request := sdk.NewCreateForPythonFunctionRequest(id.WithoutArguments(), *returns, d.Get("runtime_version").(string), d.Get("handler").(string)).
+		WithArguments(arguments).
+		WithSecure(sdk.Bool(d.Get("is_secure").(bool))).
+		WithNullInputBehavior(sdk.Pointer(sdk.NullInputBehavior(d.Get("null_input_behavior").(string)))).
+		WithReturnResultsBehavior(sdk.Pointer(sdk.ReturnResultsBehavior(d.Get("return_results_behavior").(string)))).
+		WithImports(getFunctionImports(d)).
+		WithPackages(getFunctionPackages(d)).
+		WithExternalAccessIntegrations(getFunctionExternalAccessIntegrations(d)).
+		WithSecrets(getFunctionSecrets(d))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("function_definition"); ok {
+		request.WithFunctionDefinition(sdk.String(v.(string)))
+	}
+
+	if err := client.Functions.CreateForPython(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	return readFunctionForLanguage(functionPythonSchema)(ctx, d, meta)
+}
@@ -0,0 +1,80 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_FunctionPython(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	id := sdk.NewSchemaObjectIdentifierWithArguments(acc.TestDatabaseName, acc.TestSchemaName, name, []sdk.DataType{sdk.DataType("NUMBER")})
+	resourceName := "snowflake_function_python.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.FunctionPython),
+		Steps: []resource.TestStep{
+			{
+				Config: functionPythonConfig(name, acc.TestDatabaseName, acc.TestSchemaName, "Terraform acceptance test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "arguments.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "function_definition"),
+				),
+			},
+			// change comment
+			{
+				Config: functionPythonConfig(name, acc.TestDatabaseName, acc.TestSchemaName, "Terraform acceptance test - updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Terraform acceptance test - updated"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func functionPythonConfig(name string, databaseName string, schemaName string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_function_python" "test" {
+  database = "%[2]s"
+  schema   = "%[3]s"
+  name     = "%[1]s"
+  comment  = "%[4]s"
+  runtime_version = "3.8"
+  handler         = "addone_py"
+  return_type     = "NUMBER(38,0)"
+  arguments {
+    arg_name      = "i"
+    arg_data_type = "NUMBER(38,0)"
+  }
+  function_definition = <<EOT
+def addone_py(i):
+  return i+1
+EOT
+}
+`, name, databaseName, schemaName, comment)
+}
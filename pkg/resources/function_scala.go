@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var functionScalaSchema = func() map[string]*schema.Schema {
+	s := functionCommonSchema("function")
+	s["return_type"].Description = "Specifies the data type returned by the function. Scala functions cannot return tables."
+	s["null_input_behavior"] = functionNullInputBehaviorSchema("function")
+	s["return_results_behavior"] = functionReturnResultsBehaviorSchema()
+	s["runtime_version"] = functionRuntimeVersionSchema("Scala", false)
+	s["imports"] = functionImportsSchema()
+	s["packages"] = functionPackagesSchema(false)
+	s["handler"] = functionHandlerSchema("function")
+	s["target_path"] = functionTargetPathSchema()
+	s["function_definition"] = functionDefinitionSchema("function", "Scala", false)
+	return s
+}()
+
+// FunctionScala returns a pointer to the resource representing a Scala function.
+func FunctionScala() *schema.Resource {
+	read := readFunctionForLanguage(functionScalaSchema)
+	return &schema.Resource{
+		CreateContext: CreateContextFunctionScala,
+		ReadContext:   read,
+		UpdateContext: updateFunctionForLanguage(read),
+		DeleteContext: deleteFunctionForLanguage,
+
+		Description: "Resource used to manage Scala functions. For more information, check [function documentation](https://docs.snowflake.com/en/sql-reference/sql/create-function).",
+
+		Schema: functionScalaSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextFunctionScala(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := functionIdentifierFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	arguments, err := getFunctionArgumentRequests(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	returnDataType, diags := convertFunctionDataType(d.Get("return_type").(string))
+	if diags != nil {
+		return diags
+	}
+
+	request := sdk.NewCreateForScalaFunctionRequest(id.WithoutArguments(), returnDataType, d.Get("handler").(string)).
+		WithArguments(arguments).
+		WithSecure(sdk.Bool(d.Get("is_secure").(bool))).
+		WithNullInputBehavior(sdk.Pointer(sdk.NullInputBehavior(d.Get("null_input_behavior").(string)))).
+		WithReturnResultsBehavior(sdk.Pointer(sdk.ReturnResultsBehavior(d.Get("return_results_behavior").(string)))).
+		WithImports(getFunctionImports(d)).
+		WithPackages(getFunctionPackages(d))
+	if v, ok := d.GetOk("runtime_version"); ok {
+		request.WithRuntimeVersion(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("target_path"); ok {
+		request.WithTargetPath(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("function_definition"); ok {
+		request.WithFunctionDefinition(sdk.String(v.(string)))
+	}
+
+	if err := client.Functions.CreateForScala(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	return readFunctionForLanguage(functionScalaSchema)(ctx, d, meta)
+}
@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var functionSQLSchema = func() map[string]*schema.Schema {
+	s := functionCommonSchema("function")
+	s["return_results_behavior"] = functionReturnResultsBehaviorSchema()
+	s["is_memoizable"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies that the function is memoizable.",
+	}
+	s["function_definition"] = functionDefinitionSchema("function", "SQL", true)
+	return s
+}()
+
+// FunctionSQL returns a pointer to the resource representing a SQL function.
+func FunctionSQL() *schema.Resource {
+	read := readFunctionForLanguage(functionSQLSchema)
+	return &schema.Resource{
+		CreateContext: CreateContextFunctionSQL,
+		ReadContext:   read,
+		UpdateContext: updateFunctionForLanguage(read),
+		DeleteContext: deleteFunctionForLanguage,
+
+		Description: "Resource used to manage SQL functions. For more information, check [function documentation](https://docs.snowflake.com/en/sql-reference/sql/create-function).",
+
+		Schema: functionSQLSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextFunctionSQL(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := functionIdentifierFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	arguments, err := getFunctionArgumentRequests(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	returns, diags := parseFunctionReturnsRequest(d.Get("return_type").(string))
+	if diags != nil {
+		return diags
+	}
+
+	request := sdk.NewCreateForSQLFunctionRequest(id.WithoutArguments(), *returns, d.Get("function_definition").(string)).
+		WithArguments(arguments).
+		WithSecure(sdk.Bool(d.Get("is_secure").(bool))).
+		WithReturnResultsBehavior(sdk.Pointer(sdk.ReturnResultsBehavior(d.Get("return_results_behavior").(string))))
+	if d.Get("is_memoizable").(bool) {
+		request.WithMemoizable(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Functions.CreateForSQL(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	return readFunctionForLanguage(functionSQLSchema)(ctx, d, meta)
+}
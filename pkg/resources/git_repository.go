@@ -0,0 +1,236 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitRepositorySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the Git repository; must be unique for the schema in which the Git repository is created.",
+		ForceNew:    true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the Git repository.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the Git repository.",
+		ForceNew:    true,
+	},
+	"origin": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the origin URL of the remote Git repository, e.g. `https://github.com/my-account/my-repository.git`.",
+		ForceNew:    true,
+	},
+	"api_integration": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Specifies the name of the API integration (with the `git_https_api` provider) containing the details of the interaction with the remote Git repository.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"git_credentials": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Specifies the fully qualified name of the secret with the credentials to authenticate with the remote Git repository. The secret has to be allowed in the API integration.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the Git repository.",
+	},
+	"fetch_on_apply": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Fetches the content of the remote Git repository after the Git repository is created or updated.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The fully qualified name of the Git repository, to be used in the stage paths of the repository, e.g. `@<qualified_name>/branches/main/`.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The role that owns the Git repository.",
+	},
+	"last_fetched_at": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the content of the remote Git repository was last fetched.",
+	},
+}
+
+// GitRepository returns a pointer to the resource representing a Git repository.
+func GitRepository() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateContextGitRepository,
+		ReadContext:   ReadContextGitRepository,
+		UpdateContext: UpdateContextGitRepository,
+		DeleteContext: DeleteContextGitRepository,
+
+		Schema: gitRepositorySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateGitRepositoryRequest(
+		id,
+		d.Get("origin").(string),
+		sdk.NewAccountObjectIdentifier(d.Get("api_integration").(string)),
+	)
+	if v, ok := d.GetOk("git_credentials"); ok {
+		request.WithGitCredentials(sdk.Pointer(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v.(string))))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if err := client.GitRepositories.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating git repository %v err = %w", id.FullyQualifiedName(), err))
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	if d.Get("fetch_on_apply").(bool) {
+		if err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithFetch(sdk.Bool(true))); err != nil {
+			return diag.FromErr(fmt.Errorf("error fetching git repository %v err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadContextGitRepository(ctx, d, meta)
+}
+
+func ReadContextGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	gitRepository, err := client.GitRepositories.ShowByID(ctx, id)
+	if err != nil {
+		log.Printf("[DEBUG] git repository (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("name", gitRepository.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("database", gitRepository.DatabaseName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schema", gitRepository.SchemaName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("origin", gitRepository.Origin); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("api_integration", gitRepository.ApiIntegration); err != nil {
+		return diag.FromErr(err)
+	}
+	gitCredentials := ""
+	if gitRepository.GitCredentials != nil {
+		gitCredentials = *gitRepository.GitCredentials
+		// keep the name in the form used in the configuration when it points to the same secret
+		current := d.Get("git_credentials").(string)
+		if current != "" && sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(current).FullyQualifiedName() == sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(gitCredentials).FullyQualifiedName() {
+			gitCredentials = current
+		}
+	}
+	if err := d.Set("git_credentials", gitCredentials); err != nil {
+		return diag.FromErr(err)
+	}
+	comment := ""
+	if gitRepository.Comment != nil {
+		comment = *gitRepository.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("owner", gitRepository.Owner); err != nil {
+		return diag.FromErr(err)
+	}
+	lastFetchedAt := ""
+	if gitRepository.LastFetchedAt != nil {
+		lastFetchedAt = gitRepository.LastFetchedAt.String()
+	}
+	if err := d.Set("last_fetched_at", lastFetchedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func UpdateContextGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := sdk.NewGitRepositorySetRequest(), sdk.NewGitRepositoryUnsetRequest()
+	if d.HasChange("api_integration") {
+		set.WithApiIntegration(sdk.Pointer(sdk.NewAccountObjectIdentifier(d.Get("api_integration").(string))))
+	}
+	if d.HasChange("git_credentials") {
+		if v := d.Get("git_credentials").(string); v != "" {
+			set.WithGitCredentials(sdk.Pointer(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v)))
+		} else {
+			unset.WithGitCredentials(sdk.Bool(true))
+		}
+	}
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			set.WithComment(sdk.String(v))
+		} else {
+			unset.WithComment(sdk.Bool(true))
+		}
+	}
+
+	if *set != *sdk.NewGitRepositorySetRequest() {
+		if err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating git repository %v err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+	if *unset != *sdk.NewGitRepositoryUnsetRequest() {
+		if err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting git repository %v properties err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if d.Get("fetch_on_apply").(bool) {
+		if err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithFetch(sdk.Bool(true))); err != nil {
+			return diag.FromErr(fmt.Errorf("error fetching git repository %v err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadContextGitRepository(ctx, d, meta)
+}
+
+func DeleteContextGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.GitRepositories.Drop(ctx, sdk.NewDropGitRepositoryRequest(id)); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting git repository %v err = %w", id.FullyQualifiedName(), err))
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,79 @@
+package resources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GitRepository(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	apiIntegrationName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_git_repository.test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":                 config.StringVariable(name),
+			"database":             config.StringVariable(acc.TestDatabaseName),
+			"schema":               config.StringVariable(acc.TestSchemaName),
+			"api_integration_name": config.StringVariable(apiIntegrationName),
+			"comment":              config.StringVariable("Terraform acceptance test"),
+			"fetch_on_apply":       config.BoolVariable(false),
+		}
+	}
+
+	variableSet2 := m()
+	variableSet2["comment"] = config.StringVariable("")
+	variableSet2["fetch_on_apply"] = config.BoolVariable(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.GitRepository),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GitRepository/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "origin", "https://github.com/Snowflake-Labs/terraform-provider-snowflake.git"),
+					resource.TestCheckResourceAttr(resourceName, "api_integration", apiIntegrationName),
+					resource.TestCheckResourceAttr(resourceName, "git_credentials", ""),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName()),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+				),
+			},
+			// unset comment and fetch
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GitRepository/basic"),
+				ConfigVariables: variableSet2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "fetch_on_apply", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_fetched_at"),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:         acc.ConfigurationDirectory("TestAcc_GitRepository/basic"),
+				ConfigVariables:         variableSet2,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fetch_on_apply"},
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantApplicationRoleSchema = map[string]*schema.Schema{
+	"application_role_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the application role which will be granted to the account role or application (\"&lt;app_name&gt;\".\"&lt;app_role_name&gt;\").",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+	},
+	"parent_account_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the account role to which the application role will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf: []string{
+			"parent_account_role_name",
+			"application_name",
+		},
+	},
+	"application_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the application to which the application role will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf: []string{
+			"parent_account_role_name",
+			"application_name",
+		},
+	},
+}
+
+func GrantApplicationRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateGrantApplicationRole,
+		ReadContext:   ReadGrantApplicationRole,
+		DeleteContext: DeleteGrantApplicationRole,
+		Schema:        grantApplicationRoleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				applicationRoleId, granteeType, granteeId, err := parseGrantApplicationRoleId(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				if err := d.Set("application_role_name", applicationRoleId.FullyQualifiedName()); err != nil {
+					return nil, err
+				}
+				switch granteeType {
+				case sdk.ObjectTypeRole:
+					if err := d.Set("parent_account_role_name", granteeId.FullyQualifiedName()); err != nil {
+						return nil, err
+					}
+				case sdk.ObjectTypeApplication:
+					if err := d.Set("application_name", granteeId.FullyQualifiedName()); err != nil {
+						return nil, err
+					}
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+// parseGrantApplicationRoleId parses the resource id in the format <application_role_name>|<ROLE|APPLICATION>|<grantee_name>.
+func parseGrantApplicationRoleId(id string) (sdk.DatabaseObjectIdentifier, sdk.ObjectType, sdk.AccountObjectIdentifier, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 3 {
+		return sdk.DatabaseObjectIdentifier{}, "", sdk.AccountObjectIdentifier{}, fmt.Errorf("invalid ID specified: %v, expected <application_role_name>|<grantee_object_type>|<grantee_name>", id)
+	}
+	granteeType := sdk.ObjectType(parts[1])
+	if granteeType != sdk.ObjectTypeRole && granteeType != sdk.ObjectTypeApplication {
+		return sdk.DatabaseObjectIdentifier{}, "", sdk.AccountObjectIdentifier{}, fmt.Errorf("invalid object type specified: %v, expected ROLE or APPLICATION", parts[1])
+	}
+	return sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[0]), granteeType, sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[2]), nil
+}
+
+func applicationRoleGrantee(granteeType sdk.ObjectType, granteeId sdk.AccountObjectIdentifier) sdk.ApplicationRoleGrantee {
+	if granteeType == sdk.ObjectTypeApplication {
+		return sdk.ApplicationRoleGrantee{Application: &granteeId}
+	}
+	return sdk.ApplicationRoleGrantee{AccountRole: &granteeId}
+}
+
+func CreateGrantApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	applicationRoleId := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(d.Get("application_role_name").(string))
+
+	granteeType, granteeName := sdk.ObjectTypeRole, d.Get("parent_account_role_name").(string)
+	if applicationName, ok := d.GetOk("application_name"); ok {
+		granteeType, granteeName = sdk.ObjectTypeApplication, applicationName.(string)
+	}
+	granteeId := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(granteeName)
+
+	if err := client.Grants.GrantApplicationRole(ctx, applicationRoleId, applicationRoleGrantee(granteeType, granteeId)); err != nil {
+		return diag.FromErr(fmt.Errorf("error granting application role %s to %s %s: %w", applicationRoleId.FullyQualifiedName(), granteeType, granteeId.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(applicationRoleId.FullyQualifiedName(), granteeType.String(), granteeId.FullyQualifiedName()))
+	return ReadGrantApplicationRole(ctx, d, meta)
+}
+
+func ReadGrantApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	applicationRoleId, granteeType, granteeId, err := parseGrantApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{
+			ApplicationRole: applicationRoleId,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] application role (%s) not found", applicationRoleId.FullyQualifiedName())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	found := false
+	for _, grant := range grants {
+		if grant.GrantedTo == granteeType && grant.GranteeName.Name() == granteeId.Name() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] application role grant (%s) not found", d.Id())
+		d.SetId("")
+	}
+	return nil
+}
+
+func DeleteGrantApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	applicationRoleId, granteeType, granteeId, err := parseGrantApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.Grants.RevokeApplicationRole(ctx, applicationRoleId, applicationRoleGrantee(granteeType, granteeId)); err != nil {
+		return diag.FromErr(fmt.Errorf("error revoking application role %s from %s %s: %w", applicationRoleId.FullyQualifiedName(), granteeType, granteeId.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
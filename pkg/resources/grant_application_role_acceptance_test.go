@@ -0,0 +1,10 @@
+package resources_test
+
+import (
+	"testing"
+)
+
+// TODO [SNOW-1284382]: Implement after snowflake_application and snowflake_application_role resources are introduced.
+func TestAcc_GrantApplicationRole_basic(t *testing.T) {
+	t.Skip("Skipped until snowflake_application and snowflake_application_role resources are introduced. Currently, behavior tested in application_roles_gen_integration_test.go.")
+}
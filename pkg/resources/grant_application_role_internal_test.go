@@ -0,0 +1,28 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseGrantApplicationRoleId(t *testing.T) {
+	applicationRoleId, granteeType, granteeId, err := parseGrantApplicationRoleId(`"app"."role"|ROLE|"parent"`)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDatabaseObjectIdentifier("app", "role"), applicationRoleId)
+	assert.Equal(t, sdk.ObjectTypeRole, granteeType)
+	assert.Equal(t, sdk.NewAccountObjectIdentifier("parent"), granteeId)
+
+	_, granteeType, granteeId, err = parseGrantApplicationRoleId(`"app"."role"|APPLICATION|"consumer"`)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.ObjectTypeApplication, granteeType)
+	assert.Equal(t, sdk.NewAccountObjectIdentifier("consumer"), granteeId)
+
+	_, _, _, err = parseGrantApplicationRoleId(`"app"."role"|"parent"`)
+	assert.ErrorContains(t, err, "invalid ID specified")
+
+	_, _, _, err = parseGrantApplicationRoleId(`"app"."role"|SHARE|"parent"`)
+	assert.ErrorContains(t, err, "invalid object type specified")
+}
@@ -1,12 +1,15 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -408,3 +411,31 @@ func isNotOwnershipGrant() func(value any, path cty.Path) diag.Diagnostics {
 		return diags
 	}
 }
+
+// validatePrivilegesForObjectType rejects the privileges which cannot be granted on the object type returned by getObjectType
+// at plan time, instead of failing at apply. The privileges and object types which are not known yet are not validated.
+func validatePrivilegesForObjectType(getObjectType func(d *schema.ResourceDiff) sdk.ObjectType) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if !d.NewValueKnown("privileges") {
+			return nil
+		}
+		objectType := getObjectType(d)
+		if objectType == "" {
+			return nil
+		}
+		return sdk.ValidatePrivilegesForObjectType(objectType, expandStringList(d.Get("privileges").(*schema.Set).List()))
+	}
+}
+
+// getOnSchemaObjectObjectType returns the object type configured in the on_schema_object block of the grant privileges resources.
+func getOnSchemaObjectObjectType(d *schema.ResourceDiff) sdk.ObjectType {
+	if objectType := d.Get("on_schema_object.0.object_type").(string); objectType != "" {
+		return sdk.ObjectType(strings.ToUpper(objectType))
+	}
+	for _, kind := range []string{"all", "future"} {
+		if plural := d.Get(fmt.Sprintf("on_schema_object.0.%s.0.object_type_plural", kind)).(string); plural != "" {
+			return sdk.PluralObjectType(strings.ToUpper(plural)).Singular()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validatePrivilegesForObjectType(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Resource *schema.Resource
+		Config   map[string]any
+		Error    string
+	}{
+		{
+			Name:     "account role - valid privileges on account object",
+			Resource: GrantPrivilegesToAccountRole(),
+			Config: map[string]any{
+				"account_role_name": "ROLE",
+				"privileges":        []any{"CREATE SCHEMA", "usage"},
+				"on_account_object": []any{map[string]any{"object_type": "DATABASE", "object_name": "DB"}},
+			},
+		},
+		{
+			Name:     "account role - invalid privilege on account",
+			Resource: GrantPrivilegesToAccountRole(),
+			Config: map[string]any{
+				"account_role_name": "ROLE",
+				"privileges":        []any{"SELECT"},
+				"on_account":        true,
+			},
+			Error: "privileges SELECT cannot be granted on ACCOUNT",
+		},
+		{
+			Name:     "account role - invalid privilege on schema object",
+			Resource: GrantPrivilegesToAccountRole(),
+			Config: map[string]any{
+				"account_role_name": "ROLE",
+				"privileges":        []any{"INSERT"},
+				"on_schema_object":  []any{map[string]any{"object_type": "VIEW", "object_name": "DB.SCHEMA.VIEW"}},
+			},
+			Error: "privileges INSERT cannot be granted on VIEW, valid privileges are: REFERENCES, SELECT",
+		},
+		{
+			Name:     "account role - invalid privilege on future schema objects",
+			Resource: GrantPrivilegesToAccountRole(),
+			Config: map[string]any{
+				"account_role_name": "ROLE",
+				"privileges":        []any{"SELECT"},
+				"on_schema_object":  []any{map[string]any{"future": []any{map[string]any{"object_type_plural": "PIPES", "in_database": "DB"}}}},
+			},
+			Error: "privileges SELECT cannot be granted on PIPE",
+		},
+		{
+			Name:     "database role - invalid privilege on database",
+			Resource: GrantPrivilegesToDatabaseRole(),
+			Config: map[string]any{
+				"database_role_name": "DB.ROLE",
+				"privileges":         []any{"CREATE TABLE"},
+				"on_database":        "DB",
+			},
+			Error: "privileges CREATE TABLE cannot be granted on DATABASE",
+		},
+		{
+			Name:     "database role - valid privilege on schema",
+			Resource: GrantPrivilegesToDatabaseRole(),
+			Config: map[string]any{
+				"database_role_name": "DB.ROLE",
+				"privileges":         []any{"CREATE TABLE"},
+				"on_schema":          []any{map[string]any{"schema_name": "DB.SCHEMA"}},
+			},
+		},
+		{
+			Name:     "all and future - invalid privilege",
+			Resource: GrantPrivilegesOnAllAndFuture(),
+			Config: map[string]any{
+				"account_role_name":  "ROLE",
+				"privileges":         []any{"SELECT", "OPERATE"},
+				"object_type_plural": "TABLES",
+				"in_schema":          "DB.SCHEMA",
+			},
+			Error: "privileges OPERATE cannot be granted on TABLE",
+		},
+		{
+			Name:     "object grants - invalid privilege",
+			Resource: ObjectGrants(),
+			Config: map[string]any{
+				"object_type": "STAGE",
+				"object_name": "DB.SCHEMA.STAGE",
+				"grant":       []any{map[string]any{"privilege": "SELECT", "account_role_name": "ROLE"}},
+			},
+			Error: "privileges SELECT cannot be granted on STAGE",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, err := tt.Resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tt.Config), nil)
+			if tt.Error != "" {
+				assert.ErrorContains(t, err, tt.Error)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
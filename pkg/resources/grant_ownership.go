@@ -2,8 +2,10 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -49,6 +51,42 @@ var grantOwnershipSchema = map[string]*schema.Schema{
 			"REVOKE",
 		}, true),
 	},
+	"restore_previous_owner_on_destroy": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If true, the ownership is transferred back to the roles recorded in `previous_owners` when the resource is destroyed. Otherwise (the default, and for the objects without a recorded previous owner), the ownership is transferred to the role used by the provider.",
+	},
+	"previous_owners": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The roles which owned the objects before the ownership was transferred. Recorded for `on.object_name` and `on.all` (for the object types which can be listed in a database or schema).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The fully qualified name of the object.",
+				},
+				"owner_role_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the role which owned the object (ROLE | DATABASE ROLE).",
+				},
+				"owner_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The fully qualified name of the role which owned the object.",
+				},
+			},
+		},
+	},
+	"ownership_drift": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The fully qualified names of the objects covered by `on.all` which are not owned by the target role. The ownership of these objects is transferred again on the next apply.",
+	},
 	"on": {
 		Type:        schema.TypeList,
 		Required:    true,
@@ -153,7 +191,8 @@ func grantOwnershipBulkOperationSchema(branchName string) map[string]*schema.Sch
 func GrantOwnership() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: CreateGrantOwnership,
-		// There's no Update, because every field is marked as ForceNew
+		// Update only transfers the ownership of the drifted objects again, the other fields are marked as ForceNew
+		UpdateContext: UpdateGrantOwnership,
 		DeleteContext: DeleteGrantOwnership,
 		ReadContext:   ReadGrantOwnership,
 
@@ -161,6 +200,13 @@ func GrantOwnership() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: ImportGrantOwnership(),
 		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			if drift, ok := d.Get("ownership_drift").([]any); ok && len(drift) > 0 {
+				return d.SetNew("ownership_drift", []any{})
+			}
+			return nil
+		},
 	}
 }
 
@@ -190,6 +236,10 @@ func ImportGrantOwnership() schema.StateContextFunc {
 			}
 		}
 
+		if err := d.Set("restore_previous_owner_on_destroy", false); err != nil {
+			return nil, err
+		}
+
 		switch id.Kind {
 		case OnObjectGrantOwnershipKind:
 			data := id.Data.(*OnObjectGrantOwnershipData)
@@ -248,12 +298,7 @@ func CreateGrantOwnership(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.FromErr(err)
 	}
 
-	err = client.Grants.GrantOwnership(
-		ctx,
-		*grantOn,
-		getOwnershipGrantTo(d),
-		getOwnershipGrantOpts(id),
-	)
+	previousOwners, err := transferOwnership(ctx, client, id, grantOn, getOwnershipGrantTo(d), nil)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -267,6 +312,44 @@ func CreateGrantOwnership(ctx context.Context, d *schema.ResourceData, meta any)
 	logging.DebugLogger.Printf("[DEBUG] Setting identifier to %s", id.String())
 	d.SetId(id.String())
 
+	if err := d.Set("previous_owners", previousOwnersToSchema(previousOwners)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadGrantOwnership(ctx, d, meta)
+}
+
+// UpdateGrantOwnership transfers the ownership of the objects covered by on.all again, when some of them are not owned by the target role.
+func UpdateGrantOwnership(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := ParseGrantOwnershipId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("ownership_drift") && id.Kind == OnAllGrantOwnershipKind {
+		grantOn, err := getOwnershipGrantOn(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		previousOwners, err := transferOwnership(ctx, client, id, grantOn, getOwnershipGrantTo(d), previousOwnersFromSchema(d))
+		if err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "An error occurred during grant ownership",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}
+		}
+
+		if err := d.Set("previous_owners", previousOwnersToSchema(previousOwners)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ReadGrantOwnership(ctx, d, meta)
 }
 
@@ -298,19 +381,37 @@ func DeleteGrantOwnership(ctx context.Context, d *schema.ResourceData, meta any)
 			return diag.FromErr(err)
 		}
 
-		err = client.Grants.GrantOwnership( // TODO: Should we always set outbound privileges to COPY in delete operation or set it to the config value?
-			ctx,
-			*grantOn,
-			sdk.OwnershipGrantTo{
-				AccountRoleName: sdk.Pointer(sdk.NewAccountObjectIdentifier(accountRoleName)),
-			},
-			getOwnershipGrantOpts(id),
-		)
-		if err != nil {
+		var previousOwners []ownershipPreviousOwner
+		if d.Get("restore_previous_owner_on_destroy").(bool) {
+			previousOwners = previousOwnersFromSchema(d)
+		}
+
+		// The objects without a recorded previous owner are transferred to the role used by the provider.
+		if grantOn.All != nil || len(previousOwners) == 0 {
+			err = client.Grants.GrantOwnership( // TODO: Should we always set outbound privileges to COPY in delete operation or set it to the config value?
+				ctx,
+				*grantOn,
+				sdk.OwnershipGrantTo{
+					AccountRoleName: sdk.Pointer(sdk.NewAccountObjectIdentifier(accountRoleName)),
+				},
+				getOwnershipGrantOpts(id),
+			)
+			if err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "An error occurred when transferring ownership back to the original role",
+						Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+					},
+				}
+			}
+		}
+
+		if err := restorePreviousOwners(ctx, client, id, previousOwners, sdk.NewAccountObjectIdentifier(accountRoleName)); err != nil {
 			return diag.Diagnostics{
 				diag.Diagnostic{
 					Severity: diag.Error,
-					Summary:  "An error occurred when transferring ownership back to the original role",
+					Summary:  "An error occurred when transferring ownership back to the previous owner",
 					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
 				},
 			}
@@ -334,13 +435,20 @@ func ReadGrantOwnership(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 	}
 
+	client := meta.(*provider.Context).Client
+
+	if id.Kind == OnAllGrantOwnershipKind {
+		return readGrantOwnershipOnAll(ctx, client, d, id)
+	}
+	if err := d.Set("ownership_drift", []string{}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	opts, expectedGrantedOn := prepareShowGrantsRequestForGrantOwnership(id)
 	if opts == nil {
 		return nil
 	}
 
-	client := meta.(*provider.Context).Client
-
 	grants, err := client.Grants.Show(ctx, opts)
 	if err != nil {
 		d.SetId("")
@@ -620,3 +728,274 @@ func createGrantOwnershipIdFromSchema(d *schema.ResourceData) (*GrantOwnershipId
 
 	return id, nil
 }
+
+// ownershipPreviousOwner is the role which owned the object before the ownership was transferred by the resource.
+type ownershipPreviousOwner struct {
+	ObjectName    string
+	OwnerRoleType sdk.ObjectType
+	OwnerName     string
+}
+
+func (o ownershipPreviousOwner) grantTo() sdk.OwnershipGrantTo {
+	if o.OwnerRoleType == sdk.ObjectTypeDatabaseRole {
+		return sdk.OwnershipGrantTo{DatabaseRoleName: sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(o.OwnerName))}
+	}
+	return sdk.OwnershipGrantTo{AccountRoleName: sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(o.OwnerName))}
+}
+
+func previousOwnersToSchema(owners []ownershipPreviousOwner) []map[string]any {
+	result := make([]map[string]any, len(owners))
+	for i, owner := range owners {
+		result[i] = map[string]any{
+			"object_name":     owner.ObjectName,
+			"owner_role_type": owner.OwnerRoleType.String(),
+			"owner_name":      owner.OwnerName,
+		}
+	}
+	return result
+}
+
+func previousOwnersFromSchema(d *schema.ResourceData) []ownershipPreviousOwner {
+	owners := make([]ownershipPreviousOwner, 0)
+	for _, raw := range d.Get("previous_owners").([]any) {
+		owner := raw.(map[string]any)
+		owners = append(owners, ownershipPreviousOwner{
+			ObjectName:    owner["object_name"].(string),
+			OwnerRoleType: sdk.ObjectType(owner["owner_role_type"].(string)),
+			OwnerName:     owner["owner_name"].(string),
+		})
+	}
+	return owners
+}
+
+// ownerFullyQualifiedName returns the fully qualified name of the owner returned by SHOW commands. The database roles
+// may be returned without the database name, in which case the database of the owned object is used.
+func ownerFullyQualifiedName(ownerRoleType sdk.ObjectType, owner string, databaseName string) string {
+	if ownerRoleType != sdk.ObjectTypeDatabaseRole {
+		return sdk.NewAccountObjectIdentifier(owner).FullyQualifiedName()
+	}
+	if parts := strings.Split(owner, "."); len(parts) == 2 {
+		return sdk.NewDatabaseObjectIdentifier(strings.Trim(parts[0], `"`), strings.Trim(parts[1], `"`)).FullyQualifiedName()
+	}
+	return sdk.NewDatabaseObjectIdentifier(databaseName, owner).FullyQualifiedName()
+}
+
+func isOwnedByTargetRole(id *GrantOwnershipId, owner ownershipPreviousOwner) bool {
+	switch id.GrantOwnershipTargetRoleKind {
+	case ToAccountGrantOwnershipTargetRoleKind:
+		return owner.OwnerRoleType == sdk.ObjectTypeRole && owner.OwnerName == id.AccountRoleName.FullyQualifiedName()
+	case ToDatabaseGrantOwnershipTargetRoleKind:
+		return owner.OwnerRoleType == sdk.ObjectTypeDatabaseRole && owner.OwnerName == id.DatabaseRoleName.FullyQualifiedName()
+	}
+	return false
+}
+
+// ownershipDrift returns the names of the objects which are not owned by the target role.
+func ownershipDrift(id *GrantOwnershipId, owners []ownershipPreviousOwner) []string {
+	drift := make([]string, 0)
+	for _, owner := range owners {
+		if !isOwnedByTargetRole(id, owner) {
+			drift = append(drift, owner.ObjectName)
+		}
+	}
+	return drift
+}
+
+// mergePreviousOwners adds the current owners of the objects not owned by the target role to the already recorded
+// previous owners. The recorded owners take precedence, so the original owner is kept when the ownership is transferred again.
+func mergePreviousOwners(id *GrantOwnershipId, recorded []ownershipPreviousOwner, current []ownershipPreviousOwner) []ownershipPreviousOwner {
+	result := append(make([]ownershipPreviousOwner, 0, len(recorded)+len(current)), recorded...)
+	for _, owner := range current {
+		if isOwnedByTargetRole(id, owner) || slices.ContainsFunc(recorded, func(o ownershipPreviousOwner) bool { return o.ObjectName == owner.ObjectName }) {
+			continue
+		}
+		result = append(result, owner)
+	}
+	return result
+}
+
+func getOwnershipBulkOperationIn(id *GrantOwnershipId) *sdk.GrantOnSchemaObjectIn {
+	data := id.Data.(*BulkOperationGrantData)
+	return &sdk.GrantOnSchemaObjectIn{
+		PluralObjectType: data.ObjectNamePlural,
+		InDatabase:       data.Database,
+		InSchema:         data.Schema,
+	}
+}
+
+// showCurrentOwners returns the current owners of the objects covered by the resource. The ownership of objects
+// granted with on.all is resolved only for the object types which can be listed in a database or schema.
+func showCurrentOwners(ctx context.Context, client *sdk.Client, id *GrantOwnershipId) ([]ownershipPreviousOwner, error) {
+	owners := make([]ownershipPreviousOwner, 0)
+	switch id.Kind {
+	case OnObjectGrantOwnershipKind:
+		data := id.Data.(*OnObjectGrantOwnershipData)
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			On: &sdk.ShowGrantsOn{Object: &sdk.Object{ObjectType: data.ObjectType, Name: data.ObjectName}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var databaseName string
+		switch objectName := data.ObjectName.(type) {
+		case sdk.DatabaseObjectIdentifier:
+			databaseName = objectName.DatabaseName()
+		case sdk.SchemaObjectIdentifier:
+			databaseName = objectName.DatabaseName()
+		}
+		for _, grant := range grants {
+			if grant.Privilege != "OWNERSHIP" || (grant.GrantedTo != sdk.ObjectTypeRole && grant.GrantedTo != sdk.ObjectTypeDatabaseRole) {
+				continue
+			}
+			owners = append(owners, ownershipPreviousOwner{
+				ObjectName:    data.ObjectName.FullyQualifiedName(),
+				OwnerRoleType: grant.GrantedTo,
+				OwnerName:     ownerFullyQualifiedName(grant.GrantedTo, grant.GranteeName.Name(), databaseName),
+			})
+		}
+	case OnAllGrantOwnershipKind:
+		in := getOwnershipBulkOperationIn(id)
+		if !slices.Contains(listableSchemaObjectPluralTypes, in.PluralObjectType) {
+			log.Printf("[INFO] Objects of type %s cannot be listed, the ownership of the existing objects is not tracked.", in.PluralObjectType)
+			return owners, nil
+		}
+		objects, err := showSchemaObjectsIn(ctx, client, in)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range objects {
+			if object.Owner == "" {
+				continue
+			}
+			ownerRoleType := sdk.ObjectTypeRole
+			if object.OwnerRoleType == sdk.ObjectTypeDatabaseRole.String() {
+				ownerRoleType = sdk.ObjectTypeDatabaseRole
+			}
+			owners = append(owners, ownershipPreviousOwner{
+				ObjectName:    object.Id.FullyQualifiedName(),
+				OwnerRoleType: ownerRoleType,
+				OwnerName:     ownerFullyQualifiedName(ownerRoleType, object.Owner, object.Id.DatabaseName()),
+			})
+		}
+	}
+	return owners, nil
+}
+
+// checkOwnershipTransferDoesNotOrphan refuses the transfer of the running tasks and pipes, which would be left
+// suspended (or paused) after the transfer. The SDK resumes pipes and single tasks itself when the current grants
+// are copied, so only the transfers it cannot resume are refused.
+func checkOwnershipTransferDoesNotOrphan(ctx context.Context, client *sdk.Client, id *GrantOwnershipId, owners []ownershipPreviousOwner) error {
+	var objectType sdk.ObjectType
+	switch id.Kind {
+	case OnObjectGrantOwnershipKind:
+		objectType = id.Data.(*OnObjectGrantOwnershipData).ObjectType
+	case OnAllGrantOwnershipKind:
+		objectType = id.Data.(*BulkOperationGrantData).ObjectNamePlural.Singular()
+	}
+
+	copiesGrants := id.OutboundPrivilegesBehavior != nil && *id.OutboundPrivilegesBehavior == CopyOutboundPrivilegesBehavior
+	switch {
+	case copiesGrants && objectType == sdk.ObjectTypePipe:
+		return nil
+	case copiesGrants && objectType == sdk.ObjectTypeTask && id.Kind == OnObjectGrantOwnershipKind:
+		return nil
+	}
+
+	var errs []error
+	for _, owner := range owners {
+		if isOwnedByTargetRole(id, owner) {
+			continue
+		}
+		objectId := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(owner.ObjectName)
+		switch objectType {
+		case sdk.ObjectTypeTask:
+			task, err := client.Tasks.ShowByID(ctx, objectId)
+			if err != nil {
+				return err
+			}
+			if task.IsStarted() {
+				errs = append(errs, fmt.Errorf("task %s is running, suspend it or set outbound_privileges to COPY before transferring the ownership", owner.ObjectName))
+			}
+		case sdk.ObjectTypePipe:
+			state, err := client.SystemFunctions.PipeStatus(objectId)
+			if err != nil {
+				return err
+			}
+			if state == sdk.RunningPipeExecutionState {
+				errs = append(errs, fmt.Errorf("pipe %s is running, pause it (PIPE_EXECUTION_PAUSED = TRUE) or set outbound_privileges to COPY before transferring the ownership", owner.ObjectName))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// transferOwnership grants the ownership and returns the previous owners of the transferred objects merged with the recorded ones.
+func transferOwnership(ctx context.Context, client *sdk.Client, id *GrantOwnershipId, grantOn *sdk.OwnershipGrantOn, grantTo sdk.OwnershipGrantTo, recorded []ownershipPreviousOwner) ([]ownershipPreviousOwner, error) {
+	current, err := showCurrentOwners(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOwnershipTransferDoesNotOrphan(ctx, client, id, current); err != nil {
+		return nil, err
+	}
+	if err := client.Grants.GrantOwnership(ctx, *grantOn, grantTo, getOwnershipGrantOpts(id)); err != nil {
+		return nil, err
+	}
+	return mergePreviousOwners(id, recorded, current), nil
+}
+
+// restorePreviousOwners transfers the ownership of the objects back to the recorded previous owners. The objects
+// dropped in the meantime are skipped.
+func restorePreviousOwners(ctx context.Context, client *sdk.Client, id *GrantOwnershipId, owners []ownershipPreviousOwner, currentRole sdk.AccountObjectIdentifier) error {
+	for _, owner := range owners {
+		var object *sdk.Object
+		switch id.Kind {
+		case OnObjectGrantOwnershipKind:
+			data := id.Data.(*OnObjectGrantOwnershipData)
+			object = &sdk.Object{ObjectType: data.ObjectType, Name: data.ObjectName}
+		case OnAllGrantOwnershipKind:
+			// the objects owned by the current role were already transferred with the whole bulk operation
+			if owner.OwnerRoleType == sdk.ObjectTypeRole && owner.OwnerName == currentRole.FullyQualifiedName() {
+				continue
+			}
+			object = &sdk.Object{
+				ObjectType: id.Data.(*BulkOperationGrantData).ObjectNamePlural.Singular(),
+				Name:       sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(owner.ObjectName),
+			}
+		default:
+			continue
+		}
+
+		if err := client.Grants.GrantOwnership(ctx, sdk.OwnershipGrantOn{Object: object}, owner.grantTo(), getOwnershipGrantOpts(id)); err != nil {
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				log.Printf("[DEBUG] object %s not found, skipping the ownership restore", owner.ObjectName)
+				continue
+			}
+			return fmt.Errorf("error transferring ownership of %s back to %s %s: %w", owner.ObjectName, owner.OwnerRoleType, owner.OwnerName, err)
+		}
+	}
+	return nil
+}
+
+func readGrantOwnershipOnAll(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id *GrantOwnershipId) diag.Diagnostics {
+	owners, err := showCurrentOwners(ctx, client, id)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing the objects covered by grant ownership %s: %w", d.Id(), err))
+	}
+
+	drift := ownershipDrift(id, owners)
+	if err := d.Set("ownership_drift", drift); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(drift) > 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Some of the objects are not owned by the target role. The ownership will be transferred again.",
+				Detail:   fmt.Sprintf("Id: %s\nObjects: %s", d.Id(), strings.Join(drift, ", ")),
+			},
+		}
+	}
+	return nil
+}
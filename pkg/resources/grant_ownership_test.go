@@ -525,3 +525,65 @@ func TestGetOwnershipGrantOpts(t *testing.T) {
 		})
 	}
 }
+
+func TestOwnerFullyQualifiedName(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		OwnerRoleType sdk.ObjectType
+		Owner         string
+		DatabaseName  string
+		Expected      string
+	}{
+		{
+			Name:          "account role",
+			OwnerRoleType: sdk.ObjectTypeRole,
+			Owner:         "ROLE_NAME",
+			DatabaseName:  "DB",
+			Expected:      `"ROLE_NAME"`,
+		},
+		{
+			Name:          "database role with database name",
+			OwnerRoleType: sdk.ObjectTypeDatabaseRole,
+			Owner:         "OTHER_DB.ROLE_NAME",
+			DatabaseName:  "DB",
+			Expected:      `"OTHER_DB"."ROLE_NAME"`,
+		},
+		{
+			Name:          "database role without database name",
+			OwnerRoleType: sdk.ObjectTypeDatabaseRole,
+			Owner:         "ROLE_NAME",
+			DatabaseName:  "DB",
+			Expected:      `"DB"."ROLE_NAME"`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, ownerFullyQualifiedName(tt.OwnerRoleType, tt.Owner, tt.DatabaseName))
+		})
+	}
+}
+
+func TestOwnershipDriftAndMergePreviousOwners(t *testing.T) {
+	id := &GrantOwnershipId{
+		GrantOwnershipTargetRoleKind: ToAccountGrantOwnershipTargetRoleKind,
+		AccountRoleName:              sdk.NewAccountObjectIdentifier("TARGET"),
+	}
+	ownedByTarget := ownershipPreviousOwner{ObjectName: `"DB"."SCHEMA"."T1"`, OwnerRoleType: sdk.ObjectTypeRole, OwnerName: `"TARGET"`}
+	ownedByOther := ownershipPreviousOwner{ObjectName: `"DB"."SCHEMA"."T2"`, OwnerRoleType: sdk.ObjectTypeRole, OwnerName: `"OTHER"`}
+	ownedByDatabaseRole := ownershipPreviousOwner{ObjectName: `"DB"."SCHEMA"."T3"`, OwnerRoleType: sdk.ObjectTypeDatabaseRole, OwnerName: `"DB"."TARGET"`}
+
+	t.Run("drift", func(t *testing.T) {
+		assert.Equal(t, []string{ownedByOther.ObjectName, ownedByDatabaseRole.ObjectName}, ownershipDrift(id, []ownershipPreviousOwner{ownedByTarget, ownedByOther, ownedByDatabaseRole}))
+		assert.Empty(t, ownershipDrift(id, []ownershipPreviousOwner{ownedByTarget}))
+	})
+
+	t.Run("merge - skips objects owned by the target role", func(t *testing.T) {
+		assert.Equal(t, []ownershipPreviousOwner{ownedByOther}, mergePreviousOwners(id, nil, []ownershipPreviousOwner{ownedByTarget, ownedByOther}))
+	})
+
+	t.Run("merge - keeps the recorded owner", func(t *testing.T) {
+		recorded := ownershipPreviousOwner{ObjectName: ownedByOther.ObjectName, OwnerRoleType: sdk.ObjectTypeRole, OwnerName: `"ORIGINAL"`}
+		assert.Equal(t, []ownershipPreviousOwner{recorded, ownedByDatabaseRole}, mergePreviousOwners(id, []ownershipPreviousOwner{recorded}, []ownershipPreviousOwner{ownedByOther, ownedByDatabaseRole}))
+	})
+}
@@ -0,0 +1,658 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listableSchemaObjectPluralTypes are the object types which can be listed with SHOW <object_type_plural> IN SCHEMA | DATABASE
+// and identified without the arguments, so the grants and ownership of every existing object can be verified.
+var listableSchemaObjectPluralTypes = []sdk.PluralObjectType{
+	sdk.PluralObjectTypeTables,
+	sdk.PluralObjectTypeViews,
+	sdk.PluralObjectTypeMaterializedViews,
+	sdk.PluralObjectTypeExternalTables,
+	sdk.PluralObjectTypeDynamicTables,
+	sdk.PluralObjectTypeIcebergTables,
+	sdk.PluralObjectTypeSequences,
+	sdk.PluralObjectTypeStages,
+	sdk.PluralObjectTypeStreams,
+	sdk.PluralObjectTypeTasks,
+	sdk.PluralObjectTypePipes,
+	sdk.PluralObjectTypeFileFormats,
+}
+
+func validGrantPrivilegesOnAllAndFuturePluralObjectTypes() []string {
+	objectTypes := make([]string, 0, len(listableSchemaObjectPluralTypes))
+	for _, objectType := range listableSchemaObjectPluralTypes {
+		if slices.Contains(sdk.ValidGrantToPluralObjectTypesString, objectType.String()) && slices.Contains(sdk.ValidGrantToFuturePluralObjectTypesString, objectType.String()) {
+			objectTypes = append(objectTypes, objectType.String())
+		}
+	}
+	return objectTypes
+}
+
+var grantPrivilegesOnAllAndFutureSchema = map[string]*schema.Schema{
+	"account_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the account role to which privileges will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf:     []string{"account_role_name", "database_role_name"},
+	},
+	"database_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the database role to which privileges will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ExactlyOneOf:     []string{"account_role_name", "database_role_name"},
+	},
+	"privileges": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		Description: "The privileges to grant on all existing and future objects. A privilege which is missing on any of the existing objects (or on the future objects) is reported as a drift and granted again on the next apply.",
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: isNotOwnershipGrant(),
+		},
+	},
+	"with_grant_option": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "If specified, allows the recipient role to grant the privileges to other roles.",
+	},
+	"object_type_plural": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      fmt.Sprintf("The plural object type of the schema objects on which privileges will be granted. Valid values are: %s", strings.Join(validGrantPrivilegesOnAllAndFuturePluralObjectTypes(), " | ")),
+		ValidateDiagFunc: StringInSlice(validGrantPrivilegesOnAllAndFuturePluralObjectTypes(), true),
+	},
+	"in_database": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the database in which the objects are (and will be) created.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf:     []string{"in_database", "in_schema"},
+	},
+	"in_schema": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the schema in which the objects are (and will be) created.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ExactlyOneOf:     []string{"in_database", "in_schema"},
+	},
+}
+
+func GrantPrivilegesOnAllAndFuture() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateGrantPrivilegesOnAllAndFuture,
+		ReadContext:   ReadGrantPrivilegesOnAllAndFuture,
+		UpdateContext: UpdateGrantPrivilegesOnAllAndFuture,
+		DeleteContext: DeleteGrantPrivilegesOnAllAndFuture,
+		Schema:        grantPrivilegesOnAllAndFutureSchema,
+		Description:   "Grants privileges on all existing and all future objects of the given type in a database or schema. The privileges on the existing objects are read with a single `SHOW GRANTS TO ROLE` (or `SHOW GRANTS TO DATABASE ROLE`) instead of `SHOW GRANTS ON` every object, so objects missing any of the privileges are reported as a drift and the privileges are granted again on the next apply. On import, all the privileges granted on the future objects are taken over.",
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportGrantPrivilegesOnAllAndFuture,
+		},
+
+		CustomizeDiff: validatePrivilegesForObjectType(func(d *schema.ResourceDiff) sdk.ObjectType {
+			return sdk.PluralObjectType(strings.ToUpper(d.Get("object_type_plural").(string))).Singular()
+		}),
+	}
+}
+
+// GrantPrivilegesOnAllAndFutureId identifies the role and the objects the privileges are granted on. Privileges are
+// not part of the identifier, so they can be changed in place.
+type GrantPrivilegesOnAllAndFutureId struct {
+	GranteeType      sdk.ObjectType
+	GranteeName      sdk.ObjectIdentifier
+	WithGrantOption  bool
+	PluralObjectType sdk.PluralObjectType
+	Kind             BulkOperationGrantKind
+	ContainerName    sdk.ObjectIdentifier
+}
+
+func (g GrantPrivilegesOnAllAndFutureId) String() string {
+	return strings.Join([]string{
+		g.GranteeType.String(),
+		g.GranteeName.FullyQualifiedName(),
+		strconv.FormatBool(g.WithGrantOption),
+		g.PluralObjectType.String(),
+		string(g.Kind),
+		g.ContainerName.FullyQualifiedName(),
+	}, helpers.IDDelimiter)
+}
+
+// ParseGrantPrivilegesOnAllAndFutureId parses identifiers in the format
+// <ROLE|DATABASE ROLE>|<role_name>|<with_grant_option>|<object_type_plural>|<InDatabase|InSchema>|<database_or_schema_name>.
+func ParseGrantPrivilegesOnAllAndFutureId(id string) (GrantPrivilegesOnAllAndFutureId, error) {
+	var allAndFutureId GrantPrivilegesOnAllAndFutureId
+
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 6 {
+		return allAndFutureId, sdk.NewError(fmt.Sprintf(`all and future grant identifier should consist of 6 parts "<grantee_type>|<role_name>|<with_grant_option>|<object_type_plural>|<InDatabase|InSchema>|<database_or_schema_name>", got %d`, len(parts)))
+	}
+
+	allAndFutureId.GranteeType = sdk.ObjectType(parts[0])
+	switch allAndFutureId.GranteeType {
+	case sdk.ObjectTypeRole:
+		allAndFutureId.GranteeName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[1])
+	case sdk.ObjectTypeDatabaseRole:
+		allAndFutureId.GranteeName = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[1])
+	default:
+		return allAndFutureId, sdk.NewError(fmt.Sprintf("invalid grantee type %s, expected one of: ROLE, DATABASE ROLE", parts[0]))
+	}
+
+	withGrantOption, err := strconv.ParseBool(parts[2])
+	if err != nil {
+		return allAndFutureId, sdk.NewError(fmt.Sprintf("invalid with grant option value: %s, should be either \"true\" or \"false\"", parts[2]))
+	}
+	allAndFutureId.WithGrantOption = withGrantOption
+
+	allAndFutureId.PluralObjectType = sdk.PluralObjectType(parts[3])
+	if !slices.Contains(validGrantPrivilegesOnAllAndFuturePluralObjectTypes(), parts[3]) {
+		return allAndFutureId, sdk.NewError(fmt.Sprintf("invalid object type plural %s, expected one of: %s", parts[3], strings.Join(validGrantPrivilegesOnAllAndFuturePluralObjectTypes(), ", ")))
+	}
+
+	allAndFutureId.Kind = BulkOperationGrantKind(parts[4])
+	switch allAndFutureId.Kind {
+	case InDatabaseBulkOperationGrantKind:
+		allAndFutureId.ContainerName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[5])
+	case InSchemaBulkOperationGrantKind:
+		allAndFutureId.ContainerName = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[5])
+	default:
+		return allAndFutureId, sdk.NewError(fmt.Sprintf("invalid bulk operation grant kind %s, expected one of: InDatabase, InSchema", parts[4]))
+	}
+
+	return allAndFutureId, nil
+}
+
+func createGrantPrivilegesOnAllAndFutureIdFromSchema(d *schema.ResourceData) GrantPrivilegesOnAllAndFutureId {
+	id := GrantPrivilegesOnAllAndFutureId{
+		GranteeType:      sdk.ObjectTypeRole,
+		WithGrantOption:  d.Get("with_grant_option").(bool),
+		PluralObjectType: sdk.PluralObjectType(strings.ToUpper(d.Get("object_type_plural").(string))),
+	}
+
+	if databaseRoleName, ok := d.GetOk("database_role_name"); ok {
+		id.GranteeType = sdk.ObjectTypeDatabaseRole
+		id.GranteeName = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(databaseRoleName.(string))
+	} else {
+		id.GranteeName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("account_role_name").(string))
+	}
+
+	if inSchema, ok := d.GetOk("in_schema"); ok {
+		id.Kind = InSchemaBulkOperationGrantKind
+		id.ContainerName = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(inSchema.(string))
+	} else {
+		id.Kind = InDatabaseBulkOperationGrantKind
+		id.ContainerName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("in_database").(string))
+	}
+
+	return id
+}
+
+func (g GrantPrivilegesOnAllAndFutureId) grantOnSchemaObjectIn() *sdk.GrantOnSchemaObjectIn {
+	in := &sdk.GrantOnSchemaObjectIn{PluralObjectType: g.PluralObjectType}
+	switch containerName := g.ContainerName.(type) {
+	case sdk.AccountObjectIdentifier:
+		in.InDatabase = &containerName
+	case sdk.DatabaseObjectIdentifier:
+		in.InSchema = &containerName
+	}
+	return in
+}
+
+// grantOrRevoke grants (or revokes) the privileges both on all existing objects and on the future objects.
+func (g GrantPrivilegesOnAllAndFutureId) grantOrRevoke(ctx context.Context, client *sdk.Client, privileges []string, revoke bool) error {
+	if len(privileges) == 0 {
+		return nil
+	}
+	for _, on := range []*sdk.GrantOnSchemaObject{{All: g.grantOnSchemaObjectIn()}, {Future: g.grantOnSchemaObjectIn()}} {
+		var err error
+		switch granteeName := g.GranteeName.(type) {
+		case sdk.AccountObjectIdentifier:
+			accountRoleGrantOn := &sdk.AccountRoleGrantOn{SchemaObject: on}
+			accountRolePrivileges := getAccountRolePrivileges(false, privileges, false, false, false, true)
+			if revoke {
+				err = client.Grants.RevokePrivilegesFromAccountRole(ctx, accountRolePrivileges, accountRoleGrantOn, granteeName, &sdk.RevokePrivilegesFromAccountRoleOptions{})
+			} else {
+				err = client.Grants.GrantPrivilegesToAccountRole(ctx, accountRolePrivileges, accountRoleGrantOn, granteeName, &sdk.GrantPrivilegesToAccountRoleOptions{WithGrantOption: sdk.Bool(g.WithGrantOption)})
+			}
+		case sdk.DatabaseObjectIdentifier:
+			databaseRoleGrantOn := &sdk.DatabaseRoleGrantOn{SchemaObject: on}
+			databaseRolePrivileges := getDatabaseRolePrivileges(false, privileges, false, false, true)
+			if revoke {
+				err = client.Grants.RevokePrivilegesFromDatabaseRole(ctx, databaseRolePrivileges, databaseRoleGrantOn, granteeName, &sdk.RevokePrivilegesFromDatabaseRoleOptions{})
+			} else {
+				err = client.Grants.GrantPrivilegesToDatabaseRole(ctx, databaseRolePrivileges, databaseRoleGrantOn, granteeName, &sdk.GrantPrivilegesToDatabaseRoleOptions{WithGrantOption: sdk.Bool(g.WithGrantOption)})
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isGrantee checks if the grant returned by SHOW GRANTS was given to the role from the identifier. Grants to database roles
+// may be returned with or without the database name.
+func (g GrantPrivilegesOnAllAndFutureId) isGrantee(grant sdk.Grant) bool {
+	if grant.GrantedTo != g.GranteeType && grant.GrantTo != g.GranteeType {
+		return false
+	}
+	if grant.GrantOption != g.WithGrantOption {
+		return false
+	}
+	parts := strings.Split(grant.GranteeName.Name(), ".")
+	if strings.Trim(parts[len(parts)-1], `"`) != g.GranteeName.Name() {
+		return false
+	}
+	if databaseRoleName, ok := g.GranteeName.(sdk.DatabaseObjectIdentifier); ok && len(parts) == 2 {
+		return strings.Trim(parts[0], `"`) == databaseRoleName.DatabaseName()
+	}
+	return true
+}
+
+func ImportGrantPrivilegesOnAllAndFuture(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := ParseGrantPrivilegesOnAllAndFutureId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAttribute := "account_role_name"
+	if id.GranteeType == sdk.ObjectTypeDatabaseRole {
+		granteeAttribute = "database_role_name"
+	}
+	containerAttribute := "in_database"
+	if id.Kind == InSchemaBulkOperationGrantKind {
+		containerAttribute = "in_schema"
+	}
+
+	if err := d.Set(granteeAttribute, id.GranteeName.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("with_grant_option", id.WithGrantOption); err != nil {
+		return nil, err
+	}
+	if err := d.Set("object_type_plural", id.PluralObjectType.String()); err != nil {
+		return nil, err
+	}
+	if err := d.Set(containerAttribute, id.ContainerName.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+
+	// The identifier does not hold the privileges, so all the privileges granted on the future objects are taken over on import.
+	// Read only reconciles the privileges already present in the state.
+	futurePrivileges, err := showAllAndFutureFuturePrivileges(ctx, meta.(*provider.Context).Client, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("privileges", futurePrivileges); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateGrantPrivilegesOnAllAndFuture(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := createGrantPrivilegesOnAllAndFutureIdFromSchema(d)
+	privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+
+	if err := id.grantOrRevoke(ctx, client, privileges, false); err != nil {
+		return diag.FromErr(fmt.Errorf("error granting privileges on all and future %s in %s to %s %s: %w", id.PluralObjectType, id.ContainerName.FullyQualifiedName(), id.GranteeType, id.GranteeName.FullyQualifiedName(), err))
+	}
+
+	d.SetId(id.String())
+	return ReadGrantPrivilegesOnAllAndFuture(ctx, d, meta)
+}
+
+func UpdateGrantPrivilegesOnAllAndFuture(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := ParseGrantPrivilegesOnAllAndFutureId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("privileges") {
+		before, after := d.GetChange("privileges")
+		toRevoke := expandStringList(before.(*schema.Set).Difference(after.(*schema.Set)).List())
+
+		if err := id.grantOrRevoke(ctx, client, toRevoke, true); err != nil {
+			return diag.FromErr(fmt.Errorf("error revoking privileges on all and future %s in %s from %s %s: %w", id.PluralObjectType, id.ContainerName.FullyQualifiedName(), id.GranteeType, id.GranteeName.FullyQualifiedName(), err))
+		}
+		// All privileges are granted again, because privileges missing on some of the existing objects are removed from the state by Read.
+		if err := id.grantOrRevoke(ctx, client, expandStringList(after.(*schema.Set).List()), false); err != nil {
+			return diag.FromErr(fmt.Errorf("error granting privileges on all and future %s in %s to %s %s: %w", id.PluralObjectType, id.ContainerName.FullyQualifiedName(), id.GranteeType, id.GranteeName.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadGrantPrivilegesOnAllAndFuture(ctx, d, meta)
+}
+
+func DeleteGrantPrivilegesOnAllAndFuture(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := ParseGrantPrivilegesOnAllAndFutureId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+	if err := id.grantOrRevoke(ctx, client, privileges, true); err != nil {
+		return diag.FromErr(fmt.Errorf("error revoking privileges on all and future %s in %s from %s %s: %w", id.PluralObjectType, id.ContainerName.FullyQualifiedName(), id.GranteeType, id.GranteeName.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ReadGrantPrivilegesOnAllAndFuture(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := ParseGrantPrivilegesOnAllAndFutureId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	futurePrivileges, err := showAllAndFutureFuturePrivileges(ctx, client, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] container for all and future grant (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	objectNames, err := showAllAndFutureObjects(ctx, client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objectPrivileges, err := showAllAndFutureObjectPrivileges(ctx, client, id, objectNames)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	expectedPrivileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+
+	missing := missingAllAndFuturePrivileges(expectedPrivileges, futurePrivileges, objectPrivileges)
+	actualPrivileges := make([]string, 0, len(expectedPrivileges))
+	for _, privilege := range expectedPrivileges {
+		if _, ok := missing[privilege]; !ok {
+			actualPrivileges = append(actualPrivileges, privilege)
+		}
+	}
+
+	if err := d.Set("privileges", actualPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	for _, privilege := range expectedPrivileges {
+		if objects, ok := missing[privilege]; ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Privilege %s is missing on some of the %s", privilege, strings.ToLower(id.PluralObjectType.String())),
+				Detail:   fmt.Sprintf("Id: %s\nThe privilege will be granted again on: %s", d.Id(), strings.Join(objects, ", ")),
+			})
+		}
+	}
+	return diags
+}
+
+// missingAllAndFuturePrivileges returns the objects missing each of the expected privileges. Privileges missing on
+// the future objects are reported with the "FUTURE" object.
+func missingAllAndFuturePrivileges(expectedPrivileges []string, futurePrivileges []string, objectPrivileges map[string][]string) map[string][]string {
+	objectNames := make([]string, 0, len(objectPrivileges))
+	for objectName := range objectPrivileges {
+		objectNames = append(objectNames, objectName)
+	}
+	sort.Strings(objectNames)
+
+	containsPrivilege := func(privileges []string, privilege string) bool {
+		return slices.ContainsFunc(privileges, func(p string) bool { return strings.EqualFold(p, privilege) })
+	}
+
+	missing := make(map[string][]string)
+	for _, privilege := range expectedPrivileges {
+		if !containsPrivilege(futurePrivileges, privilege) {
+			missing[privilege] = append(missing[privilege], "FUTURE")
+		}
+		for _, objectName := range objectNames {
+			if !containsPrivilege(objectPrivileges[objectName], privilege) {
+				missing[privilege] = append(missing[privilege], objectName)
+			}
+		}
+	}
+	return missing
+}
+
+// showAllAndFutureFuturePrivileges returns the privileges granted to the role on the future objects in the database or schema.
+func showAllAndFutureFuturePrivileges(ctx context.Context, client *sdk.Client, id GrantPrivilegesOnAllAndFutureId) ([]string, error) {
+	in := new(sdk.ShowGrantsIn)
+	switch containerName := id.ContainerName.(type) {
+	case sdk.AccountObjectIdentifier:
+		in.Database = &containerName
+	case sdk.DatabaseObjectIdentifier:
+		in.Schema = &containerName
+	}
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Future: sdk.Bool(true), In: in})
+	if err != nil {
+		return nil, err
+	}
+
+	privileges := make([]string, 0)
+	for _, grant := range grants {
+		if grant.GrantOn == id.PluralObjectType.Singular() && id.isGrantee(grant) {
+			privileges = append(privileges, grant.Privilege)
+		}
+	}
+	return privileges, nil
+}
+
+// showAllAndFutureObjectPrivileges returns the privileges granted to the role on each of the existing objects. All the grants
+// to the role are listed with a single SHOW GRANTS TO, instead of listing the grants on every object.
+func showAllAndFutureObjectPrivileges(ctx context.Context, client *sdk.Client, id GrantPrivilegesOnAllAndFutureId, objectNames []string) (map[string][]string, error) {
+	to := new(sdk.ShowGrantsTo)
+	switch granteeName := id.GranteeName.(type) {
+	case sdk.AccountObjectIdentifier:
+		to.Role = granteeName
+	case sdk.DatabaseObjectIdentifier:
+		to.DatabaseRole = granteeName
+	}
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: to})
+	if err != nil {
+		return nil, err
+	}
+
+	objectPrivileges := make(map[string][]string, len(objectNames))
+	for _, objectName := range objectNames {
+		objectPrivileges[objectName] = make([]string, 0)
+	}
+	for _, grant := range grants {
+		if grant.GrantedOn != id.PluralObjectType.Singular() || grant.GrantOption != id.WithGrantOption {
+			continue
+		}
+		objectName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(grant.Name.Name()).FullyQualifiedName()
+		if privileges, ok := objectPrivileges[objectName]; ok {
+			objectPrivileges[objectName] = append(privileges, grant.Privilege)
+		}
+	}
+	return objectPrivileges, nil
+}
+
+// showAllAndFutureObjects returns the fully qualified names of the existing objects in the database or schema.
+func showAllAndFutureObjects(ctx context.Context, client *sdk.Client, id GrantPrivilegesOnAllAndFutureId) ([]string, error) {
+	objects, err := showSchemaObjectsIn(ctx, client, id.grantOnSchemaObjectIn())
+	if err != nil {
+		return nil, err
+	}
+	objectNames := make([]string, len(objects))
+	for i, object := range objects {
+		objectNames[i] = object.Id.FullyQualifiedName()
+	}
+	return objectNames, nil
+}
+
+// schemaObjectInContainer is the existing schema object returned by SHOW <object_type_plural> IN SCHEMA | DATABASE.
+type schemaObjectInContainer struct {
+	Id            sdk.SchemaObjectIdentifier
+	Owner         string
+	OwnerRoleType string
+}
+
+func newSchemaObjectInContainer(databaseName string, schemaName string, name string, owner string, ownerRoleType string) schemaObjectInContainer {
+	return schemaObjectInContainer{
+		Id:            sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name),
+		Owner:         owner,
+		OwnerRoleType: strings.ReplaceAll(ownerRoleType, "_", " "),
+	}
+}
+
+// showSchemaObjectsIn returns the existing objects of one of the listableSchemaObjectPluralTypes in the database or schema.
+func showSchemaObjectsIn(ctx context.Context, client *sdk.Client, in *sdk.GrantOnSchemaObjectIn) ([]schemaObjectInContainer, error) {
+	showIn := new(sdk.In)
+	externalTableIn := sdk.NewShowExternalTableInRequest()
+	switch {
+	case in.InSchema != nil:
+		showIn.Schema = *in.InSchema
+		externalTableIn.WithSchema(*in.InSchema)
+	case in.InDatabase != nil:
+		showIn.Database = *in.InDatabase
+		externalTableIn.WithDatabase(*in.InDatabase)
+	}
+
+	objects := make([]schemaObjectInContainer, 0)
+	switch in.PluralObjectType {
+	case sdk.PluralObjectTypeTables, sdk.PluralObjectTypeIcebergTables:
+		tables, err := client.Tables.Show(ctx, sdk.NewShowTableRequest().WithIn(showIn))
+		if err != nil {
+			return nil, err
+		}
+		for _, table := range tables {
+			// SHOW TABLES also returns dynamic tables and iceberg tables, which are granted separately.
+			if table.IsDynamic || table.IsIceberg != (in.PluralObjectType == sdk.PluralObjectTypeIcebergTables) {
+				continue
+			}
+			objects = append(objects, newSchemaObjectInContainer(table.DatabaseName, table.SchemaName, table.Name, table.Owner, table.OwnerRoleType))
+		}
+	case sdk.PluralObjectTypeViews:
+		views, err := client.Views.Show(ctx, sdk.NewShowViewRequest().WithIn(showIn))
+		if err != nil {
+			return nil, err
+		}
+		for _, view := range views {
+			// SHOW VIEWS also returns materialized views, which are granted separately.
+			if view.IsMaterialized {
+				continue
+			}
+			objects = append(objects, newSchemaObjectInContainer(view.DatabaseName, view.SchemaName, view.Name, view.Owner, view.OwnerRoleType))
+		}
+	case sdk.PluralObjectTypeMaterializedViews:
+		materializedViews, err := client.MaterializedViews.Show(ctx, sdk.NewShowMaterializedViewRequest().WithIn(showIn))
+		if err != nil {
+			return nil, err
+		}
+		for _, materializedView := range materializedViews {
+			objects = append(objects, newSchemaObjectInContainer(materializedView.DatabaseName, materializedView.SchemaName, materializedView.Name, materializedView.Owner, materializedView.OwnerRoleType))
+		}
+	case sdk.PluralObjectTypeExternalTables:
+		externalTables, err := client.ExternalTables.Show(ctx, sdk.NewShowExternalTableRequest().WithIn(externalTableIn))
+		if err != nil {
+			return nil, err
+		}
+		for _, externalTable := range externalTables {
+			objects = append(objects, newSchemaObjectInContainer(externalTable.DatabaseName, externalTable.SchemaName, externalTable.Name, externalTable.Owner, externalTable.OwnerRoleType))
+		}
+	case sdk.PluralObjectTypeDynamicTables:
+		dynamicTables, err := client.DynamicTables.Show(ctx, sdk.NewShowDynamicTableRequest().WithIn(showIn))
+		if err != nil {
+			return nil, err
+		}
+		for _, dynamicTable := range dynamicTables {
+			objects = append(objects, newSchemaObjectInContainer(dynamicTable.DatabaseName, dynamicTable.SchemaName, dynamicTable.Name, dynamicTable.Owner, ""))
+		}
+	case sdk.PluralObjectTypeSequences:
+		sequences, err := client.Sequences.Show(ctx, sdk.NewShowSequenceRequest().WithIn(showIn))
+		if err != nil {
+			return nil, err
+		}
+		for _, sequence := range sequences {
+			objects = append(objects, newSchemaObjectInContainer(sequence.DatabaseName, sequence.SchemaName, sequence.Name, sequence.Owner, sequence.OwnerRoleType))
+		}
+	case sdk.PluralObjectTypeStages:
+		stages, err := client.Stages.Show(ctx, sdk.NewShowStageRequest().WithIn(showIn))
+		if err != nil {
+			return nil, err
+		}
+		for _, stage := range stages {
+			objects = append(objects, newSchemaObjectInContainer(stage.DatabaseName, stage.SchemaName, stage.Name, stage.Owner, stringValueOrEmpty(stage.OwnerRoleType)))
+		}
+	case sdk.PluralObjectTypeStreams:
+		streams, err := client.Streams.Show(ctx, sdk.NewShowStreamRequest().WithIn(showIn))
+		if err != nil {
+			return nil, err
+		}
+		for _, stream := range streams {
+			objects = append(objects, newSchemaObjectInContainer(stream.DatabaseName, stream.SchemaName, stream.Name, stringValueOrEmpty(stream.Owner), stringValueOrEmpty(stream.OwnerRoleType)))
+		}
+	case sdk.PluralObjectTypeTasks:
+		tasks, err := client.Tasks.Show(ctx, sdk.NewShowTaskRequest().WithIn(showIn))
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range tasks {
+			objects = append(objects, newSchemaObjectInContainer(task.DatabaseName, task.SchemaName, task.Name, task.Owner, task.OwnerRoleType))
+		}
+	case sdk.PluralObjectTypePipes:
+		pipes, err := client.Pipes.Show(ctx, &sdk.ShowPipeOptions{In: showIn})
+		if err != nil {
+			return nil, err
+		}
+		for _, pipe := range pipes {
+			objects = append(objects, newSchemaObjectInContainer(pipe.DatabaseName, pipe.SchemaName, pipe.Name, pipe.Owner, pipe.OwnerRoleType))
+		}
+	case sdk.PluralObjectTypeFileFormats:
+		fileFormats, err := client.FileFormats.Show(ctx, &sdk.ShowFileFormatsOptions{In: showIn})
+		if err != nil {
+			return nil, err
+		}
+		for _, fileFormat := range fileFormats {
+			objects = append(objects, newSchemaObjectInContainer(fileFormat.Name.DatabaseName(), fileFormat.Name.SchemaName(), fileFormat.Name.Name(), fileFormat.Owner, fileFormat.OwnerRoleType))
+		}
+	default:
+		return nil, fmt.Errorf("objects of type %s cannot be listed", in.PluralObjectType)
+	}
+
+	return slices.DeleteFunc(objects, func(object schemaObjectInContainer) bool {
+		return object.Id.SchemaName() == "INFORMATION_SCHEMA"
+	}), nil
+}
+
+func stringValueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGrantPrivilegesOnAllAndFutureId(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier string
+		Expected   GrantPrivilegesOnAllAndFutureId
+		Error      string
+	}{
+		{
+			Name:       "account role in schema",
+			Identifier: `ROLE|"role"|false|TABLES|InSchema|"db"."schema"`,
+			Expected: GrantPrivilegesOnAllAndFutureId{
+				GranteeType:      sdk.ObjectTypeRole,
+				GranteeName:      sdk.NewAccountObjectIdentifier("role"),
+				PluralObjectType: sdk.PluralObjectTypeTables,
+				Kind:             InSchemaBulkOperationGrantKind,
+				ContainerName:    sdk.NewDatabaseObjectIdentifier("db", "schema"),
+			},
+		},
+		{
+			Name:       "database role in database with grant option",
+			Identifier: `DATABASE ROLE|"db"."role"|true|MATERIALIZED VIEWS|InDatabase|"db"`,
+			Expected: GrantPrivilegesOnAllAndFutureId{
+				GranteeType:      sdk.ObjectTypeDatabaseRole,
+				GranteeName:      sdk.NewDatabaseObjectIdentifier("db", "role"),
+				WithGrantOption:  true,
+				PluralObjectType: sdk.PluralObjectTypeMaterializedViews,
+				Kind:             InDatabaseBulkOperationGrantKind,
+				ContainerName:    sdk.NewAccountObjectIdentifier("db"),
+			},
+		},
+		{
+			Name:       "validation: invalid number of parts",
+			Identifier: `ROLE|"role"|false|TABLES|InSchema`,
+			Error:      "all and future grant identifier should consist of 6 parts",
+		},
+		{
+			Name:       "validation: invalid grantee type",
+			Identifier: `USER|"user"|false|TABLES|InSchema|"db"."schema"`,
+			Error:      "invalid grantee type USER",
+		},
+		{
+			Name:       "validation: invalid with grant option",
+			Identifier: `ROLE|"role"|maybe|TABLES|InSchema|"db"."schema"`,
+			Error:      "invalid with grant option value: maybe",
+		},
+		{
+			Name:       "validation: unsupported object type",
+			Identifier: `ROLE|"role"|false|FUNCTIONS|InSchema|"db"."schema"`,
+			Error:      "invalid object type plural FUNCTIONS",
+		},
+		{
+			Name:       "validation: invalid kind",
+			Identifier: `ROLE|"role"|false|TABLES|InAccount|"db"."schema"`,
+			Error:      "invalid bulk operation grant kind InAccount",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			id, err := ParseGrantPrivilegesOnAllAndFutureId(tt.Identifier)
+			if tt.Error == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.Expected, id)
+				assert.Equal(t, tt.Identifier, id.String())
+			} else {
+				assert.ErrorContains(t, err, tt.Error)
+			}
+		})
+	}
+}
+
+func TestGrantPrivilegesOnAllAndFutureId_IsGrantee(t *testing.T) {
+	accountRoleId := GrantPrivilegesOnAllAndFutureId{GranteeType: sdk.ObjectTypeRole, GranteeName: sdk.NewAccountObjectIdentifier("ROLE")}
+	databaseRoleId := GrantPrivilegesOnAllAndFutureId{GranteeType: sdk.ObjectTypeDatabaseRole, GranteeName: sdk.NewDatabaseObjectIdentifier("DB", "ROLE")}
+
+	assert.True(t, accountRoleId.isGrantee(sdk.Grant{GrantedTo: sdk.ObjectTypeRole, GranteeName: sdk.NewAccountObjectIdentifier("ROLE")}))
+	assert.True(t, accountRoleId.isGrantee(sdk.Grant{GrantTo: sdk.ObjectTypeRole, GranteeName: sdk.NewAccountObjectIdentifier("ROLE")}))
+	assert.False(t, accountRoleId.isGrantee(sdk.Grant{GrantedTo: sdk.ObjectTypeRole, GranteeName: sdk.NewAccountObjectIdentifier("OTHER")}))
+	assert.False(t, accountRoleId.isGrantee(sdk.Grant{GrantedTo: sdk.ObjectTypeRole, GranteeName: sdk.NewAccountObjectIdentifier("ROLE"), GrantOption: true}))
+	assert.False(t, accountRoleId.isGrantee(sdk.Grant{GrantedTo: sdk.ObjectTypeDatabaseRole, GranteeName: sdk.NewAccountObjectIdentifier("ROLE")}))
+
+	assert.True(t, databaseRoleId.isGrantee(sdk.Grant{GrantedTo: sdk.ObjectTypeDatabaseRole, GranteeName: sdk.NewAccountObjectIdentifier("DB.ROLE")}))
+	assert.True(t, databaseRoleId.isGrantee(sdk.Grant{GrantTo: sdk.ObjectTypeDatabaseRole, GranteeName: sdk.NewAccountObjectIdentifier("ROLE")}))
+	assert.False(t, databaseRoleId.isGrantee(sdk.Grant{GrantedTo: sdk.ObjectTypeDatabaseRole, GranteeName: sdk.NewAccountObjectIdentifier("OTHER_DB.ROLE")}))
+}
+
+func TestMissingAllAndFuturePrivileges(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		Expected        []string
+		Future          []string
+		Objects         map[string][]string
+		ExpectedMissing map[string][]string
+	}{
+		{
+			Name:            "all privileges granted",
+			Expected:        []string{"SELECT", "INSERT"},
+			Future:          []string{"INSERT", "SELECT"},
+			Objects:         map[string][]string{`"db"."schema"."a"`: {"SELECT", "INSERT"}, `"db"."schema"."b"`: {"select", "insert", "UPDATE"}},
+			ExpectedMissing: map[string][]string{},
+		},
+		{
+			Name:            "no existing objects",
+			Expected:        []string{"SELECT"},
+			Future:          []string{"SELECT"},
+			Objects:         map[string][]string{},
+			ExpectedMissing: map[string][]string{},
+		},
+		{
+			Name:     "privileges missing on objects and future objects",
+			Expected: []string{"SELECT", "INSERT"},
+			Future:   []string{"SELECT"},
+			Objects:  map[string][]string{`"db"."schema"."b"`: {"INSERT"}, `"db"."schema"."a"`: {}},
+			ExpectedMissing: map[string][]string{
+				"SELECT": {`"db"."schema"."a"`, `"db"."schema"."b"`},
+				"INSERT": {"FUTURE", `"db"."schema"."a"`},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedMissing, missingAllAndFuturePrivileges(tt.Expected, tt.Future, tt.Objects))
+		})
+	}
+}
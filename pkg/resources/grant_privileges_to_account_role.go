@@ -298,7 +298,23 @@ func GrantPrivilegesToAccountRole() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: ImportGrantPrivilegesToAccountRole(),
 		},
+
+		CustomizeDiff: validatePrivilegesForObjectType(getGrantPrivilegesToAccountRoleObjectType),
+	}
+}
+
+func getGrantPrivilegesToAccountRoleObjectType(d *schema.ResourceDiff) sdk.ObjectType {
+	switch {
+	case d.Get("on_account").(bool):
+		return sdk.ObjectTypeAccount
+	case len(d.Get("on_account_object").([]any)) > 0:
+		return sdk.ObjectType(strings.ToUpper(d.Get("on_account_object.0.object_type").(string)))
+	case len(d.Get("on_schema").([]any)) > 0:
+		return sdk.ObjectTypeSchema
+	case len(d.Get("on_schema_object").([]any)) > 0:
+		return getOnSchemaObjectObjectType(d)
 	}
+	return ""
 }
 
 func ImportGrantPrivilegesToAccountRole() func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
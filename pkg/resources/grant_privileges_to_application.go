@@ -0,0 +1,461 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var grantPrivilegesToApplicationOnExactlyOneOf = []string{
+	"on_account",
+	"on_account_object",
+	"on_schema",
+	"on_schema_object",
+}
+
+var grantPrivilegesToApplicationSchema = map[string]*schema.Schema{
+	"application_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the application to which privileges will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"privileges": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		Description: "The privileges to grant to the application.",
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: isNotOwnershipGrant(),
+		},
+	},
+	"on_account": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		Default:      false,
+		ForceNew:     true,
+		Description:  "If true, the privileges will be granted on the account.",
+		ExactlyOneOf: grantPrivilegesToApplicationOnExactlyOneOf,
+	},
+	"on_account_object": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the account object on which privileges will be granted.",
+		MaxItems:     1,
+		ExactlyOneOf: grantPrivilegesToApplicationOnExactlyOneOf,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The object type of the account object on which privileges will be granted. Valid values are: USER | RESOURCE MONITOR | WAREHOUSE | COMPUTE POOL | DATABASE | INTEGRATION | FAILOVER GROUP | REPLICATION GROUP | EXTERNAL VOLUME",
+					ValidateFunc: validation.StringInSlice([]string{
+						"USER",
+						"RESOURCE MONITOR",
+						"WAREHOUSE",
+						"COMPUTE POOL",
+						"DATABASE",
+						"INTEGRATION",
+						"FAILOVER GROUP",
+						"REPLICATION GROUP",
+						"EXTERNAL VOLUME",
+					}, true),
+				},
+				"object_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the object on which privileges will be granted.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+				},
+			},
+		},
+	},
+	"on_schema": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the schema on which privileges will be granted.",
+		MaxItems:     1,
+		ExactlyOneOf: grantPrivilegesToApplicationOnExactlyOneOf,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"schema_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the schema.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+				},
+			},
+		},
+	},
+	"on_schema_object": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the schema object on which privileges will be granted.",
+		MaxItems:     1,
+		ExactlyOneOf: grantPrivilegesToApplicationOnExactlyOneOf,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      fmt.Sprintf("The object type of the schema object on which privileges will be granted. Valid values are: %s", strings.Join(sdk.ValidGrantToObjectTypesString, " | ")),
+					ValidateDiagFunc: StringInSlice(sdk.ValidGrantToObjectTypesString, true),
+				},
+				"object_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the object on which privileges will be granted.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+				},
+			},
+		},
+	},
+}
+
+func GrantPrivilegesToApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateGrantPrivilegesToApplication,
+		ReadContext:   ReadGrantPrivilegesToApplication,
+		UpdateContext: UpdateGrantPrivilegesToApplication,
+		DeleteContext: DeleteGrantPrivilegesToApplication,
+		Schema:        grantPrivilegesToApplicationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportGrantPrivilegesToApplication,
+		},
+	}
+}
+
+// GrantPrivilegesToApplicationId identifies the application and the object the privileges are granted on. Privileges are
+// not part of the identifier, so they can be changed in place.
+type GrantPrivilegesToApplicationId struct {
+	ApplicationName sdk.AccountObjectIdentifier
+	Kind            AccountRoleGrantKind
+	ObjectType      sdk.ObjectType
+	ObjectName      sdk.ObjectIdentifier
+}
+
+func (g GrantPrivilegesToApplicationId) String() string {
+	parts := []string{g.ApplicationName.FullyQualifiedName(), string(g.Kind)}
+	switch g.Kind {
+	case OnAccountObjectAccountRoleGrantKind, OnSchemaObjectAccountRoleGrantKind:
+		parts = append(parts, g.ObjectType.String(), g.ObjectName.FullyQualifiedName())
+	case OnSchemaAccountRoleGrantKind:
+		parts = append(parts, g.ObjectName.FullyQualifiedName())
+	}
+	return strings.Join(parts, helpers.IDDelimiter)
+}
+
+// ParseGrantPrivilegesToApplicationId parses identifiers in the formats:
+//   - <application_name>|OnAccount
+//   - <application_name>|OnAccountObject|<object_type>|<object_name>
+//   - <application_name>|OnSchema|<schema_name>
+//   - <application_name>|OnSchemaObject|<object_type>|<object_name>
+func ParseGrantPrivilegesToApplicationId(id string) (GrantPrivilegesToApplicationId, error) {
+	var applicationId GrantPrivilegesToApplicationId
+
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) < 2 {
+		return applicationId, sdk.NewError(`application identifier should hold at least 2 parts "<application_name>|<grant_on_kind>"`)
+	}
+
+	applicationId.ApplicationName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[0])
+	applicationId.Kind = AccountRoleGrantKind(parts[1])
+
+	expectedParts := map[AccountRoleGrantKind]int{
+		OnAccountAccountRoleGrantKind:       2,
+		OnAccountObjectAccountRoleGrantKind: 4,
+		OnSchemaAccountRoleGrantKind:        3,
+		OnSchemaObjectAccountRoleGrantKind:  4,
+	}
+	expected, ok := expectedParts[applicationId.Kind]
+	if !ok {
+		return applicationId, sdk.NewError(fmt.Sprintf("invalid grant on kind %s, expected one of: OnAccount, OnAccountObject, OnSchema, OnSchemaObject", parts[1]))
+	}
+	if len(parts) != expected {
+		return applicationId, sdk.NewError(fmt.Sprintf("%s application identifier should consist of %d parts, got %d", applicationId.Kind, expected, len(parts)))
+	}
+
+	switch applicationId.Kind {
+	case OnAccountObjectAccountRoleGrantKind:
+		applicationId.ObjectType = sdk.ObjectType(parts[2])
+		applicationId.ObjectName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[3])
+	case OnSchemaAccountRoleGrantKind:
+		applicationId.ObjectType = sdk.ObjectTypeSchema
+		applicationId.ObjectName = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[2])
+	case OnSchemaObjectAccountRoleGrantKind:
+		applicationId.ObjectType = sdk.ObjectType(parts[2])
+		applicationId.ObjectName = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(parts[3])
+	}
+
+	return applicationId, nil
+}
+
+func createGrantPrivilegesToApplicationIdFromSchema(d *schema.ResourceData) GrantPrivilegesToApplicationId {
+	id := GrantPrivilegesToApplicationId{
+		ApplicationName: sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("application_name").(string)),
+	}
+
+	onAccountObjectBlock, onAccountObjectOk := d.GetOk("on_account_object")
+	onSchemaBlock, onSchemaOk := d.GetOk("on_schema")
+	onSchemaObjectBlock, onSchemaObjectOk := d.GetOk("on_schema_object")
+
+	switch {
+	case onAccountObjectOk:
+		onAccountObject := onAccountObjectBlock.([]any)[0].(map[string]any)
+		id.Kind = OnAccountObjectAccountRoleGrantKind
+		id.ObjectType = sdk.ObjectType(strings.ToUpper(onAccountObject["object_type"].(string)))
+		id.ObjectName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(onAccountObject["object_name"].(string))
+	case onSchemaOk:
+		onSchema := onSchemaBlock.([]any)[0].(map[string]any)
+		id.Kind = OnSchemaAccountRoleGrantKind
+		id.ObjectType = sdk.ObjectTypeSchema
+		id.ObjectName = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(onSchema["schema_name"].(string))
+	case onSchemaObjectOk:
+		onSchemaObject := onSchemaObjectBlock.([]any)[0].(map[string]any)
+		id.Kind = OnSchemaObjectAccountRoleGrantKind
+		id.ObjectType = sdk.ObjectType(strings.ToUpper(onSchemaObject["object_type"].(string)))
+		id.ObjectName = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(onSchemaObject["object_name"].(string))
+	default:
+		id.Kind = OnAccountAccountRoleGrantKind
+	}
+
+	return id
+}
+
+func (g GrantPrivilegesToApplicationId) privileges(privileges []string) *sdk.AccountRoleGrantPrivileges {
+	return getAccountRolePrivileges(
+		false,
+		privileges,
+		g.Kind == OnAccountAccountRoleGrantKind,
+		g.Kind == OnAccountObjectAccountRoleGrantKind,
+		g.Kind == OnSchemaAccountRoleGrantKind,
+		g.Kind == OnSchemaObjectAccountRoleGrantKind,
+	)
+}
+
+func (g GrantPrivilegesToApplicationId) grantOn() *sdk.AccountRoleGrantOn {
+	on := new(sdk.AccountRoleGrantOn)
+
+	switch g.Kind {
+	case OnAccountAccountRoleGrantKind:
+		on.Account = sdk.Bool(true)
+	case OnAccountObjectAccountRoleGrantKind:
+		objectId := g.ObjectName.(sdk.AccountObjectIdentifier)
+		grantOnAccountObject := new(sdk.GrantOnAccountObject)
+		switch g.ObjectType {
+		case sdk.ObjectTypeDatabase:
+			grantOnAccountObject.Database = &objectId
+		case sdk.ObjectTypeFailoverGroup:
+			grantOnAccountObject.FailoverGroup = &objectId
+		case sdk.ObjectTypeIntegration:
+			grantOnAccountObject.Integration = &objectId
+		case sdk.ObjectTypeReplicationGroup:
+			grantOnAccountObject.ReplicationGroup = &objectId
+		case sdk.ObjectTypeResourceMonitor:
+			grantOnAccountObject.ResourceMonitor = &objectId
+		case sdk.ObjectTypeUser:
+			grantOnAccountObject.User = &objectId
+		case sdk.ObjectTypeWarehouse:
+			grantOnAccountObject.Warehouse = &objectId
+		case sdk.ObjectTypeComputePool:
+			grantOnAccountObject.ComputePool = &objectId
+		case sdk.ObjectTypeExternalVolume:
+			grantOnAccountObject.ExternalVolume = &objectId
+		}
+		on.AccountObject = grantOnAccountObject
+	case OnSchemaAccountRoleGrantKind:
+		on.Schema = &sdk.GrantOnSchema{
+			Schema: sdk.Pointer(g.ObjectName.(sdk.DatabaseObjectIdentifier)),
+		}
+	case OnSchemaObjectAccountRoleGrantKind:
+		on.SchemaObject = &sdk.GrantOnSchemaObject{
+			SchemaObject: &sdk.Object{
+				ObjectType: g.ObjectType,
+				Name:       g.ObjectName,
+			},
+		}
+	}
+
+	return on
+}
+
+func ImportGrantPrivilegesToApplication(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := ParseGrantPrivilegesToApplicationId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("application_name", id.ApplicationName.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+
+	switch id.Kind {
+	case OnAccountAccountRoleGrantKind:
+		err = d.Set("on_account", true)
+	case OnAccountObjectAccountRoleGrantKind:
+		err = d.Set("on_account_object", []any{map[string]any{
+			"object_type": id.ObjectType.String(),
+			"object_name": id.ObjectName.FullyQualifiedName(),
+		}})
+	case OnSchemaAccountRoleGrantKind:
+		err = d.Set("on_schema", []any{map[string]any{
+			"schema_name": id.ObjectName.FullyQualifiedName(),
+		}})
+	case OnSchemaObjectAccountRoleGrantKind:
+		err = d.Set("on_schema_object", []any{map[string]any{
+			"object_type": id.ObjectType.String(),
+			"object_name": id.ObjectName.FullyQualifiedName(),
+		}})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The identifier does not hold the privileges, so all the privileges currently granted to the application
+	// on the object are taken over on import. Read only reconciles the privileges already present in the state.
+	privileges, err := grantedPrivilegesToApplication(ctx, meta.(*provider.Context).Client, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("privileges", privileges); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateGrantPrivilegesToApplication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := createGrantPrivilegesToApplicationIdFromSchema(d)
+	privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+
+	if err := client.Grants.GrantPrivilegesToApplication(ctx, id.privileges(privileges), id.grantOn(), id.ApplicationName); err != nil {
+		return diag.FromErr(fmt.Errorf("error granting privileges to application %s: %w", id.ApplicationName.FullyQualifiedName(), err))
+	}
+
+	d.SetId(id.String())
+	return ReadGrantPrivilegesToApplication(ctx, d, meta)
+}
+
+func UpdateGrantPrivilegesToApplication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := ParseGrantPrivilegesToApplicationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("privileges") {
+		before, after := d.GetChange("privileges")
+		toRevoke := expandStringList(before.(*schema.Set).Difference(after.(*schema.Set)).List())
+		toGrant := expandStringList(after.(*schema.Set).Difference(before.(*schema.Set)).List())
+
+		if len(toRevoke) > 0 {
+			if err := client.Grants.RevokePrivilegesFromApplication(ctx, id.privileges(toRevoke), id.grantOn(), id.ApplicationName); err != nil {
+				return diag.FromErr(fmt.Errorf("error revoking privileges from application %s: %w", id.ApplicationName.FullyQualifiedName(), err))
+			}
+		}
+		if len(toGrant) > 0 {
+			if err := client.Grants.GrantPrivilegesToApplication(ctx, id.privileges(toGrant), id.grantOn(), id.ApplicationName); err != nil {
+				return diag.FromErr(fmt.Errorf("error granting privileges to application %s: %w", id.ApplicationName.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	return ReadGrantPrivilegesToApplication(ctx, d, meta)
+}
+
+func ReadGrantPrivilegesToApplication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := ParseGrantPrivilegesToApplicationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grantedPrivileges, err := grantedPrivilegesToApplication(ctx, client, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] object for application grant (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Only privileges already known to the resource are considered, so privileges granted to the application
+	// by other means (e.g. other resources or the application setup script) are not revoked.
+	expectedPrivileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+	actualPrivileges := make([]string, 0)
+	for _, privilege := range grantedPrivileges {
+		if slices.Contains(expectedPrivileges, privilege) {
+			actualPrivileges = append(actualPrivileges, privilege)
+		}
+	}
+
+	if err := d.Set("privileges", actualPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// grantedPrivilegesToApplication returns all the privileges (except OWNERSHIP) granted to the application on the object from the id.
+func grantedPrivilegesToApplication(ctx context.Context, client *sdk.Client, id GrantPrivilegesToApplicationId) ([]string, error) {
+	opts := &sdk.ShowGrantOptions{On: &sdk.ShowGrantsOn{Account: sdk.Bool(true)}}
+	grantedOn := sdk.ObjectTypeAccount
+	if id.Kind != OnAccountAccountRoleGrantKind {
+		opts.On = &sdk.ShowGrantsOn{Object: &sdk.Object{ObjectType: id.ObjectType, Name: id.ObjectName}}
+		grantedOn = id.ObjectType
+	}
+
+	grants, err := client.Grants.Show(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	privileges := make([]string, 0)
+	for _, grant := range grants {
+		if grant.GrantedTo != sdk.ObjectTypeApplication || grant.GranteeName.Name() != id.ApplicationName.Name() {
+			continue
+		}
+		if grant.GrantedOn != grantedOn || grant.Privilege == "OWNERSHIP" || slices.Contains(privileges, grant.Privilege) {
+			continue
+		}
+		privileges = append(privileges, grant.Privilege)
+	}
+	return privileges, nil
+}
+
+func DeleteGrantPrivilegesToApplication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := ParseGrantPrivilegesToApplicationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+	if len(privileges) > 0 {
+		if err := client.Grants.RevokePrivilegesFromApplication(ctx, id.privileges(privileges), id.grantOn(), id.ApplicationName); err != nil {
+			return diag.FromErr(fmt.Errorf("error revoking privileges from application %s: %w", id.ApplicationName.FullyQualifiedName(), err))
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,86 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGrantPrivilegesToApplicationId(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier string
+		Expected   GrantPrivilegesToApplicationId
+		Error      string
+	}{
+		{
+			Name:       "on account",
+			Identifier: `"app"|OnAccount`,
+			Expected: GrantPrivilegesToApplicationId{
+				ApplicationName: sdk.NewAccountObjectIdentifier("app"),
+				Kind:            OnAccountAccountRoleGrantKind,
+			},
+		},
+		{
+			Name:       "on account object",
+			Identifier: `"app"|OnAccountObject|WAREHOUSE|"wh"`,
+			Expected: GrantPrivilegesToApplicationId{
+				ApplicationName: sdk.NewAccountObjectIdentifier("app"),
+				Kind:            OnAccountObjectAccountRoleGrantKind,
+				ObjectType:      sdk.ObjectTypeWarehouse,
+				ObjectName:      sdk.NewAccountObjectIdentifier("wh"),
+			},
+		},
+		{
+			Name:       "on schema",
+			Identifier: `"app"|OnSchema|"db"."schema"`,
+			Expected: GrantPrivilegesToApplicationId{
+				ApplicationName: sdk.NewAccountObjectIdentifier("app"),
+				Kind:            OnSchemaAccountRoleGrantKind,
+				ObjectType:      sdk.ObjectTypeSchema,
+				ObjectName:      sdk.NewDatabaseObjectIdentifier("db", "schema"),
+			},
+		},
+		{
+			Name:       "on schema object",
+			Identifier: `"app"|OnSchemaObject|TABLE|"db"."schema"."table"`,
+			Expected: GrantPrivilegesToApplicationId{
+				ApplicationName: sdk.NewAccountObjectIdentifier("app"),
+				Kind:            OnSchemaObjectAccountRoleGrantKind,
+				ObjectType:      sdk.ObjectTypeTable,
+				ObjectName:      sdk.NewSchemaObjectIdentifier("db", "schema", "table"),
+			},
+		},
+		{
+			Name:       "validation: too few parts",
+			Identifier: `"app"`,
+			Error:      "application identifier should hold at least 2 parts",
+		},
+		{
+			Name:       "validation: invalid kind",
+			Identifier: `"app"|OnSomething`,
+			Error:      "invalid grant on kind OnSomething",
+		},
+		{
+			Name:       "validation: invalid number of parts",
+			Identifier: `"app"|OnAccountObject|WAREHOUSE`,
+			Error:      "OnAccountObject application identifier should consist of 4 parts, got 3",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			id, err := ParseGrantPrivilegesToApplicationId(tt.Identifier)
+			if tt.Error == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.Expected, id)
+				assert.Equal(t, tt.Identifier, id.String())
+			} else {
+				assert.ErrorContains(t, err, tt.Error)
+			}
+		})
+	}
+}
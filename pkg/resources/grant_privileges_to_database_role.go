@@ -252,7 +252,20 @@ func GrantPrivilegesToDatabaseRole() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: ImportGrantPrivilegesToDatabaseRole,
 		},
+
+		CustomizeDiff: validatePrivilegesForObjectType(getGrantPrivilegesToDatabaseRoleObjectType),
+	}
+}
+
+func getGrantPrivilegesToDatabaseRoleObjectType(d *schema.ResourceDiff) sdk.ObjectType {
+	switch {
+	case len(d.Get("on_schema").([]any)) > 0:
+		return sdk.ObjectTypeSchema
+	case len(d.Get("on_schema_object").([]any)) > 0:
+		return getOnSchemaObjectObjectType(d)
 	}
+	// on_database is the only remaining option (its value may not be known yet)
+	return sdk.ObjectTypeDatabase
 }
 
 func ImportGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
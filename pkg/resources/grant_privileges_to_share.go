@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"slices"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 
@@ -17,11 +18,36 @@ import (
 var grantPrivilegesToShareGrantExactlyOneOfValidation = []string{
 	"on_database",
 	"on_schema",
-	// TODO(SNOW-990811): "function_name",
+	"on_function",
 	"on_table",
 	"on_all_tables_in_schema",
+	"on_external_table",
+	"on_all_external_tables_in_schema",
+	"on_iceberg_table",
+	"on_all_iceberg_tables_in_schema",
+	"on_dynamic_table",
+	"on_all_dynamic_tables_in_schema",
 	"on_tag",
 	"on_view",
+	"on_materialized_view",
+}
+
+// grantPrivilegesToShareOnAttributes maps every supported share grant kind to the attribute it is configured with.
+var grantPrivilegesToShareOnAttributes = map[ShareGrantKind]string{
+	OnDatabaseShareGrantKind:                  "on_database",
+	OnSchemaShareGrantKind:                    "on_schema",
+	OnFunctionShareGrantKind:                  "on_function",
+	OnTableShareGrantKind:                     "on_table",
+	OnAllTablesInSchemaShareGrantKind:         "on_all_tables_in_schema",
+	OnExternalTableShareGrantKind:             "on_external_table",
+	OnAllExternalTablesInSchemaShareGrantKind: "on_all_external_tables_in_schema",
+	OnIcebergTableShareGrantKind:              "on_iceberg_table",
+	OnAllIcebergTablesInSchemaShareGrantKind:  "on_all_iceberg_tables_in_schema",
+	OnDynamicTableShareGrantKind:              "on_dynamic_table",
+	OnAllDynamicTablesInSchemaShareGrantKind:  "on_all_dynamic_tables_in_schema",
+	OnTagShareGrantKind:                       "on_tag",
+	OnViewShareGrantKind:                      "on_view",
+	OnMaterializedViewShareGrantKind:          "on_materialized_view",
 }
 
 var grantPrivilegesToShareSchema = map[string]*schema.Schema{
@@ -54,15 +80,14 @@ var grantPrivilegesToShareSchema = map[string]*schema.Schema{
 		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
 		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
 	},
-	//	TODO(SNOW-1021686): Because function identifier contains arguments which are not supported right now
-	// "function_name": {
-	//	Type:        schema.TypeString,
-	//	Optional:    true,
-	//	ForceNew:    true,
-	//	Description: "The fully qualified name of the function on which privileges will be granted.",
-	//	ValidateDiagFunc: IsValidIdentifier[sdk.FunctionIdentifier](),
-	//	ExactlyOneOf: grantPrivilegesToShareGrantExactlyOneOfValidation,
-	// },
+	"on_function": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the function on which privileges will be granted, including its argument data types (e.g. `\"database\".\"schema\".\"function\"(NUMBER, VARCHAR)`). Only secure functions can be shared.",
+		ValidateDiagFunc: isValidFunctionIdentifier(),
+		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
+	},
 	"on_table": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -79,6 +104,54 @@ var grantPrivilegesToShareSchema = map[string]*schema.Schema{
 		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
 		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
 	},
+	"on_external_table": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the external table on which privileges will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
+	},
+	"on_all_external_tables_in_schema": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified identifier for the schema for which the specified privilege will be granted for all external tables.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
+	},
+	"on_iceberg_table": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the Iceberg table on which privileges will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
+	},
+	"on_all_iceberg_tables_in_schema": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified identifier for the schema for which the specified privilege will be granted for all Iceberg tables.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
+	},
+	"on_dynamic_table": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the dynamic table on which privileges will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
+	},
+	"on_all_dynamic_tables_in_schema": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified identifier for the schema for which the specified privilege will be granted for all dynamic tables.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
+	},
 	"on_tag": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -95,6 +168,14 @@ var grantPrivilegesToShareSchema = map[string]*schema.Schema{
 		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
 		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
 	},
+	"on_materialized_view": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the materialized view on which privileges will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		ExactlyOneOf:     grantPrivilegesToShareGrantExactlyOneOfValidation,
+	},
 }
 
 func GrantPrivilegesToShare() *schema.Resource {
@@ -124,35 +205,15 @@ func ImportGrantPrivilegesToShare() func(ctx context.Context, d *schema.Resource
 			return nil, err
 		}
 
+		var onValue string
 		switch id.Kind {
 		case OnDatabaseShareGrantKind:
-			if err := d.Set("on_database", id.Identifier.Name()); err != nil {
-				return nil, err
-			}
-		case OnSchemaShareGrantKind:
-			if err := d.Set("on_schema", id.Identifier.FullyQualifiedName()); err != nil {
-				return nil, err
-			}
-		// TODO(SNOW-990811) case OnFunctionShareGrantKind:
-		//	if err := d.Set("function_name", id.Identifier.FullyQualifiedName()); err != nil {
-		//		return nil, err
-		//	}
-		case OnTableShareGrantKind:
-			if err := d.Set("on_table", id.Identifier.FullyQualifiedName()); err != nil {
-				return nil, err
-			}
-		case OnAllTablesInSchemaShareGrantKind:
-			if err := d.Set("on_all_tables_in_schema", id.Identifier.FullyQualifiedName()); err != nil {
-				return nil, err
-			}
-		case OnTagShareGrantKind:
-			if err := d.Set("on_tag", id.Identifier.FullyQualifiedName()); err != nil {
-				return nil, err
-			}
-		case OnViewShareGrantKind:
-			if err := d.Set("on_view", id.Identifier.FullyQualifiedName()); err != nil {
-				return nil, err
-			}
+			onValue = id.Identifier.Name()
+		default:
+			onValue = id.Identifier.FullyQualifiedName()
+		}
+		if err := d.Set(grantPrivilegesToShareOnAttributes[id.Kind], onValue); err != nil {
+			return nil, err
 		}
 
 		return []*schema.ResourceData{d}, nil
@@ -164,7 +225,7 @@ func CreateGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, m
 	id := createGrantPrivilegesToShareIdFromSchema(d)
 	log.Printf("[DEBUG] created identifier from schema: %s", id.String())
 
-	err := client.Grants.GrantPrivilegeToShare(ctx, getObjectPrivilegesFromSchema(d), getShareGrantOn(d), sdk.NewAccountObjectIdentifier(id.ShareName.Name()))
+	err := client.Grants.GrantPrivilegeToShare(ctx, getObjectPrivilegesFromSchema(d), getShareGrantOn(*id), sdk.NewAccountObjectIdentifier(id.ShareName.Name()))
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -213,7 +274,7 @@ func UpdateGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, m
 			}
 		}
 
-		grantOn := getShareGrantOn(d)
+		grantOn := getShareGrantOn(id)
 
 		if len(privilegesToAdd) > 0 {
 			err = client.Grants.GrantPrivilegeToShare(
@@ -272,7 +333,7 @@ func DeleteGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	err = client.Grants.RevokePrivilegeFromShare(ctx, getObjectPrivilegesFromSchema(d), getShareGrantOn(d), sdk.NewAccountObjectIdentifier(id.ShareName.Name()))
+	err = client.Grants.RevokePrivilegeFromShare(ctx, getObjectPrivilegesFromSchema(d), getShareGrantOn(id), sdk.NewAccountObjectIdentifier(id.ShareName.Name()))
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -300,11 +361,6 @@ func ReadGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	opts, grantedOn := prepareShowGrantsRequestForShare(id)
-	if opts == nil {
-		return nil
-	}
-
 	client := meta.(*provider.Context).Client
 	if _, err := client.Shares.ShowByID(ctx, id.ShareName); err != nil && errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
 		d.SetId("")
@@ -317,7 +373,7 @@ func ReadGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	grants, err := client.Grants.Show(ctx, opts)
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Share: &sdk.ShowGrantsToShare{Name: id.ShareName}}})
 	if err != nil {
 		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
 			d.SetId("")
@@ -338,20 +394,14 @@ func ReadGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	var privileges []string
-	for _, grant := range grants {
-		if grant.GrantedTo != sdk.ObjectTypeShare {
-			continue
-		}
-		// Only consider privileges that are already present in the ID, so we
-		// don't delete privileges managed by other resources.
-		if !slices.Contains(id.Privileges, grant.Privilege) {
-			continue
-		}
-		if grant.GranteeName.Name() == id.ShareName.Name() {
-			if grantedOn == grant.GrantedOn {
-				privileges = append(privileges, grant.Privilege)
-			}
+	privileges, err := shareGrantPrivileges(ctx, client, id, grants)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve objects in schema",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
 		}
 	}
 
@@ -380,36 +430,24 @@ func createGrantPrivilegesToShareIdFromSchema(d *schema.ResourceData) *GrantPriv
 	id.ShareName = sdk.NewAccountObjectIdentifier(d.Get("to_share").(string))
 	id.Privileges = expandStringList(d.Get("privileges").(*schema.Set).List())
 
-	databaseName, databaseNameOk := d.GetOk("on_database")
-	schemaName, schemaNameOk := d.GetOk("on_schema")
-	// TODO(SNOW-990811) functionName, functionNameOk := d.GetOk("function_name")
-	tableName, tableNameOk := d.GetOk("on_table")
-	allTablesInSchema, allTablesInSchemaOk := d.GetOk("on_all_tables_in_schema")
-	tagName, tagNameOk := d.GetOk("on_tag")
-	viewName, viewNameOk := d.GetOk("on_view")
-
-	switch {
-	case databaseNameOk:
-		id.Kind = OnDatabaseShareGrantKind
-		id.Identifier = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(databaseName.(string))
-	case schemaNameOk:
-		id.Kind = OnSchemaShareGrantKind
-		id.Identifier = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(schemaName.(string))
-	// TODO(SNOW-990811) case functionNameOk:
-	//	id.Kind = OnFunctionShareGrantKind
-	//	id.Identifier = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(functionName.(string))
-	case tableNameOk:
-		id.Kind = OnTableShareGrantKind
-		id.Identifier = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(tableName.(string))
-	case allTablesInSchemaOk:
-		id.Kind = OnAllTablesInSchemaShareGrantKind
-		id.Identifier = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(allTablesInSchema.(string))
-	case tagNameOk:
-		id.Kind = OnTagShareGrantKind
-		id.Identifier = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(tagName.(string))
-	case viewNameOk:
-		id.Kind = OnViewShareGrantKind
-		id.Identifier = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(viewName.(string))
+	for kind, attribute := range grantPrivilegesToShareOnAttributes {
+		value, ok := d.GetOk(attribute)
+		if !ok || len(value.(string)) == 0 {
+			continue
+		}
+		id.Kind = kind
+		switch kind {
+		case OnDatabaseShareGrantKind:
+			id.Identifier = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(value.(string))
+		case OnSchemaShareGrantKind,
+			OnAllTablesInSchemaShareGrantKind,
+			OnAllExternalTablesInSchemaShareGrantKind,
+			OnAllIcebergTablesInSchemaShareGrantKind,
+			OnAllDynamicTablesInSchemaShareGrantKind:
+			id.Identifier = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(value.(string))
+		default:
+			id.Identifier = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(value.(string))
+		}
 	}
 
 	return id
@@ -424,67 +462,165 @@ func getObjectPrivilegesFromSchema(d *schema.ResourceData) []sdk.ObjectPrivilege
 	return objectPrivileges
 }
 
-func getShareGrantOn(d *schema.ResourceData) *sdk.ShareGrantOn {
+func getShareGrantOn(id GrantPrivilegesToShareId) *sdk.ShareGrantOn {
 	grantOn := new(sdk.ShareGrantOn)
 
-	databaseName, databaseNameOk := d.GetOk("on_database")
-	schemaName, schemaNameOk := d.GetOk("on_schema")
-	// TODO(SNOW-990811) functionName, functionNameOk := d.GetOk("on_function")
-	tableName, tableNameOk := d.GetOk("on_table")
-	allTablesInSchema, allTablesInSchemaOk := d.GetOk("on_all_tables_in_schema")
-	tagName, tagNameOk := d.GetOk("on_tag")
-	viewName, viewNameOk := d.GetOk("on_view")
-
-	switch {
-	case len(databaseName.(string)) > 0 && databaseNameOk:
-		grantOn.Database = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(databaseName.(string))
-	case len(schemaName.(string)) > 0 && schemaNameOk:
-		grantOn.Schema = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(schemaName.(string))
-	// TODO(SNOW-990811) case len(functionName.(string)) > 0 && functionNameOk:
-	//	grantOn.Function = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(functionName.(string))
-	case len(tableName.(string)) > 0 && tableNameOk:
+	switch id.Kind {
+	case OnDatabaseShareGrantKind:
+		grantOn.Database = id.Identifier.(sdk.AccountObjectIdentifier)
+	case OnSchemaShareGrantKind:
+		grantOn.Schema = id.Identifier.(sdk.DatabaseObjectIdentifier)
+	case OnFunctionShareGrantKind:
+		grantOn.Function = id.Identifier.(sdk.SchemaObjectIdentifier)
+	case OnTableShareGrantKind:
 		grantOn.Table = &sdk.OnTable{
-			Name: sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(tableName.(string)),
+			Name: id.Identifier.(sdk.SchemaObjectIdentifier),
 		}
-	case len(allTablesInSchema.(string)) > 0 && allTablesInSchemaOk:
+	case OnAllTablesInSchemaShareGrantKind:
 		grantOn.Table = &sdk.OnTable{
-			AllInSchema: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(allTablesInSchema.(string)),
+			AllInSchema: id.Identifier.(sdk.DatabaseObjectIdentifier),
+		}
+	case OnExternalTableShareGrantKind:
+		grantOn.ExternalTable = &sdk.OnExternalTable{
+			Name: id.Identifier.(sdk.SchemaObjectIdentifier),
+		}
+	case OnAllExternalTablesInSchemaShareGrantKind:
+		grantOn.ExternalTable = &sdk.OnExternalTable{
+			AllInSchema: id.Identifier.(sdk.DatabaseObjectIdentifier),
 		}
-	case len(tagName.(string)) > 0 && tagNameOk:
-		grantOn.Tag = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(tagName.(string))
-	case len(viewName.(string)) > 0 && viewNameOk:
-		grantOn.View = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(viewName.(string))
+	case OnIcebergTableShareGrantKind:
+		grantOn.IcebergTable = &sdk.OnIcebergTable{
+			Name: id.Identifier.(sdk.SchemaObjectIdentifier),
+		}
+	case OnAllIcebergTablesInSchemaShareGrantKind:
+		grantOn.IcebergTable = &sdk.OnIcebergTable{
+			AllInSchema: id.Identifier.(sdk.DatabaseObjectIdentifier),
+		}
+	case OnDynamicTableShareGrantKind:
+		grantOn.DynamicTable = &sdk.OnDynamicTable{
+			Name: id.Identifier.(sdk.SchemaObjectIdentifier),
+		}
+	case OnAllDynamicTablesInSchemaShareGrantKind:
+		grantOn.DynamicTable = &sdk.OnDynamicTable{
+			AllInSchema: id.Identifier.(sdk.DatabaseObjectIdentifier),
+		}
+	case OnTagShareGrantKind:
+		grantOn.Tag = id.Identifier.(sdk.SchemaObjectIdentifier)
+	case OnViewShareGrantKind:
+		grantOn.View = id.Identifier.(sdk.SchemaObjectIdentifier)
+	case OnMaterializedViewShareGrantKind:
+		grantOn.MaterializedView = id.Identifier.(sdk.SchemaObjectIdentifier)
 	}
 
 	return grantOn
 }
 
-func prepareShowGrantsRequestForShare(id GrantPrivilegesToShareId) (*sdk.ShowGrantOptions, sdk.ObjectType) {
-	opts := new(sdk.ShowGrantOptions)
-	var objectType sdk.ObjectType
+// shareGrantObjectTypes maps every share grant kind to the object type reported in the granted_on column of SHOW GRANTS TO SHARE.
+var shareGrantObjectTypes = map[ShareGrantKind]sdk.ObjectType{
+	OnDatabaseShareGrantKind:                  sdk.ObjectTypeDatabase,
+	OnSchemaShareGrantKind:                    sdk.ObjectTypeSchema,
+	OnFunctionShareGrantKind:                  sdk.ObjectTypeFunction,
+	OnTableShareGrantKind:                     sdk.ObjectTypeTable,
+	OnAllTablesInSchemaShareGrantKind:         sdk.ObjectTypeTable,
+	OnExternalTableShareGrantKind:             sdk.ObjectTypeExternalTable,
+	OnAllExternalTablesInSchemaShareGrantKind: sdk.ObjectTypeExternalTable,
+	OnIcebergTableShareGrantKind:              sdk.ObjectTypeIcebergTable,
+	OnAllIcebergTablesInSchemaShareGrantKind:  sdk.ObjectTypeIcebergTable,
+	OnDynamicTableShareGrantKind:              sdk.ObjectTypeDynamicTable,
+	OnAllDynamicTablesInSchemaShareGrantKind:  sdk.ObjectTypeDynamicTable,
+	OnTagShareGrantKind:                       sdk.ObjectTypeTag,
+	OnViewShareGrantKind:                      sdk.ObjectTypeView,
+	OnMaterializedViewShareGrantKind:          sdk.ObjectTypeMaterializedView,
+}
 
+// shareGrantPrivileges returns the privileges from the id that are granted to the share according to the grants from
+// SHOW GRANTS TO SHARE. A privilege granted with one of the on_all_..._in_schema kinds is considered granted only when
+// every object of the given type currently in the schema has it.
+func shareGrantPrivileges(ctx context.Context, client *sdk.Client, id GrantPrivilegesToShareId, grants []sdk.Grant) ([]string, error) {
+	grantedOn := shareGrantObjectTypes[id.Kind]
+	var privileges []string
 	switch id.Kind {
-	case OnDatabaseShareGrantKind:
-		objectType = sdk.ObjectTypeDatabase
-	case OnSchemaShareGrantKind:
-		objectType = sdk.ObjectTypeSchema
-	case OnTableShareGrantKind:
-		objectType = sdk.ObjectTypeTable
-	case OnAllTablesInSchemaShareGrantKind:
-		log.Printf("[INFO] Show with on_all_tables_in_schema option is skipped. No changes in privileges in Snowflake will be detected.")
-		return nil, ""
-	case OnTagShareGrantKind:
-		objectType = sdk.ObjectTypeTag
-	case OnViewShareGrantKind:
-		objectType = sdk.ObjectTypeView
+	case OnAllTablesInSchemaShareGrantKind,
+		OnAllExternalTablesInSchemaShareGrantKind,
+		OnAllIcebergTablesInSchemaShareGrantKind,
+		OnAllDynamicTablesInSchemaShareGrantKind:
+		schemaId := id.Identifier.(sdk.DatabaseObjectIdentifier)
+		objects, err := showSchemaObjectsIn(ctx, client, &sdk.GrantOnSchemaObjectIn{
+			PluralObjectType: grantedOn.Plural(),
+			InSchema:         &schemaId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		grantedObjects := make(map[string]map[string]bool)
+		for _, grant := range grants {
+			if grant.GrantedOn != grantedOn || !slices.Contains(id.Privileges, grant.Privilege) {
+				continue
+			}
+			if grantedObjects[grant.Privilege] == nil {
+				grantedObjects[grant.Privilege] = make(map[string]bool)
+			}
+			grantedObjects[grant.Privilege][sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(grant.Name.Name()).FullyQualifiedName()] = true
+		}
+		for _, privilege := range id.Privileges {
+			if !slices.ContainsFunc(objects, func(object schemaObjectInContainer) bool {
+				return !grantedObjects[privilege][object.Id.FullyQualifiedName()]
+			}) {
+				privileges = append(privileges, privilege)
+			}
+		}
+	default:
+		for _, grant := range grants {
+			// Only consider privileges that are already present in the ID, so we
+			// don't delete privileges managed by other resources.
+			if grant.GrantedOn != grantedOn || !slices.Contains(id.Privileges, grant.Privilege) || slices.Contains(privileges, grant.Privilege) {
+				continue
+			}
+			if isShareGrantOnObject(id, grant.Name.Name()) {
+				privileges = append(privileges, grant.Privilege)
+			}
+		}
 	}
+	return privileges, nil
+}
 
-	opts.On = &sdk.ShowGrantsOn{
-		Object: &sdk.Object{
-			ObjectType: objectType,
-			Name:       id.Identifier,
-		},
+// isShareGrantOnObject checks if the name reported by SHOW GRANTS TO SHARE refers to the object from the id. Functions are
+// reported with their signature, e.g. DATABASE.SCHEMA."FUNCTION(ARG NUMBER):VARCHAR".
+func isShareGrantOnObject(id GrantPrivilegesToShareId, name string) bool {
+	switch identifier := id.Identifier.(type) {
+	case sdk.AccountObjectIdentifier:
+		return sdk.NewAccountObjectIdentifierFromFullyQualifiedName(name).FullyQualifiedName() == identifier.FullyQualifiedName()
+	case sdk.DatabaseObjectIdentifier:
+		return sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(name).FullyQualifiedName() == identifier.FullyQualifiedName()
+	case sdk.SchemaObjectIdentifier:
+		if id.Kind != OnFunctionShareGrantKind {
+			return sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name).FullyQualifiedName() == identifier.FullyQualifiedName()
+		}
+		argumentsStart := strings.Index(name, "(")
+		if argumentsStart == -1 {
+			return false
+		}
+		argumentsEnd, depth := -1, 0
+		for i := argumentsStart; i < len(name) && argumentsEnd == -1; i++ {
+			switch name[i] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+				if depth == 0 {
+					argumentsEnd = i
+				}
+			}
+		}
+		if argumentsEnd == -1 {
+			return false
+		}
+		functionId := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name[:argumentsStart])
+		arguments, err := parseFunctionArgumentTypes(name[argumentsStart+1:argumentsEnd], true)
+		if err != nil {
+			return false
+		}
+		return sdk.NewSchemaObjectIdentifierWithArguments(functionId.DatabaseName(), functionId.SchemaName(), functionId.Name(), arguments).FullyQualifiedName() == identifier.FullyQualifiedName()
 	}
-
-	return opts, objectType
+	return false
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAcc_GrantPrivilegesToShare_OnDatabase(t *testing.T) {
@@ -223,6 +224,71 @@ func TestAcc_GrantPrivilegesToShare_OnAllTablesInSchema(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// a table created outside of Terraform is not granted to the share yet
+			{
+				PreConfig: func() {
+					_, err := acc.Client(t).ExecForTests(context.Background(), fmt.Sprintf("create table %s (id number)", sdk.NewSchemaObjectIdentifier(schemaName.DatabaseName(), schemaName.Name(), "OUTSIDE_TABLE").FullyQualifiedName()))
+					require.NoError(t, err)
+				},
+				ConfigDirectory:    acc.ConfigurationDirectory("TestAcc_GrantPrivilegesToShare/OnAllTablesInSchema"),
+				ConfigVariables:    configVariables(true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GrantPrivilegesToShare/OnAllTablesInSchema"),
+				ConfigVariables: configVariables(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", sdk.ObjectPrivilegeSelect.String()),
+				),
+			},
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GrantPrivilegesToShare/OnAllTablesInSchema_NoGrant"),
+				ConfigVariables: configVariables(false),
+				Check:           testAccCheckSharePrivilegesRevoked(),
+			},
+		},
+	})
+}
+
+func TestAcc_GrantPrivilegesToShare_OnAllDynamicTablesInSchema(t *testing.T) {
+	databaseName := sdk.NewAccountObjectIdentifier(strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+	schemaName := sdk.NewDatabaseObjectIdentifier(databaseName.Name(), strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+	shareName := sdk.NewAccountObjectIdentifier(strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+
+	configVariables := func(withGrant bool) config.Variables {
+		variables := config.Variables{
+			"to_share": config.StringVariable(shareName.Name()),
+			"database": config.StringVariable(databaseName.Name()),
+			"schema":   config.StringVariable(schemaName.Name()),
+		}
+		if withGrant {
+			variables["privileges"] = config.ListVariable(
+				config.StringVariable(sdk.ObjectPrivilegeSelect.String()),
+			)
+		}
+		return variables
+	}
+	resourceName := "snowflake_grant_privileges_to_share.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GrantPrivilegesToShare/OnAllDynamicTablesInSchema"),
+				ConfigVariables: configVariables(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "to_share", shareName.Name()),
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", sdk.ObjectPrivilegeSelect.String()),
+					resource.TestCheckResourceAttr(resourceName, "on_all_dynamic_tables_in_schema", schemaName.FullyQualifiedName()),
+				),
+			},
 			{
 				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GrantPrivilegesToShare/OnAllTablesInSchema_NoGrant"),
 				ConfigVariables: configVariables(false),
@@ -232,6 +298,61 @@ func TestAcc_GrantPrivilegesToShare_OnAllTablesInSchema(t *testing.T) {
 	})
 }
 
+func TestAcc_GrantPrivilegesToShare_OnFunction(t *testing.T) {
+	databaseName := sdk.NewAccountObjectIdentifier(strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+	schemaName := sdk.NewDatabaseObjectIdentifier(databaseName.Name(), strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+	functionName := sdk.NewSchemaObjectIdentifierWithArguments(databaseName.Name(), schemaName.Name(), strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)), []sdk.DataType{sdk.DataTypeNumber})
+	shareName := sdk.NewAccountObjectIdentifier(strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+
+	configVariables := func(withGrant bool) config.Variables {
+		variables := config.Variables{
+			"to_share":    config.StringVariable(shareName.Name()),
+			"database":    config.StringVariable(databaseName.Name()),
+			"schema":      config.StringVariable(schemaName.Name()),
+			"on_function": config.StringVariable(functionName.Name()),
+		}
+		if withGrant {
+			variables["privileges"] = config.ListVariable(
+				config.StringVariable(sdk.ObjectPrivilegeUsage.String()),
+			)
+		}
+		return variables
+	}
+	resourceName := "snowflake_grant_privileges_to_share.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GrantPrivilegesToShare/OnFunction"),
+				ConfigVariables: configVariables(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "to_share", shareName.Name()),
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", sdk.ObjectPrivilegeUsage.String()),
+					resource.TestCheckResourceAttr(resourceName, "on_function", functionName.FullyQualifiedName()),
+				),
+			},
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_GrantPrivilegesToShare/OnFunction"),
+				ConfigVariables:   configVariables(true),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GrantPrivilegesToShare/OnFunction_NoGrant"),
+				ConfigVariables: configVariables(false),
+				Check:           testAccCheckSharePrivilegesRevoked(),
+			},
+		},
+	})
+}
+
 func TestAcc_GrantPrivilegesToShare_OnView(t *testing.T) {
 	databaseName := sdk.NewAccountObjectIdentifier(strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
 	schemaName := sdk.NewDatabaseObjectIdentifier(databaseName.Name(), strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
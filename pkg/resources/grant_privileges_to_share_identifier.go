@@ -11,14 +11,20 @@ import (
 type ShareGrantKind string
 
 const (
-	OnDatabaseShareGrantKind ShareGrantKind = "OnDatabase"
-	OnSchemaShareGrantKind   ShareGrantKind = "OnSchema"
-	//	TODO(SNOW-1021686): Because function identifier contains arguments which are not supported right now
-	// OnFunctionShareGrantKind          ShareGrantKind = "OnFunction"
-	OnTableShareGrantKind             ShareGrantKind = "OnTable"
-	OnAllTablesInSchemaShareGrantKind ShareGrantKind = "OnAllTablesInSchema"
-	OnTagShareGrantKind               ShareGrantKind = "OnTag"
-	OnViewShareGrantKind              ShareGrantKind = "OnView"
+	OnDatabaseShareGrantKind                  ShareGrantKind = "OnDatabase"
+	OnSchemaShareGrantKind                    ShareGrantKind = "OnSchema"
+	OnFunctionShareGrantKind                  ShareGrantKind = "OnFunction"
+	OnTableShareGrantKind                     ShareGrantKind = "OnTable"
+	OnAllTablesInSchemaShareGrantKind         ShareGrantKind = "OnAllTablesInSchema"
+	OnExternalTableShareGrantKind             ShareGrantKind = "OnExternalTable"
+	OnAllExternalTablesInSchemaShareGrantKind ShareGrantKind = "OnAllExternalTablesInSchema"
+	OnIcebergTableShareGrantKind              ShareGrantKind = "OnIcebergTable"
+	OnAllIcebergTablesInSchemaShareGrantKind  ShareGrantKind = "OnAllIcebergTablesInSchema"
+	OnDynamicTableShareGrantKind              ShareGrantKind = "OnDynamicTable"
+	OnAllDynamicTablesInSchemaShareGrantKind  ShareGrantKind = "OnAllDynamicTablesInSchema"
+	OnTagShareGrantKind                       ShareGrantKind = "OnTag"
+	OnViewShareGrantKind                      ShareGrantKind = "OnView"
+	OnMaterializedViewShareGrantKind          ShareGrantKind = "OnMaterializedView"
 )
 
 type GrantPrivilegesToShareId struct {
@@ -53,6 +59,16 @@ func ParseGrantPrivilegesToShareId(idString string) (GrantPrivilegesToShareId, e
 	grantPrivilegesToShareId.Privileges = privileges
 	grantPrivilegesToShareId.Kind = ShareGrantKind(parts[2])
 
+	// Function identifiers contain argument types, which cannot be decoded as a parameter identifier.
+	if grantPrivilegesToShareId.Kind == OnFunctionShareGrantKind {
+		functionId, err := decodeFunctionParameterID(parts[3])
+		if err != nil {
+			return grantPrivilegesToShareId, fmt.Errorf(`invalid function identifier, expected fully qualified name with argument types: "<database_name>"."<schema_name>"."<function_name>"(<argument_types>), but instead got: %s, err = %w`, parts[3], err)
+		}
+		grantPrivilegesToShareId.Identifier = functionId
+		return grantPrivilegesToShareId, nil
+	}
+
 	id, err := helpers.DecodeSnowflakeParameterID(parts[3])
 	if err != nil {
 		return grantPrivilegesToShareId, err
@@ -69,7 +85,11 @@ func ParseGrantPrivilegesToShareId(idString string) (GrantPrivilegesToShareId, e
 				getExpectedIdentifierRepresentationFromParam(id),
 			)
 		}
-	case OnSchemaShareGrantKind, OnAllTablesInSchemaShareGrantKind:
+	case OnSchemaShareGrantKind,
+		OnAllTablesInSchemaShareGrantKind,
+		OnAllExternalTablesInSchemaShareGrantKind,
+		OnAllIcebergTablesInSchemaShareGrantKind,
+		OnAllDynamicTablesInSchemaShareGrantKind:
 		if typedIdentifier, ok := id.(sdk.DatabaseObjectIdentifier); ok {
 			grantPrivilegesToShareId.Identifier = typedIdentifier
 		} else {
@@ -79,7 +99,13 @@ func ParseGrantPrivilegesToShareId(idString string) (GrantPrivilegesToShareId, e
 				getExpectedIdentifierRepresentationFromParam(id),
 			)
 		}
-	case OnTableShareGrantKind, OnViewShareGrantKind, OnTagShareGrantKind: // TODO(SNOW-1021686) , OnFunctionShareGrantKind:
+	case OnTableShareGrantKind,
+		OnExternalTableShareGrantKind,
+		OnIcebergTableShareGrantKind,
+		OnDynamicTableShareGrantKind,
+		OnViewShareGrantKind,
+		OnMaterializedViewShareGrantKind,
+		OnTagShareGrantKind:
 		if typedIdentifier, ok := id.(sdk.SchemaObjectIdentifier); ok {
 			grantPrivilegesToShareId.Identifier = typedIdentifier
 		} else {
@@ -95,3 +121,74 @@ func ParseGrantPrivilegesToShareId(idString string) (GrantPrivilegesToShareId, e
 
 	return grantPrivilegesToShareId, nil
 }
+
+// decodeFunctionParameterID decodes a function identifier with argument types, e.g. "database"."schema"."function"(NUMBER, VARCHAR).
+// The name is decoded with helpers.DecodeSnowflakeParameterID, so the quoted parts may contain dots and parentheses.
+func decodeFunctionParameterID(identifier string) (sdk.SchemaObjectIdentifier, error) {
+	argumentsStart, quoted := -1, false
+	for i, c := range identifier {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == '(' && !quoted {
+			argumentsStart = i
+			break
+		}
+	}
+	if argumentsStart == -1 || !strings.HasSuffix(identifier, ")") {
+		return sdk.SchemaObjectIdentifier{}, fmt.Errorf("missing argument types")
+	}
+
+	id, err := helpers.DecodeSnowflakeParameterID(identifier[:argumentsStart])
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, err
+	}
+	functionId, ok := id.(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return sdk.SchemaObjectIdentifier{}, fmt.Errorf("expected a schema object, got %s", getExpectedIdentifierRepresentationFromParam(id))
+	}
+
+	arguments, err := parseFunctionArgumentTypes(identifier[argumentsStart+1:len(identifier)-1], false)
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, err
+	}
+	return sdk.NewSchemaObjectIdentifierWithArguments(functionId.DatabaseName(), functionId.SchemaName(), functionId.Name(), arguments), nil
+}
+
+// parseFunctionArgumentTypes parses the comma separated arguments of a function signature, e.g. NUMBER(38,0), VARCHAR.
+// With argumentNames, every argument starts with its name, e.g. A NUMBER(38,0), B VARCHAR.
+func parseFunctionArgumentTypes(signature string, argumentNames bool) ([]sdk.DataType, error) {
+	arguments := make([]sdk.DataType, 0)
+	depth, argumentStart := 0, 0
+	for i := 0; i <= len(signature); i++ {
+		if i < len(signature) {
+			switch signature[i] {
+			case '(':
+				depth++
+				continue
+			case ')':
+				depth--
+				continue
+			case ',':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		argument := strings.TrimSpace(signature[argumentStart:i])
+		argumentStart = i + 1
+		if argument == "" {
+			continue
+		}
+		if _, argumentType, found := strings.Cut(argument, " "); argumentNames && found {
+			argument = strings.TrimSpace(argumentType)
+		}
+		dataType, err := sdk.ToDataType(argument)
+		if err != nil {
+			return nil, err
+		}
+		arguments = append(arguments, dataType)
+	}
+	return arguments, nil
+}
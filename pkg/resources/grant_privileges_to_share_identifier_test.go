@@ -34,17 +34,36 @@ func TestParseGrantPrivilegesToShareId(t *testing.T) {
 				Identifier: sdk.NewDatabaseObjectIdentifier("on-database-name", "on-schema-name"),
 			},
 		},
-		// TODO(SNOW-1021686): This is wrong and should be fixed (function's last part of identifier cannot be enclosed with quotes like that)
-		//{
-		//	Name:       "grant privileges on function to share",
-		//	Identifier: `"share-name"|USAGE|OnFunction|"on-database-name"."on-schema-name".on-function-name(INT, VARCHAR)`,
-		//	Expected: GrantPrivilegesToShareId{
-		//		ShareName:  sdk.NewExternalObjectIdentifierFromFullyQualifiedName("share-name"),
-		//		Privileges: []string{"USAGE"},
-		//		Kind:       OnFunctionShareGrantKind,
-		//		Identifier: sdk.NewSchemaObjectIdentifier("on-database-name", "on-schema-name", "on-function-name(INT, VARCHAR)"),
-		//	},
-		// },
+		{
+			Name:       "grant privileges on function to share",
+			Identifier: `"share-name"|USAGE|OnFunction|"on-database-name"."on-schema-name"."on-function-name"(NUMBER, VARCHAR)`,
+			Expected: GrantPrivilegesToShareId{
+				ShareName:  sdk.NewAccountObjectIdentifier("share-name"),
+				Privileges: []string{"USAGE"},
+				Kind:       OnFunctionShareGrantKind,
+				Identifier: sdk.NewSchemaObjectIdentifierWithArguments("on-database-name", "on-schema-name", "on-function-name", []sdk.DataType{sdk.DataTypeNumber, sdk.DataTypeVARCHAR}),
+			},
+		},
+		{
+			Name:       "grant privileges on function with dots in quoted names to share",
+			Identifier: `"share-name"|USAGE|OnFunction|"on.database"."on.schema"."on.function(x)"(NUMBER(38,0), VARCHAR)`,
+			Expected: GrantPrivilegesToShareId{
+				ShareName:  sdk.NewAccountObjectIdentifier("share-name"),
+				Privileges: []string{"USAGE"},
+				Kind:       OnFunctionShareGrantKind,
+				Identifier: sdk.NewSchemaObjectIdentifierWithArguments("on.database", "on.schema", "on.function(x)", []sdk.DataType{sdk.DataTypeNumber, sdk.DataTypeVARCHAR}),
+			},
+		},
+		{
+			Name:       "grant privileges on function without arguments to share",
+			Identifier: `"share-name"|USAGE|OnFunction|"on-database-name"."on-schema-name"."on-function-name"()`,
+			Expected: GrantPrivilegesToShareId{
+				ShareName:  sdk.NewAccountObjectIdentifier("share-name"),
+				Privileges: []string{"USAGE"},
+				Kind:       OnFunctionShareGrantKind,
+				Identifier: sdk.NewSchemaObjectIdentifierWithArguments("on-database-name", "on-schema-name", "on-function-name", []sdk.DataType{}),
+			},
+		},
 		{
 			Name:       "grant privileges on table to share",
 			Identifier: `"share-name"|EVOLVE SCHEMA|OnTable|"on-database-name"."on-schema-name"."on-table-name"`,
@@ -85,6 +104,36 @@ func TestParseGrantPrivilegesToShareId(t *testing.T) {
 				Identifier: sdk.NewSchemaObjectIdentifier("on-database-name", "on-schema-name", "on-view-name"),
 			},
 		},
+		{
+			Name:       "grant privileges on all dynamic tables in schema to share",
+			Identifier: `"share-name"|SELECT|OnAllDynamicTablesInSchema|"on-database-name"."on-schema-name"`,
+			Expected: GrantPrivilegesToShareId{
+				ShareName:  sdk.NewAccountObjectIdentifier("share-name"),
+				Privileges: []string{"SELECT"},
+				Kind:       OnAllDynamicTablesInSchemaShareGrantKind,
+				Identifier: sdk.NewDatabaseObjectIdentifier("on-database-name", "on-schema-name"),
+			},
+		},
+		{
+			Name:       "grant privileges on external table to share",
+			Identifier: `"share-name"|SELECT|OnExternalTable|"on-database-name"."on-schema-name"."on-table-name"`,
+			Expected: GrantPrivilegesToShareId{
+				ShareName:  sdk.NewAccountObjectIdentifier("share-name"),
+				Privileges: []string{"SELECT"},
+				Kind:       OnExternalTableShareGrantKind,
+				Identifier: sdk.NewSchemaObjectIdentifier("on-database-name", "on-schema-name", "on-table-name"),
+			},
+		},
+		{
+			Name:       "grant privileges on materialized view to share",
+			Identifier: `"share-name"|SELECT|OnMaterializedView|"on-database-name"."on-schema-name"."on-view-name"`,
+			Expected: GrantPrivilegesToShareId{
+				ShareName:  sdk.NewAccountObjectIdentifier("share-name"),
+				Privileges: []string{"SELECT"},
+				Kind:       OnMaterializedViewShareGrantKind,
+				Identifier: sdk.NewSchemaObjectIdentifier("on-database-name", "on-schema-name", "on-view-name"),
+			},
+		},
 		{
 			Name:       "validation: not enough parts",
 			Identifier: `"share-name"|SELECT|OnDatabase`,
@@ -115,6 +164,11 @@ func TestParseGrantPrivilegesToShareId(t *testing.T) {
 			Identifier: `"share-name"|SELECT|OnSchema|one.two.three`,
 			Error:      `invalid identifier, expected fully qualified name of database object: <database_name>.<name>, but instead got: <database_name>.<schema_name>.<name>`,
 		},
+		{
+			Name:       "validation: function without arguments",
+			Identifier: `"share-name"|USAGE|OnFunction|"database-name"."schema-name"."function-name"`,
+			Error:      `invalid function identifier, expected fully qualified name with argument types`,
+		},
 		{
 			Name:       "validation: invalid schema object identifier",
 			Identifier: `"share-name"|SELECT|OnTable|one`,
@@ -163,17 +217,16 @@ func TestGrantPrivilegesToShareIdString(t *testing.T) {
 			},
 			Expected: `"share-name"|USAGE|OnSchema|"database-name"."schema-name"`,
 		},
-		// TODO(SNOW-1021686): This is wrong and should be fixed (function's last part of identifier cannot be enclosed with quotes like that)
-		//{
-		//	Name: "grant privileges on function to share",
-		//	Identifier: GrantPrivilegesToShareId{
-		//		ShareName:  sdk.NewExternalObjectIdentifierFromFullyQualifiedName("share-name"),
-		//		Privileges: []string{"USAGE"},
-		//		Kind:       OnFunctionShareGrantKind,
-		//		Identifier: sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "function-name(INT, VARCHAR)"),
-		//	},
-		//	Expected: `"share-name"|USAGE|OnFunction|"database-name"."schema-name".\"function-name(INT, VARCHAR)\"`,
-		// },
+		{
+			Name: "grant privileges on function to share",
+			Identifier: GrantPrivilegesToShareId{
+				ShareName:  sdk.NewAccountObjectIdentifier("share-name"),
+				Privileges: []string{"USAGE"},
+				Kind:       OnFunctionShareGrantKind,
+				Identifier: sdk.NewSchemaObjectIdentifierWithArguments("database-name", "schema-name", "function-name", []sdk.DataType{sdk.DataTypeNumber, sdk.DataTypeVARCHAR}),
+			},
+			Expected: `"share-name"|USAGE|OnFunction|"database-name"."schema-name"."function-name"(NUMBER, VARCHAR)`,
+		},
 		{
 			Name: "grant privileges on table to share",
 			Identifier: GrantPrivilegesToShareId{
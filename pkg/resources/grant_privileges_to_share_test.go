@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsShareGrantOnObject(t *testing.T) {
+	functionId := sdk.NewSchemaObjectIdentifierWithArguments("database-name", "schema-name", "function-name", []sdk.DataType{sdk.DataTypeNumber, sdk.DataTypeVARCHAR})
+
+	testCases := []struct {
+		Name     string
+		Id       GrantPrivilegesToShareId
+		Granted  string
+		Expected bool
+	}{
+		{
+			Name:     "database",
+			Id:       GrantPrivilegesToShareId{Kind: OnDatabaseShareGrantKind, Identifier: sdk.NewAccountObjectIdentifier("database-name")},
+			Granted:  "database-name",
+			Expected: true,
+		},
+		{
+			Name:     "schema",
+			Id:       GrantPrivilegesToShareId{Kind: OnSchemaShareGrantKind, Identifier: sdk.NewDatabaseObjectIdentifier("database-name", "schema-name")},
+			Granted:  `database-name."schema-name"`,
+			Expected: true,
+		},
+		{
+			Name:     "table",
+			Id:       GrantPrivilegesToShareId{Kind: OnTableShareGrantKind, Identifier: sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "table-name")},
+			Granted:  `database-name.schema-name.table-name`,
+			Expected: true,
+		},
+		{
+			Name:     "other table",
+			Id:       GrantPrivilegesToShareId{Kind: OnTableShareGrantKind, Identifier: sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "table-name")},
+			Granted:  `database-name.schema-name.other-table-name`,
+			Expected: false,
+		},
+		{
+			Name:     "function with argument names and return type",
+			Id:       GrantPrivilegesToShareId{Kind: OnFunctionShareGrantKind, Identifier: functionId},
+			Granted:  `database-name.schema-name."function-name(A NUMBER(38,0), B VARCHAR):NUMBER(38,0)`,
+			Expected: true,
+		},
+		{
+			Name:     "function with other arguments",
+			Id:       GrantPrivilegesToShareId{Kind: OnFunctionShareGrantKind, Identifier: functionId},
+			Granted:  `database-name.schema-name."function-name(A NUMBER(38,0)):NUMBER(38,0)`,
+			Expected: false,
+		},
+		{
+			Name:     "function without signature",
+			Id:       GrantPrivilegesToShareId{Kind: OnFunctionShareGrantKind, Identifier: functionId},
+			Granted:  `database-name.schema-name.function-name`,
+			Expected: false,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, isShareGrantOnObject(tt.Id, tt.Granted))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
@@ -79,6 +80,40 @@ var managedAccountSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "URL for accessing the managed account, particularly through the web interface.",
 	},
+	"account_locator_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "URL for accessing the managed account with its account locator.",
+	},
+	"connection_config": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Values to configure a second provider instance connecting to the managed account as its administrator, e.g. to create the databases from the shares consumed by a reader account. The password is not included; use the `admin_password` value.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Account locator of the managed account, to be used as the `account` of the provider.",
+				},
+				"host": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Host of the managed account, to be used as the `host` of the provider.",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Snowflake Region in which the managed account is located.",
+				},
+				"user": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Login name of the administrator of the managed account, to be used as the `user` of the provider.",
+				},
+			},
+		},
+	},
 }
 
 // ManagedAccount returns a pointer to the resource representing a managed account.
@@ -170,6 +205,14 @@ func ReadManagedAccount(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("account_locator_url", managedAccount.AccountLocatorURL); err != nil {
+		return err
+	}
+
+	if err := d.Set("connection_config", []map[string]any{managedAccountConnection(managedAccount, d.Get("admin_name").(string))}); err != nil {
+		return err
+	}
+
 	if managedAccount.IsReader {
 		if err := d.Set("type", "READER"); err != nil {
 			return err
@@ -185,6 +228,22 @@ func ReadManagedAccount(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// managedAccountConnection returns the provider configuration values for the managed account; the host is taken from
+// the account locator URL, so it matches the account locator used as the account.
+func managedAccountConnection(managedAccount *sdk.ManagedAccount, adminName string) map[string]any {
+	accountURL := managedAccount.AccountLocatorURL
+	if accountURL == "" {
+		accountURL = managedAccount.URL
+	}
+	host := strings.TrimSuffix(strings.TrimPrefix(accountURL, "https://"), "/")
+	return map[string]any{
+		"account": managedAccount.Locator,
+		"host":    host,
+		"region":  managedAccount.Region,
+		"user":    adminName,
+	}
+}
+
 // DeleteManagedAccount implements schema.DeleteFunc.
 func DeleteManagedAccount(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*provider.Context).Client
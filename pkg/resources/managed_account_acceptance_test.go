@@ -42,6 +42,11 @@ func TestAcc_ManagedAccount(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_managed_account.test", "admin_password", adminPass),
 					resource.TestCheckResourceAttr("snowflake_managed_account.test", "comment", managedAccountComment),
 					resource.TestCheckResourceAttr("snowflake_managed_account.test", "type", "READER"),
+					resource.TestCheckResourceAttr("snowflake_managed_account.test", "connection_config.#", "1"),
+					resource.TestCheckResourceAttrPair("snowflake_managed_account.test", "connection_config.0.account", "snowflake_managed_account.test", "locator"),
+					resource.TestCheckResourceAttrPair("snowflake_managed_account.test", "connection_config.0.region", "snowflake_managed_account.test", "region"),
+					resource.TestCheckResourceAttrSet("snowflake_managed_account.test", "connection_config.0.host"),
+					resource.TestCheckResourceAttr("snowflake_managed_account.test", "connection_config.0.user", adminName),
 				),
 			},
 			// IMPORT
@@ -49,7 +54,7 @@ func TestAcc_ManagedAccount(t *testing.T) {
 				ResourceName:            "snowflake_managed_account.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"admin_name", "admin_password"},
+				ImportStateVerifyIgnore: []string{"admin_name", "admin_password", "connection_config.0.user"},
 			},
 		},
 	})
@@ -23,7 +23,7 @@ var networkPolicySchema = map[string]*schema.Schema{
 	"allowed_ip_list": {
 		Type:        schema.TypeSet,
 		Elem:        &schema.Schema{Type: schema.TypeString},
-		Required:    true,
+		Optional:    true,
 		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are allowed access to your Snowflake account",
 	},
 	// TODO: Add a ValidationFunc to ensure 0.0.0.0/0 is not in blocked_ip_list
@@ -34,6 +34,24 @@ var networkPolicySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are denied access to your Snowflake account<br><br>**Do not** add `0.0.0.0/0` to `blocked_ip_list`",
 	},
+	"allowed_network_rule_list": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Optional:    true,
+		Description: "Specifies a list of fully qualified network rules that contain the network identifiers that are allowed access to Snowflake.",
+	},
+	"blocked_network_rule_list": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Optional:    true,
+		Description: "Specifies a list of fully qualified network rules that contain the network identifiers that are denied access to Snowflake.",
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -81,7 +99,15 @@ func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		for i, v := range ipList {
 			ipRequests[i] = *sdk.NewIPRequest(v)
 		}
-		req = req.WithAllowedIpList(ipRequests)
+		req = req.WithBlockedIpList(ipRequests)
+	}
+
+	if v, ok := d.GetOk("allowed_network_rule_list"); ok {
+		req = req.WithAllowedNetworkRuleList(expandNetworkRuleIdentifiers(v.(*schema.Set).List()))
+	}
+
+	if v, ok := d.GetOk("blocked_network_rule_list"); ok {
+		req = req.WithBlockedNetworkRuleList(expandNetworkRuleIdentifiers(v.(*schema.Set).List()))
 	}
 
 	client := meta.(*provider.Context).Client
@@ -132,6 +158,15 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 			if err = d.Set("blocked_ip_list", strings.Split(desc.Value, ",")); err != nil {
 				return err
 			}
+		case "ALLOWED_NETWORK_RULE_LIST", "BLOCKED_NETWORK_RULE_LIST":
+			key := strings.ToLower(desc.Name)
+			networkRules, err := sdk.ParseNetworkRulesSnowflakeDto(desc.Value)
+			if err != nil {
+				return err
+			}
+			if err = d.Set(key, networkRuleNames(d.Get(key).(*schema.Set).List(), networkRules)); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -188,6 +223,38 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	for _, key := range []string{"allowed_network_rule_list", "blocked_network_rule_list"} {
+		if !d.HasChange(key) {
+			continue
+		}
+		o, n := d.GetChange(key)
+		toRemove := networkRuleIdentifiersDifference(o.(*schema.Set).List(), n.(*schema.Set).List())
+		toAdd := networkRuleIdentifiersDifference(n.(*schema.Set).List(), o.(*schema.Set).List())
+
+		if len(toRemove) > 0 {
+			removeReq := sdk.NewRemoveNetworkRuleRequest()
+			if key == "allowed_network_rule_list" {
+				removeReq.WithAllowedNetworkRuleList(toRemove)
+			} else {
+				removeReq.WithBlockedNetworkRuleList(toRemove)
+			}
+			if err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)).WithRemove(removeReq)); err != nil {
+				return fmt.Errorf("error removing %s from network policy %v err = %w", strings.ToUpper(key), name, err)
+			}
+		}
+		if len(toAdd) > 0 {
+			addReq := sdk.NewAddNetworkRuleRequest()
+			if key == "allowed_network_rule_list" {
+				addReq.WithAllowedNetworkRuleList(toAdd)
+			} else {
+				addReq.WithBlockedNetworkRuleList(toAdd)
+			}
+			if err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)).WithAdd(addReq)); err != nil {
+				return fmt.Errorf("error adding %s to network policy %v err = %w", strings.ToUpper(key), name, err)
+			}
+		}
+	}
+
 	return ReadNetworkPolicy(d, meta)
 }
 
@@ -216,3 +283,46 @@ func ipChangeParser(data *schema.ResourceData, key string) []string {
 	}
 	return newIps
 }
+
+func expandNetworkRuleIdentifiers(networkRules []any) []sdk.SchemaObjectIdentifier {
+	ids := make([]sdk.SchemaObjectIdentifier, len(networkRules))
+	for i, networkRule := range networkRules {
+		ids[i] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(networkRule.(string))
+	}
+	return ids
+}
+
+// networkRuleIdentifiersDifference returns the network rules from the first list that are not present in the second one.
+// The names are compared as identifiers, so "DB"."SCHEMA"."RULE" and DB.SCHEMA.RULE are the same network rule.
+func networkRuleIdentifiersDifference(networkRules []any, other []any) []sdk.SchemaObjectIdentifier {
+	otherNames := make(map[string]bool, len(other))
+	for _, id := range expandNetworkRuleIdentifiers(other) {
+		otherNames[id.FullyQualifiedName()] = true
+	}
+	difference := make([]sdk.SchemaObjectIdentifier, 0)
+	for _, id := range expandNetworkRuleIdentifiers(networkRules) {
+		if !otherNames[id.FullyQualifiedName()] {
+			difference = append(difference, id)
+		}
+	}
+	return difference
+}
+
+// networkRuleNames returns the names of the network rules set on the network policy. The names already in the state are
+// kept in the form used in the configuration, the others are returned as fully qualified names.
+func networkRuleNames(current []any, networkRules []sdk.NetworkRulesSnowflakeDTO) []string {
+	currentNames := make(map[string]string, len(current))
+	for _, name := range current {
+		currentNames[sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name.(string)).FullyQualifiedName()] = name.(string)
+	}
+	names := make([]string, len(networkRules))
+	for i, networkRule := range networkRules {
+		fullyQualifiedName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(networkRule.FullyQualifiedRuleName).FullyQualifiedName()
+		if name, ok := currentNames[fullyQualifiedName]; ok {
+			names[i] = name
+		} else {
+			names[i] = fullyQualifiedName
+		}
+	}
+	return names
+}
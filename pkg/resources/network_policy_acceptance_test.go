@@ -8,6 +8,8 @@ import (
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
@@ -77,3 +79,68 @@ resource "snowflake_network_policy" "test" {
 }
 `, name, networkPolicyComment)
 }
+
+func TestAcc_NetworkPolicy_NetworkRules(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_network_policy.test"
+	m := func(allowedRules []string, blockedRules []string) map[string]config.Variable {
+		allowed := make([]config.Variable, len(allowedRules))
+		for i, rule := range allowedRules {
+			allowed[i] = config.StringVariable(rule)
+		}
+		blocked := make([]config.Variable, len(blockedRules))
+		for i, rule := range blockedRules {
+			blocked[i] = config.StringVariable(rule)
+		}
+		return map[string]config.Variable{
+			"name":          config.StringVariable(name),
+			"database":      config.StringVariable(acc.TestDatabaseName),
+			"schema":        config.StringVariable(acc.TestSchemaName),
+			"allowed_rules": config.SetVariable(allowed...),
+			"blocked_rules": config.SetVariable(blocked...),
+		}
+	}
+	ruleName := func(suffix string) string {
+		return sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name+suffix).FullyQualifiedName()
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NetworkPolicy),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NetworkPolicy_NetworkRules/basic"),
+				ConfigVariables: m([]string{"allowed_1"}, []string{"blocked"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_network_rule_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_network_rule_list.*", ruleName("_ALLOWED_1")),
+					resource.TestCheckResourceAttr(resourceName, "blocked_network_rule_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "blocked_network_rule_list.*", ruleName("_BLOCKED")),
+				),
+			},
+			// add and remove network rules
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NetworkPolicy_NetworkRules/basic"),
+				ConfigVariables: m([]string{"allowed_2", "allowed_1"}, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_network_rule_list.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_network_rule_list.*", ruleName("_ALLOWED_1")),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_network_rule_list.*", ruleName("_ALLOWED_2")),
+					resource.TestCheckResourceAttr(resourceName, "blocked_network_rule_list.#", "0"),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_NetworkPolicy_NetworkRules/basic"),
+				ConfigVariables:   m([]string{"allowed_2", "allowed_1"}, []string{}),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_networkRuleIdentifiersDifference(t *testing.T) {
+	difference := networkRuleIdentifiersDifference(
+		[]any{`"DB"."SCHEMA"."RULE_1"`, "DB.SCHEMA.RULE_2", "DB.SCHEMA.RULE_3"},
+		[]any{"DB.SCHEMA.RULE_1", `"DB"."SCHEMA"."RULE_3"`},
+	)
+
+	assert.Equal(t, []sdk.SchemaObjectIdentifier{sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "RULE_2")}, difference)
+}
+
+func Test_networkRuleNames(t *testing.T) {
+	names := networkRuleNames(
+		[]any{"DB.SCHEMA.RULE_1"},
+		[]sdk.NetworkRulesSnowflakeDTO{
+			{FullyQualifiedRuleName: `"DB"."SCHEMA"."RULE_1"`},
+			{FullyQualifiedRuleName: "DB.SCHEMA.RULE_2"},
+		},
+	)
+
+	assert.Equal(t, []string{"DB.SCHEMA.RULE_1", `"DB"."SCHEMA"."RULE_2"`}, names)
+}
@@ -0,0 +1,204 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var networkRuleSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the network rule; must be unique for the schema in which the network rule is created.",
+		ForceNew:    true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the network rule.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the network rule.",
+		ForceNew:    true,
+	},
+	"type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  fmt.Sprintf("Specifies the type of the network identifiers being allowed or blocked. One of: %v.", sdk.AllNetworkRuleTypes),
+		ValidateFunc: validation.StringInSlice(networkRuleTypeValues(), false),
+		ForceNew:     true,
+	},
+	"value_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		MinItems:    1,
+		Description: "Specifies the network identifiers that will be allowed or blocked: IPv4 addresses or ranges (`IPV4`), AWS VPC endpoint IDs (`AWSVPCEID`), Azure private endpoint link IDs (`AZURELINKID`) or domains with optional ports (`HOST_PORT`).",
+	},
+	"mode": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  fmt.Sprintf("Specifies what is restricted by the network rule. One of: %v.", sdk.AllNetworkRuleModes),
+		ValidateFunc: validation.StringInSlice(networkRuleModeValues(), false),
+		ForceNew:     true,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the network rule.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The fully qualified name of the network rule, to be used in the network rule lists of `snowflake_network_policy`.",
+	},
+}
+
+// NetworkRule returns a pointer to the resource representing a network rule.
+func NetworkRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateContextNetworkRule,
+		ReadContext:   ReadContextNetworkRule,
+		UpdateContext: UpdateContextNetworkRule,
+		DeleteContext: DeleteContextNetworkRule,
+
+		Schema: networkRuleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func networkRuleTypeValues() []string {
+	values := make([]string, len(sdk.AllNetworkRuleTypes))
+	for i, v := range sdk.AllNetworkRuleTypes {
+		values[i] = string(v)
+	}
+	return values
+}
+
+func networkRuleModeValues() []string {
+	values := make([]string, len(sdk.AllNetworkRuleModes))
+	for i, v := range sdk.AllNetworkRuleModes {
+		values[i] = string(v)
+	}
+	return values
+}
+
+func expandNetworkRuleValues(values []any) []sdk.NetworkRuleValue {
+	networkRuleValues := make([]sdk.NetworkRuleValue, len(values))
+	for i, v := range expandStringList(values) {
+		networkRuleValues[i] = sdk.NetworkRuleValue{Value: v}
+	}
+	return networkRuleValues
+}
+
+func CreateContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateNetworkRuleRequest(
+		id,
+		sdk.NetworkRuleType(d.Get("type").(string)),
+		expandNetworkRuleValues(d.Get("value_list").(*schema.Set).List()),
+		sdk.NetworkRuleMode(d.Get("mode").(string)),
+	)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if err := client.NetworkRules.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating network rule %v err = %w", id.FullyQualifiedName(), err))
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	return ReadContextNetworkRule(ctx, d, meta)
+}
+
+func ReadContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if _, err := client.NetworkRules.ShowByID(ctx, id); err != nil {
+		log.Printf("[DEBUG] network rule (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	networkRule, err := client.NetworkRules.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", networkRule.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("database", networkRule.DatabaseName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schema", networkRule.SchemaName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", string(networkRule.Type)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("value_list", networkRule.ValueList); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mode", string(networkRule.Mode)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("comment", networkRule.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func UpdateContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("value_list") {
+		set := sdk.NewNetworkRuleSetRequest(expandNetworkRuleValues(d.Get("value_list").(*schema.Set).List()))
+		if err := client.NetworkRules.Alter(ctx, sdk.NewAlterNetworkRuleRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating VALUE_LIST for network rule %v err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			set := sdk.NewNetworkRuleSetRequest(expandNetworkRuleValues(d.Get("value_list").(*schema.Set).List())).WithComment(sdk.String(comment))
+			if err := client.NetworkRules.Alter(ctx, sdk.NewAlterNetworkRuleRequest(id).WithSet(set)); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating comment for network rule %v err = %w", id.FullyQualifiedName(), err))
+			}
+		} else {
+			unset := sdk.NewNetworkRuleUnsetRequest().WithComment(sdk.Bool(true))
+			if err := client.NetworkRules.Alter(ctx, sdk.NewAlterNetworkRuleRequest(id).WithUnset(unset)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting comment for network rule %v err = %w", id.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	return ReadContextNetworkRule(ctx, d, meta)
+}
+
+func DeleteContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(id)); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting network rule %v err = %w", id.FullyQualifiedName(), err))
+	}
+	d.SetId("")
+	return nil
+}
@@ -14,9 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// TODO [SNOW-1021713]: split this resource into smaller ones as part of SNOW-867235
 // TODO [SNOW-1021713]: remove SQS entirely
-// TODO [SNOW-1021713]: support Azure push notifications (AZURE_EVENT_GRID)
 var notificationIntegrationSchema = map[string]*schema.Schema{
 	// The first part of the schema is shared between all integration vendors
 	"name": {
@@ -155,6 +153,8 @@ func NotificationIntegration() *schema.Resource {
 		Update: UpdateNotificationIntegration,
 		Delete: DeleteNotificationIntegration,
 
+		DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use one of the snowflake_notification_integration_inbound_* or snowflake_notification_integration_outbound_* resources instead.",
+
 		Schema: notificationIntegrationSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
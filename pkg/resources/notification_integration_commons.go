@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// notificationIntegrationCommonSchema returns attributes shared by all the per-provider notification integration resources.
+func notificationIntegrationCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Specifies the identifier (i.e. name) for the notification integration. This value must be unique in your account.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Specifies whether the notification integration is enabled.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies a comment for the notification integration.",
+		},
+		"created_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time when the notification integration was created.",
+		},
+	}
+}
+
+func notificationIntegrationAzureConsentSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["azure_consent_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the Microsoft permissions request page, to be visited to grant Snowflake access to the Azure resources.",
+	}
+	s["azure_multi_tenant_app_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the Snowflake client application created for your account.",
+	}
+	return s
+}
+
+func notificationIntegrationGcpServiceAccountSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["gcp_pubsub_service_account"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The Snowflake service account to be granted access to the Pub/Sub subscription or topic.",
+	}
+	return s
+}
+
+func createNotificationIntegrationRequest(d *schema.ResourceData) (sdk.AccountObjectIdentifier, *sdk.CreateNotificationIntegrationRequest) {
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+	request := sdk.NewCreateNotificationIntegrationRequest(id, d.Get("enabled").(bool))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	return id, request
+}
+
+func createNotificationIntegrationForProvider(read schema.ReadContextFunc, withProviderParams func(d *schema.ResourceData, request *sdk.CreateNotificationIntegrationRequest)) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, request := createNotificationIntegrationRequest(d)
+		withProviderParams(d, request)
+
+		if err := client.NotificationIntegrations.Create(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating notification integration %v err = %w", id.Name(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(id))
+
+		return read(ctx, d, meta)
+	}
+}
+
+// readNotificationIntegrationForProvider returns a read function setting the common attributes and the provider specific
+// attributes from DESCRIBE INTEGRATION; properties maps the property names to the attribute names.
+func readNotificationIntegrationForProvider(properties map[string]string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+		integration, err := client.NotificationIntegrations.ShowByID(ctx, id)
+		if err != nil {
+			log.Printf("[DEBUG] notification integration (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		if c := integration.Category; c != "NOTIFICATION" {
+			return diag.FromErr(fmt.Errorf("expected %v to be a NOTIFICATION integration, got %v", id, c))
+		}
+		if err := d.Set("name", integration.Name); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("enabled", integration.Enabled); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("comment", integration.Comment); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		integrationProperties, err := client.NotificationIntegrations.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("could not describe notification integration: %w", err))
+		}
+		for _, property := range integrationProperties {
+			if attribute, ok := properties[property.Name]; ok {
+				if err := d.Set(attribute, property.Value); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// updateNotificationIntegrationForProvider returns an update function altering the common attributes and the provider
+// specific ones returned by pushParams (nil when nothing changed or the provider parameters cannot be altered).
+func updateNotificationIntegrationForProvider(read schema.ReadContextFunc, pushParams func(d *schema.ResourceData) *sdk.SetPushParamsRequest) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+		var runSetStatement bool
+		setRequest := sdk.NewNotificationIntegrationSetRequest()
+		if d.HasChange("enabled") {
+			runSetStatement = true
+			setRequest.WithEnabled(sdk.Bool(d.Get("enabled").(bool)))
+		}
+		if d.HasChange("comment") {
+			runSetStatement = true
+			setRequest.WithComment(sdk.String(d.Get("comment").(string)))
+		}
+		if pushParams != nil {
+			if params := pushParams(d); params != nil {
+				runSetStatement = true
+				setRequest.WithSetPushParams(params)
+			}
+		}
+
+		if runSetStatement {
+			if err := client.NotificationIntegrations.Alter(ctx, sdk.NewAlterNotificationIntegrationRequest(id).WithSet(setRequest)); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating notification integration %v err = %w", id.Name(), err))
+			}
+		}
+
+		return read(ctx, d, meta)
+	}
+}
+
+func deleteNotificationIntegrationForProvider(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.NotificationIntegrations.Drop(ctx, sdk.NewDropNotificationIntegrationRequest(id)); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting notification integration %v err = %w", id.Name(), err))
+	}
+	d.SetId("")
+	return nil
+}
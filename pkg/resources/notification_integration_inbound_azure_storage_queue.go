@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationInboundAzureStorageQueueSchema = func() map[string]*schema.Schema {
+	s := notificationIntegrationCommonSchema()
+	// There is no ALTER for the automated data load parameters, so they recreate the integration.
+	s["azure_storage_queue_primary_uri"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the queue ID for the Azure Queue Storage queue created for the Event Grid notifications.",
+	}
+	s["azure_tenant_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the ID of the Azure Active Directory tenant used for identity management.",
+	}
+	return notificationIntegrationAzureConsentSchema(s)
+}()
+
+var notificationIntegrationInboundAzureStorageQueueProperties = map[string]string{
+	"AZURE_STORAGE_QUEUE_PRIMARY_URI": "azure_storage_queue_primary_uri",
+	"AZURE_TENANT_ID":                 "azure_tenant_id",
+	"AZURE_CONSENT_URL":               "azure_consent_url",
+	"AZURE_MULTI_TENANT_APP_NAME":     "azure_multi_tenant_app_name",
+}
+
+// NotificationIntegrationInboundAzureStorageQueue returns a pointer to the resource representing a notification integration
+// for automated data loads and refreshes from Microsoft Azure (Azure Queue Storage).
+func NotificationIntegrationInboundAzureStorageQueue() *schema.Resource {
+	read := readNotificationIntegrationForProvider(notificationIntegrationInboundAzureStorageQueueProperties)
+	return &schema.Resource{
+		CreateContext: createNotificationIntegrationForProvider(read, func(d *schema.ResourceData, request *sdk.CreateNotificationIntegrationRequest) {
+			request.WithAutomatedDataLoadsParams(
+				sdk.NewAutomatedDataLoadsParamsRequest().WithAzureAutoParams(sdk.NewAzureAutoParamsRequest(d.Get("azure_storage_queue_primary_uri").(string), d.Get("azure_tenant_id").(string))),
+			)
+		}),
+		ReadContext:   read,
+		UpdateContext: updateNotificationIntegrationForProvider(read, nil),
+		DeleteContext: deleteNotificationIntegrationForProvider,
+
+		Description: "Resource used to manage notification integrations for automated data loads and external table refreshes from Microsoft Azure, using an Azure Queue Storage queue. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration-queue-inbound-azure).",
+
+		Schema: notificationIntegrationInboundAzureStorageQueueSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
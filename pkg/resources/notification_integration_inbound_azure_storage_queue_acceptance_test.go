@@ -0,0 +1,73 @@
+package resources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NotificationIntegrationInboundAzureStorageQueue(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_notification_integration_inbound_azure_storage_queue.test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":                            config.StringVariable(name),
+			"enabled":                         config.BoolVariable(true),
+			"comment":                         config.StringVariable("Terraform acceptance test"),
+			"azure_storage_queue_primary_uri": config.StringVariable("azure://great-bucket/great-path/"),
+			"azure_tenant_id":                 config.StringVariable("00000000-0000-0000-0000-000000000000"),
+		}
+	}
+	variableSet2 := m()
+	variableSet2["comment"] = config.StringVariable("different comment")
+	variableSet2["azure_storage_queue_primary_uri"] = config.StringVariable("azure://great-bucket/other-great-path/")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NotificationIntegrationInboundAzureStorageQueue),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NotificationIntegrationInboundAzureStorageQueue/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "azure_storage_queue_primary_uri", "azure://great-bucket/great-path/"),
+					resource.TestCheckResourceAttr(resourceName, "azure_tenant_id", "00000000-0000-0000-0000-000000000000"),
+					resource.TestCheckResourceAttrSet(resourceName, "azure_consent_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "azure_multi_tenant_app_name"),
+				),
+			},
+			// change the queue (recreates the integration) and comment
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NotificationIntegrationInboundAzureStorageQueue/basic"),
+				ConfigVariables: variableSet2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "different comment"),
+					resource.TestCheckResourceAttr(resourceName, "azure_storage_queue_primary_uri", "azure://great-bucket/other-great-path/"),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_NotificationIntegrationInboundAzureStorageQueue/basic"),
+				ConfigVariables:   variableSet2,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// it is not returned in DESCRIBE for azure automated data load
+				ImportStateVerifyIgnore: []string{"azure_tenant_id"},
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationInboundGcpPubsubSchema = func() map[string]*schema.Schema {
+	s := notificationIntegrationCommonSchema()
+	// There is no ALTER for the automated data load parameters, so they recreate the integration.
+	s["gcp_pubsub_subscription_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the Pub/Sub subscription ID that Snowflake listens to for the event notifications.",
+	}
+	return notificationIntegrationGcpServiceAccountSchema(s)
+}()
+
+var notificationIntegrationInboundGcpPubsubProperties = map[string]string{
+	"GCP_PUBSUB_SUBSCRIPTION_NAME": "gcp_pubsub_subscription_name",
+	"GCP_PUBSUB_SERVICE_ACCOUNT":   "gcp_pubsub_service_account",
+}
+
+// NotificationIntegrationInboundGcpPubsub returns a pointer to the resource representing a notification integration
+// for automated data loads and refreshes from Google Cloud Storage (GCP Pub/Sub subscription).
+func NotificationIntegrationInboundGcpPubsub() *schema.Resource {
+	read := readNotificationIntegrationForProvider(notificationIntegrationInboundGcpPubsubProperties)
+	return &schema.Resource{
+		CreateContext: createNotificationIntegrationForProvider(read, func(d *schema.ResourceData, request *sdk.CreateNotificationIntegrationRequest) {
+			request.WithAutomatedDataLoadsParams(
+				sdk.NewAutomatedDataLoadsParamsRequest().WithGoogleAutoParams(sdk.NewGoogleAutoParamsRequest(d.Get("gcp_pubsub_subscription_name").(string))),
+			)
+		}),
+		ReadContext:   read,
+		UpdateContext: updateNotificationIntegrationForProvider(read, nil),
+		DeleteContext: deleteNotificationIntegrationForProvider,
+
+		Description: "Resource used to manage notification integrations for automated data loads and external table refreshes from Google Cloud Storage, using a GCP Pub/Sub subscription. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration-queue-inbound-gcp).",
+
+		Schema: notificationIntegrationInboundGcpPubsubSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
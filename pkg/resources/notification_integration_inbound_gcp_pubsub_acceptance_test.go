@@ -0,0 +1,71 @@
+package resources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NotificationIntegrationInboundGcpPubsub(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_notification_integration_inbound_gcp_pubsub.test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":                         config.StringVariable(name),
+			"enabled":                      config.BoolVariable(true),
+			"comment":                      config.StringVariable("Terraform acceptance test"),
+			"gcp_pubsub_subscription_name": config.StringVariable("projects/project-1234/subscriptions/sub2"),
+		}
+	}
+	variableSet2 := m()
+	variableSet2["enabled"] = config.BoolVariable(false)
+	variableSet2["comment"] = config.StringVariable("")
+	variableSet2["gcp_pubsub_subscription_name"] = config.StringVariable("projects/project-1234/subscriptions/other")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NotificationIntegrationInboundGcpPubsub),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NotificationIntegrationInboundGcpPubsub/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "gcp_pubsub_subscription_name", "projects/project-1234/subscriptions/sub2"),
+					resource.TestCheckResourceAttrSet(resourceName, "gcp_pubsub_service_account"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_on"),
+				),
+			},
+			// change the subscription (recreates the integration), disable and unset comment
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NotificationIntegrationInboundGcpPubsub/basic"),
+				ConfigVariables: variableSet2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "gcp_pubsub_subscription_name", "projects/project-1234/subscriptions/other"),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_NotificationIntegrationInboundGcpPubsub/basic"),
+				ConfigVariables:   variableSet2,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
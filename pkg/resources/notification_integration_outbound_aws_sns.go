@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationOutboundAwsSnsSchema = func() map[string]*schema.Schema {
+	s := notificationIntegrationCommonSchema()
+	s["aws_sns_topic_arn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the Amazon Resource Name (ARN) of the Amazon SNS topic to which the notifications are pushed.",
+	}
+	s["aws_sns_role_arn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the ARN of the IAM role that has permissions to publish messages to the Amazon SNS topic.",
+	}
+	s["aws_sns_iam_user_arn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ARN of the Snowflake IAM user that assumes the IAM role, to be added to the trust policy of the role.",
+	}
+	s["aws_sns_external_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The external ID that Snowflake uses when assuming the IAM role, to be added to the trust policy of the role.",
+	}
+	return s
+}()
+
+var notificationIntegrationOutboundAwsSnsProperties = map[string]string{
+	"AWS_SNS_TOPIC_ARN":   "aws_sns_topic_arn",
+	"AWS_SNS_ROLE_ARN":    "aws_sns_role_arn",
+	"SF_AWS_IAM_USER_ARN": "aws_sns_iam_user_arn",
+	"SF_AWS_EXTERNAL_ID":  "aws_sns_external_id",
+}
+
+// NotificationIntegrationOutboundAwsSns returns a pointer to the resource representing a notification integration
+// pushing notifications to Amazon SNS.
+func NotificationIntegrationOutboundAwsSns() *schema.Resource {
+	read := readNotificationIntegrationForProvider(notificationIntegrationOutboundAwsSnsProperties)
+	return &schema.Resource{
+		CreateContext: createNotificationIntegrationForProvider(read, func(d *schema.ResourceData, request *sdk.CreateNotificationIntegrationRequest) {
+			request.WithPushNotificationParams(
+				sdk.NewPushNotificationParamsRequest().WithAmazonPushParams(sdk.NewAmazonPushParamsRequest(d.Get("aws_sns_topic_arn").(string), d.Get("aws_sns_role_arn").(string))),
+			)
+		}),
+		ReadContext: read,
+		UpdateContext: updateNotificationIntegrationForProvider(read, func(d *schema.ResourceData) *sdk.SetPushParamsRequest {
+			if !d.HasChanges("aws_sns_topic_arn", "aws_sns_role_arn") {
+				return nil
+			}
+			return sdk.NewSetPushParamsRequest().WithSetAmazonPush(sdk.NewSetAmazonPushRequest(d.Get("aws_sns_topic_arn").(string), d.Get("aws_sns_role_arn").(string)))
+		}),
+		DeleteContext: deleteNotificationIntegrationForProvider,
+
+		Description: "Resource used to manage notification integrations pushing error notifications and alerts to an Amazon SNS topic. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration-queue-outbound-aws).",
+
+		Schema: notificationIntegrationOutboundAwsSnsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
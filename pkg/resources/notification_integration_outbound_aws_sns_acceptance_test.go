@@ -0,0 +1,77 @@
+package resources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NotificationIntegrationOutboundAwsSns(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_notification_integration_outbound_aws_sns.test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":              config.StringVariable(name),
+			"enabled":           config.BoolVariable(true),
+			"comment":           config.StringVariable("Terraform acceptance test"),
+			"aws_sns_topic_arn": config.StringVariable("arn:aws:sns:us-east-2:123456789012:MyTopic"),
+			"aws_sns_role_arn":  config.StringVariable("arn:aws:iam::000000000001:/role/test"),
+		}
+	}
+	variableSet2 := m()
+	variableSet2["aws_sns_topic_arn"] = config.StringVariable("arn:aws:sns:us-east-2:123456789012:OtherTopic")
+	variableSet2["aws_sns_role_arn"] = config.StringVariable("arn:aws:iam::000000000001:/role/other")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NotificationIntegrationOutboundAwsSns),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NotificationIntegrationOutboundAwsSns/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "aws_sns_topic_arn", "arn:aws:sns:us-east-2:123456789012:MyTopic"),
+					resource.TestCheckResourceAttr(resourceName, "aws_sns_role_arn", "arn:aws:iam::000000000001:/role/test"),
+					resource.TestCheckResourceAttrSet(resourceName, "aws_sns_iam_user_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "aws_sns_external_id"),
+				),
+			},
+			// change the topic and role in place
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NotificationIntegrationOutboundAwsSns/basic"),
+				ConfigVariables: variableSet2,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "aws_sns_topic_arn", "arn:aws:sns:us-east-2:123456789012:OtherTopic"),
+					resource.TestCheckResourceAttr(resourceName, "aws_sns_role_arn", "arn:aws:iam::000000000001:/role/other"),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_NotificationIntegrationOutboundAwsSns/basic"),
+				ConfigVariables:   variableSet2,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationOutboundAzureEventGridSchema = func() map[string]*schema.Schema {
+	s := notificationIntegrationCommonSchema()
+	// The Event Grid parameters cannot be altered, so they recreate the integration.
+	s["azure_event_grid_topic_endpoint"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the Event Grid topic endpoint to which the notifications are pushed.",
+	}
+	s["azure_tenant_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the ID of the Azure Active Directory tenant used for identity management.",
+	}
+	return notificationIntegrationAzureConsentSchema(s)
+}()
+
+var notificationIntegrationOutboundAzureEventGridProperties = map[string]string{
+	"AZURE_EVENT_GRID_TOPIC_ENDPOINT": "azure_event_grid_topic_endpoint",
+	"AZURE_TENANT_ID":                 "azure_tenant_id",
+	"AZURE_CONSENT_URL":               "azure_consent_url",
+	"AZURE_MULTI_TENANT_APP_NAME":     "azure_multi_tenant_app_name",
+}
+
+// NotificationIntegrationOutboundAzureEventGrid returns a pointer to the resource representing a notification integration
+// pushing notifications to an Azure Event Grid topic.
+func NotificationIntegrationOutboundAzureEventGrid() *schema.Resource {
+	read := readNotificationIntegrationForProvider(notificationIntegrationOutboundAzureEventGridProperties)
+	return &schema.Resource{
+		CreateContext: createNotificationIntegrationForProvider(read, func(d *schema.ResourceData, request *sdk.CreateNotificationIntegrationRequest) {
+			request.WithPushNotificationParams(
+				sdk.NewPushNotificationParamsRequest().WithAzurePushParams(sdk.NewAzurePushParamsRequest(d.Get("azure_event_grid_topic_endpoint").(string), d.Get("azure_tenant_id").(string))),
+			)
+		}),
+		ReadContext:   read,
+		UpdateContext: updateNotificationIntegrationForProvider(read, nil),
+		DeleteContext: deleteNotificationIntegrationForProvider,
+
+		Description: "Resource used to manage notification integrations pushing error notifications to an Azure Event Grid topic. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration-queue-outbound-azure).",
+
+		Schema: notificationIntegrationOutboundAzureEventGridSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
@@ -0,0 +1,10 @@
+package resources_test
+
+import (
+	"testing"
+)
+
+// TODO [SNOW-1017802]: handle after "create and describe notification integration - push azure" test passes
+func TestAcc_NotificationIntegrationOutboundAzureEventGrid(t *testing.T) {
+	t.Skip("Skipping because can't be currently created. Check 'create and describe notification integration - push azure' test in the SDK.")
+}
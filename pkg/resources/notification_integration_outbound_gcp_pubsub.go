@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationOutboundGcpPubsubSchema = func() map[string]*schema.Schema {
+	s := notificationIntegrationCommonSchema()
+	// The topic cannot be altered (ALTER sets GCP_PUBSUB_SUBSCRIPTION_NAME only), so it recreates the integration.
+	s["gcp_pubsub_topic_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the Pub/Sub topic ID to which the notifications are pushed.",
+	}
+	return notificationIntegrationGcpServiceAccountSchema(s)
+}()
+
+var notificationIntegrationOutboundGcpPubsubProperties = map[string]string{
+	"GCP_PUBSUB_TOPIC_NAME":      "gcp_pubsub_topic_name",
+	"GCP_PUBSUB_SERVICE_ACCOUNT": "gcp_pubsub_service_account",
+}
+
+// NotificationIntegrationOutboundGcpPubsub returns a pointer to the resource representing a notification integration
+// pushing notifications to a GCP Pub/Sub topic.
+func NotificationIntegrationOutboundGcpPubsub() *schema.Resource {
+	read := readNotificationIntegrationForProvider(notificationIntegrationOutboundGcpPubsubProperties)
+	return &schema.Resource{
+		CreateContext: createNotificationIntegrationForProvider(read, func(d *schema.ResourceData, request *sdk.CreateNotificationIntegrationRequest) {
+			request.WithPushNotificationParams(
+				sdk.NewPushNotificationParamsRequest().WithGooglePushParams(sdk.NewGooglePushParamsRequest(d.Get("gcp_pubsub_topic_name").(string))),
+			)
+		}),
+		ReadContext:   read,
+		UpdateContext: updateNotificationIntegrationForProvider(read, nil),
+		DeleteContext: deleteNotificationIntegrationForProvider,
+
+		Description: "Resource used to manage notification integrations pushing error notifications to a GCP Pub/Sub topic. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration-queue-outbound-gcp).",
+
+		Schema: notificationIntegrationOutboundGcpPubsubSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
@@ -0,0 +1,552 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// objectGrantsAccountObjectTypes are the account level objects supported by GrantOnAccountObject.
+var objectGrantsAccountObjectTypes = []sdk.ObjectType{
+	sdk.ObjectTypeComputePool,
+	sdk.ObjectTypeDatabase,
+	sdk.ObjectTypeExternalVolume,
+	sdk.ObjectTypeFailoverGroup,
+	sdk.ObjectTypeIntegration,
+	sdk.ObjectTypeReplicationGroup,
+	sdk.ObjectTypeResourceMonitor,
+	sdk.ObjectTypeUser,
+	sdk.ObjectTypeWarehouse,
+}
+
+// systemAccountRoles are the system-defined roles, see https://docs.snowflake.com/en/user-guide/security-access-control-overview#system-defined-roles.
+var systemAccountRoles = []string{"ACCOUNTADMIN", "ORGADMIN", "PUBLIC", "SECURITYADMIN", "SYSADMIN", "USERADMIN"}
+
+func validObjectGrantsObjectTypes() []string {
+	objectTypes := make([]string, 0)
+	for _, objectType := range objectGrantsAccountObjectTypes {
+		objectTypes = append(objectTypes, objectType.String())
+	}
+	objectTypes = append(objectTypes, sdk.ObjectTypeSchema.String())
+	return append(objectTypes, sdk.ValidGrantToObjectTypesString...)
+}
+
+var objectGrantsSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      fmt.Sprintf("The object type of the object on which the privileges are managed. Valid values are: %s", strings.Join(validObjectGrantsObjectTypes(), " | ")),
+		ValidateDiagFunc: StringInSlice(validObjectGrantsObjectTypes(), true),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the object on which the privileges are managed.",
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"grant": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The complete set of privileges granted on the object. Privileges granted on the object which are not listed here are revoked (except OWNERSHIP and the grants to the ignored roles).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"privilege": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The privilege to grant on the object.",
+					ValidateDiagFunc: isNotOwnershipGrant(),
+				},
+				"account_role_name": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The fully qualified name of the account role to which the privilege is granted. Exactly one of `account_role_name` and `database_role_name` has to be set.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+				},
+				"database_role_name": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The fully qualified name of the database role to which the privilege is granted. Exactly one of `account_role_name` and `database_role_name` has to be set.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+				},
+				"with_grant_option": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "If specified, allows the recipient role to grant the privilege to other roles.",
+				},
+			},
+		},
+	},
+	"ignore_system_roles": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: fmt.Sprintf("If true, the grants to the system-defined roles (%s) which are not listed in `grant` are neither read nor revoked.", strings.Join(systemAccountRoles, ", ")),
+	},
+	"ignored_account_roles": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Names of the account roles whose grants on the object are neither read nor revoked unless they are listed in `grant`.",
+	},
+}
+
+// ObjectGrants manages all privileges granted on a single object authoritatively.
+func ObjectGrants() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateObjectGrants,
+		ReadContext:   ReadObjectGrants,
+		UpdateContext: UpdateObjectGrants,
+		DeleteContext: DeleteObjectGrants,
+
+		Schema: objectGrantsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportObjectGrants,
+		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			grants, err := expandObjectGrants(d.Get("grant").(*schema.Set).List())
+			if err != nil || !d.NewValueKnown("grant") {
+				return err
+			}
+			privileges := make([]string, 0, len(grants))
+			for _, grant := range grants {
+				if grant.Privilege != "" && !slices.Contains(privileges, grant.Privilege) {
+					privileges = append(privileges, grant.Privilege)
+				}
+			}
+			return sdk.ValidatePrivilegesForObjectType(sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string))), privileges)
+		},
+	}
+}
+
+// objectGrant is a single privilege granted on the object to an account or database role.
+type objectGrant struct {
+	Privilege       string
+	GranteeType     sdk.ObjectType
+	GranteeName     string
+	WithGrantOption bool
+}
+
+// key identifies the grant regardless of the identifier quoting. IMPORTED PRIVILEGES are returned as USAGE by SHOW GRANTS.
+func (g objectGrant) key() string {
+	privilege := strings.ToUpper(g.Privilege)
+	if privilege == sdk.AccountObjectPrivilegeImportedPrivileges.String() {
+		privilege = sdk.AccountObjectPrivilegeUsage.String()
+	}
+	return strings.Join([]string{privilege, g.GranteeType.String(), g.granteeFullyQualifiedName(), fmt.Sprintf("%t", g.WithGrantOption)}, helpers.IDDelimiter)
+}
+
+func (g objectGrant) granteeFullyQualifiedName() string {
+	if g.GranteeType == sdk.ObjectTypeDatabaseRole {
+		return sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(g.GranteeName).FullyQualifiedName()
+	}
+	return sdk.NewAccountObjectIdentifierFromFullyQualifiedName(g.GranteeName).FullyQualifiedName()
+}
+
+func (g objectGrant) toMap() map[string]any {
+	m := map[string]any{
+		"privilege":         g.Privilege,
+		"with_grant_option": g.WithGrantOption,
+	}
+	if g.GranteeType == sdk.ObjectTypeDatabaseRole {
+		m["database_role_name"] = g.GranteeName
+	} else {
+		m["account_role_name"] = g.GranteeName
+	}
+	return m
+}
+
+func expandObjectGrants(grants []any) ([]objectGrant, error) {
+	result := make([]objectGrant, 0, len(grants))
+	for _, raw := range grants {
+		grant := raw.(map[string]any)
+		accountRoleName, databaseRoleName := grant["account_role_name"].(string), grant["database_role_name"].(string)
+		g := objectGrant{
+			Privilege:       grant["privilege"].(string),
+			WithGrantOption: grant["with_grant_option"].(bool),
+		}
+		switch {
+		case accountRoleName != "" && databaseRoleName == "":
+			g.GranteeType, g.GranteeName = sdk.ObjectTypeRole, accountRoleName
+		case databaseRoleName != "" && accountRoleName == "":
+			g.GranteeType, g.GranteeName = sdk.ObjectTypeDatabaseRole, databaseRoleName
+		default:
+			return nil, fmt.Errorf("exactly one of account_role_name and database_role_name has to be set for privilege %s", g.Privilege)
+		}
+		result = append(result, g)
+	}
+	return result, nil
+}
+
+func objectGrantsIdentifier(objectType sdk.ObjectType, objectName string) (sdk.ObjectIdentifier, error) {
+	switch {
+	case slices.Contains(objectGrantsAccountObjectTypes, objectType):
+		return sdk.NewAccountObjectIdentifierFromFullyQualifiedName(objectName), nil
+	case objectType == sdk.ObjectTypeSchema:
+		return sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(objectName), nil
+	case slices.Contains(sdk.ValidGrantToObjectTypesString, objectType.String()):
+		return sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(objectName), nil
+	}
+	return nil, fmt.Errorf("unsupported object type %s", objectType)
+}
+
+// objectGrantsId returns the object type and the identifier of the object stored in the resource id (<object_type>|<object_name>).
+func objectGrantsId(id string) (sdk.ObjectType, sdk.ObjectIdentifier, error) {
+	parts := strings.SplitN(id, helpers.IDDelimiter, 2)
+	if len(parts) != 2 {
+		return "", nil, fmt.Errorf("invalid ID specified: %v, expected <object_type>|<object_name>", id)
+	}
+	objectType := sdk.ObjectType(strings.ToUpper(parts[0]))
+	objectId, err := objectGrantsIdentifier(objectType, parts[1])
+	if err != nil {
+		return "", nil, err
+	}
+	return objectType, objectId, nil
+}
+
+func isIgnoredObjectGrant(d *schema.ResourceData, grant objectGrant) bool {
+	if grant.GranteeType != sdk.ObjectTypeRole {
+		return false
+	}
+	name := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(grant.GranteeName).Name()
+	if d.Get("ignore_system_roles").(bool) && slices.Contains(systemAccountRoles, name) {
+		return true
+	}
+	return slices.ContainsFunc(expandStringList(d.Get("ignored_account_roles").(*schema.Set).List()), func(role string) bool {
+		return sdk.NewAccountObjectIdentifierFromFullyQualifiedName(role).Name() == name
+	})
+}
+
+func showObjectGrants(ctx context.Context, client *sdk.Client, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier) ([]objectGrant, error) {
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		On: &sdk.ShowGrantsOn{
+			Object: &sdk.Object{ObjectType: objectType, Name: objectId},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]objectGrant, 0, len(grants))
+	for _, grant := range grants {
+		if grant.Privilege == sdk.SchemaObjectOwnership.String() {
+			continue
+		}
+		switch grant.GrantedTo {
+		case sdk.ObjectTypeRole:
+			result = append(result, objectGrant{
+				Privilege:       grant.Privilege,
+				GranteeType:     sdk.ObjectTypeRole,
+				GranteeName:     sdk.NewAccountObjectIdentifier(grant.GranteeName.Name()).FullyQualifiedName(),
+				WithGrantOption: grant.GrantOption,
+			})
+		case sdk.ObjectTypeDatabaseRole:
+			result = append(result, objectGrant{
+				Privilege:       grant.Privilege,
+				GranteeType:     sdk.ObjectTypeDatabaseRole,
+				GranteeName:     sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(grant.GranteeName.Name()).FullyQualifiedName(),
+				WithGrantOption: grant.GrantOption,
+			})
+		}
+	}
+	return result, nil
+}
+
+// diffObjectGrants returns the grants which have to be revoked and granted to get from the actual to the expected grants.
+// Unexpected grants to the ignored roles are not revoked.
+func diffObjectGrants(actual []objectGrant, expected []objectGrant, ignored func(objectGrant) bool) (toRevoke []objectGrant, toGrant []objectGrant) {
+	actualKeys := make(map[string]bool)
+	for _, grant := range actual {
+		actualKeys[grant.key()] = true
+	}
+	expectedKeys := make(map[string]bool)
+	for _, grant := range expected {
+		expectedKeys[grant.key()] = true
+	}
+	for _, grant := range actual {
+		if !expectedKeys[grant.key()] && !ignored(grant) {
+			toRevoke = append(toRevoke, grant)
+		}
+	}
+	for _, grant := range expected {
+		if !actualKeys[grant.key()] {
+			toGrant = append(toGrant, grant)
+		}
+	}
+	return toRevoke, toGrant
+}
+
+type objectGrantsGroup struct {
+	granteeType     sdk.ObjectType
+	granteeName     string
+	withGrantOption bool
+	privileges      []string
+}
+
+// groupObjectGrants groups the privileges by grantee (and grant option), so that they are granted or revoked with a single statement.
+func groupObjectGrants(grants []objectGrant, byGrantOption bool) []objectGrantsGroup {
+	groups := make(map[string]*objectGrantsGroup)
+	var keys []string
+	for _, grant := range grants {
+		key := grant.GranteeType.String() + helpers.IDDelimiter + grant.granteeFullyQualifiedName()
+		if byGrantOption {
+			key += fmt.Sprintf("%s%t", helpers.IDDelimiter, grant.WithGrantOption)
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = &objectGrantsGroup{granteeType: grant.GranteeType, granteeName: grant.GranteeName, withGrantOption: byGrantOption && grant.WithGrantOption}
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		if !slices.Contains(group.privileges, strings.ToUpper(grant.Privilege)) {
+			group.privileges = append(group.privileges, strings.ToUpper(grant.Privilege))
+		}
+	}
+	sort.Strings(keys)
+	result := make([]objectGrantsGroup, len(keys))
+	for i, key := range keys {
+		result[i] = *groups[key]
+	}
+	return result
+}
+
+func objectGrantsAccountRoleGrantOn(objectType sdk.ObjectType, objectId sdk.ObjectIdentifier) *sdk.AccountRoleGrantOn {
+	on := new(sdk.AccountRoleGrantOn)
+	switch id := objectId.(type) {
+	case sdk.AccountObjectIdentifier:
+		accountObject := new(sdk.GrantOnAccountObject)
+		switch objectType {
+		case sdk.ObjectTypeComputePool:
+			accountObject.ComputePool = &id
+		case sdk.ObjectTypeDatabase:
+			accountObject.Database = &id
+		case sdk.ObjectTypeExternalVolume:
+			accountObject.ExternalVolume = &id
+		case sdk.ObjectTypeFailoverGroup:
+			accountObject.FailoverGroup = &id
+		case sdk.ObjectTypeIntegration:
+			accountObject.Integration = &id
+		case sdk.ObjectTypeReplicationGroup:
+			accountObject.ReplicationGroup = &id
+		case sdk.ObjectTypeResourceMonitor:
+			accountObject.ResourceMonitor = &id
+		case sdk.ObjectTypeUser:
+			accountObject.User = &id
+		case sdk.ObjectTypeWarehouse:
+			accountObject.Warehouse = &id
+		}
+		on.AccountObject = accountObject
+	case sdk.DatabaseObjectIdentifier:
+		on.Schema = &sdk.GrantOnSchema{Schema: &id}
+	default:
+		on.SchemaObject = &sdk.GrantOnSchemaObject{SchemaObject: &sdk.Object{ObjectType: objectType, Name: objectId}}
+	}
+	return on
+}
+
+func objectGrantsDatabaseRoleGrantOn(objectType sdk.ObjectType, objectId sdk.ObjectIdentifier) (*sdk.DatabaseRoleGrantOn, error) {
+	switch id := objectId.(type) {
+	case sdk.AccountObjectIdentifier:
+		if objectType != sdk.ObjectTypeDatabase {
+			return nil, fmt.Errorf("privileges on %s cannot be granted to database roles", objectType)
+		}
+		return &sdk.DatabaseRoleGrantOn{Database: &id}, nil
+	case sdk.DatabaseObjectIdentifier:
+		return &sdk.DatabaseRoleGrantOn{Schema: &sdk.GrantOnSchema{Schema: &id}}, nil
+	default:
+		return &sdk.DatabaseRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{SchemaObject: &sdk.Object{ObjectType: objectType, Name: objectId}}}, nil
+	}
+}
+
+func revokeObjectGrants(ctx context.Context, client *sdk.Client, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier, grants []objectGrant) error {
+	for _, group := range groupObjectGrants(grants, false) {
+		if err := applyObjectGrantsGroup(ctx, client, objectType, objectId, group, true); err != nil {
+			return fmt.Errorf("error revoking privileges %v on %s %s from %s %s: %w", group.privileges, objectType, objectId.FullyQualifiedName(), group.granteeType, group.granteeName, err)
+		}
+	}
+	return nil
+}
+
+func grantObjectGrants(ctx context.Context, client *sdk.Client, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier, grants []objectGrant) error {
+	for _, group := range groupObjectGrants(grants, true) {
+		if err := applyObjectGrantsGroup(ctx, client, objectType, objectId, group, false); err != nil {
+			return fmt.Errorf("error granting privileges %v on %s %s to %s %s: %w", group.privileges, objectType, objectId.FullyQualifiedName(), group.granteeType, group.granteeName, err)
+		}
+	}
+	return nil
+}
+
+func applyObjectGrantsGroup(ctx context.Context, client *sdk.Client, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier, group objectGrantsGroup, revoke bool) error {
+	if group.granteeType == sdk.ObjectTypeDatabaseRole {
+		on, err := objectGrantsDatabaseRoleGrantOn(objectType, objectId)
+		if err != nil {
+			return err
+		}
+		_, isAccountObject := objectId.(sdk.AccountObjectIdentifier)
+		_, isSchema := objectId.(sdk.DatabaseObjectIdentifier)
+		privileges := getDatabaseRolePrivileges(false, group.privileges, isAccountObject, isSchema, !isAccountObject && !isSchema)
+		role := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(group.granteeName)
+		if revoke {
+			return client.Grants.RevokePrivilegesFromDatabaseRole(ctx, privileges, on, role, &sdk.RevokePrivilegesFromDatabaseRoleOptions{})
+		}
+		return client.Grants.GrantPrivilegesToDatabaseRole(ctx, privileges, on, role, &sdk.GrantPrivilegesToDatabaseRoleOptions{WithGrantOption: sdk.Bool(group.withGrantOption)})
+	}
+
+	on := objectGrantsAccountRoleGrantOn(objectType, objectId)
+	privileges := getAccountRolePrivileges(false, group.privileges, false, on.AccountObject != nil, on.Schema != nil, on.SchemaObject != nil)
+	role := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(group.granteeName)
+	if revoke {
+		return client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, on, role, &sdk.RevokePrivilegesFromAccountRoleOptions{})
+	}
+	return client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, role, &sdk.GrantPrivilegesToAccountRoleOptions{WithGrantOption: sdk.Bool(group.withGrantOption)})
+}
+
+// reconcileObjectGrants revokes the privileges granted on the object which are not in the config and grants the missing ones.
+func reconcileObjectGrants(ctx context.Context, client *sdk.Client, d *schema.ResourceData, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier) error {
+	expected, err := expandObjectGrants(d.Get("grant").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+	actual, err := showObjectGrants(ctx, client, objectType, objectId)
+	if err != nil {
+		return err
+	}
+	toRevoke, toGrant := diffObjectGrants(actual, expected, func(grant objectGrant) bool { return isIgnoredObjectGrant(d, grant) })
+	if err := revokeObjectGrants(ctx, client, objectType, objectId, toRevoke); err != nil {
+		return err
+	}
+	return grantObjectGrants(ctx, client, objectType, objectId, toGrant)
+}
+
+func ImportObjectGrants(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	objectType, objectId, err := objectGrantsId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("object_type", objectType.String()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("object_name", objectId.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("ignore_system_roles", true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateObjectGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	objectType := sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string)))
+	objectId, err := objectGrantsIdentifier(objectType, d.Get("object_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := reconcileObjectGrants(ctx, client, d, objectType, objectId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{objectType.String(), objectId.FullyQualifiedName()}, helpers.IDDelimiter))
+	return ReadObjectGrants(ctx, d, meta)
+}
+
+func ReadObjectGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	objectType, objectId, err := objectGrantsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	actual, err := showObjectGrants(ctx, client, objectType, objectId)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve grants. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	// The grants already in the state keep their representation (e.g. identifier quoting), the unmanaged ones
+	// are added with fully qualified names, so that they are planned to be revoked.
+	managed, err := expandObjectGrants(d.Get("grant").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	managedByKey := make(map[string]objectGrant)
+	for _, grant := range managed {
+		managedByKey[grant.key()] = grant
+	}
+	grants := make([]any, 0, len(actual))
+	for _, grant := range actual {
+		if stateGrant, ok := managedByKey[grant.key()]; ok {
+			grants = append(grants, stateGrant.toMap())
+			continue
+		}
+		if isIgnoredObjectGrant(d, grant) {
+			continue
+		}
+		grants = append(grants, grant.toMap())
+	}
+
+	if err := d.Set("object_type", objectType.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("grant", grants); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func UpdateObjectGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	objectType, objectId, err := objectGrantsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := reconcileObjectGrants(ctx, client, d, objectType, objectId); err != nil {
+		return diag.FromErr(err)
+	}
+	return ReadObjectGrants(ctx, d, meta)
+}
+
+func DeleteObjectGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	objectType, objectId, err := objectGrantsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grants, err := expandObjectGrants(d.Get("grant").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := revokeObjectGrants(ctx, client, objectType, objectId, grants); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
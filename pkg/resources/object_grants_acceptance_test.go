@@ -0,0 +1,104 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ObjectGrants_OnDatabase(t *testing.T) {
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	roleId := sdk.NewAccountObjectIdentifier(roleName)
+	database, databaseCleanup := acc.TestClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	configVariables := func(privileges ...string) config.Variables {
+		variables := make([]config.Variable, len(privileges))
+		for i, privilege := range privileges {
+			variables[i] = config.StringVariable(privilege)
+		}
+		return config.Variables{
+			"account_role_name": config.StringVariable(roleId.FullyQualifiedName()),
+			"database_name":     config.StringVariable(database.ID().FullyQualifiedName()),
+			"privileges":        config.ListVariable(variables...),
+		}
+	}
+	resourceName := "snowflake_object_grants.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig:       func() { t.Cleanup(createAccountRoleOutsideTerraform(t, roleName)) },
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ObjectGrants/OnDatabase"),
+				ConfigVariables: configVariables("USAGE", "MONITOR"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("DATABASE|%s", database.ID().FullyQualifiedName())),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					queriedAccountRolePrivilegesEqualTo(roleId, "USAGE", "MONITOR"),
+				),
+			},
+			// grant made outside of Terraform is detected and revoked
+			{
+				PreConfig: func() {
+					grantPrivilegesOnDatabaseOutsideTerraform(t, roleId, database.ID(), sdk.AccountObjectPrivilegeCreateSchema)
+				},
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ObjectGrants/OnDatabase"),
+				ConfigVariables: configVariables("USAGE", "MONITOR"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					queriedAccountRolePrivilegesEqualTo(roleId, "USAGE", "MONITOR"),
+				),
+			},
+			// privilege removed from the config is revoked
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ObjectGrants/OnDatabase"),
+				ConfigVariables: configVariables("USAGE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					queriedAccountRolePrivilegesEqualTo(roleId, "USAGE"),
+				),
+			},
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_ObjectGrants/OnDatabase"),
+				ConfigVariables:   configVariables("USAGE"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func grantPrivilegesOnDatabaseOutsideTerraform(t *testing.T, roleId sdk.AccountObjectIdentifier, databaseId sdk.AccountObjectIdentifier, privileges ...sdk.AccountObjectPrivilege) {
+	t.Helper()
+	client := acc.Client(t)
+	ctx := context.Background()
+	err := client.Grants.GrantPrivilegesToAccountRole(
+		ctx,
+		&sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: privileges},
+		&sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}},
+		roleId,
+		new(sdk.GrantPrivilegesToAccountRoleOptions),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_objectGrant_key(t *testing.T) {
+	assert.Equal(t,
+		objectGrant{Privilege: "usage", GranteeType: sdk.ObjectTypeRole, GranteeName: "ANALYST"}.key(),
+		objectGrant{Privilege: "USAGE", GranteeType: sdk.ObjectTypeRole, GranteeName: `"ANALYST"`}.key(),
+	)
+	assert.Equal(t,
+		objectGrant{Privilege: "IMPORTED PRIVILEGES", GranteeType: sdk.ObjectTypeDatabaseRole, GranteeName: "DB.ROLE"}.key(),
+		objectGrant{Privilege: "USAGE", GranteeType: sdk.ObjectTypeDatabaseRole, GranteeName: `"DB"."ROLE"`}.key(),
+	)
+	assert.NotEqual(t,
+		objectGrant{Privilege: "USAGE", GranteeType: sdk.ObjectTypeRole, GranteeName: "ANALYST"}.key(),
+		objectGrant{Privilege: "USAGE", GranteeType: sdk.ObjectTypeRole, GranteeName: "ANALYST", WithGrantOption: true}.key(),
+	)
+}
+
+func Test_expandObjectGrants(t *testing.T) {
+	grants, err := expandObjectGrants([]any{
+		map[string]any{"privilege": "USAGE", "account_role_name": "ANALYST", "database_role_name": "", "with_grant_option": false},
+		map[string]any{"privilege": "MONITOR", "account_role_name": "", "database_role_name": "DB.ROLE", "with_grant_option": true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []objectGrant{
+		{Privilege: "USAGE", GranteeType: sdk.ObjectTypeRole, GranteeName: "ANALYST"},
+		{Privilege: "MONITOR", GranteeType: sdk.ObjectTypeDatabaseRole, GranteeName: "DB.ROLE", WithGrantOption: true},
+	}, grants)
+
+	_, err = expandObjectGrants([]any{map[string]any{"privilege": "USAGE", "account_role_name": "", "database_role_name": "", "with_grant_option": false}})
+	assert.ErrorContains(t, err, "exactly one of account_role_name and database_role_name")
+
+	_, err = expandObjectGrants([]any{map[string]any{"privilege": "USAGE", "account_role_name": "ANALYST", "database_role_name": "DB.ROLE", "with_grant_option": false}})
+	assert.ErrorContains(t, err, "exactly one of account_role_name and database_role_name")
+}
+
+func Test_diffObjectGrants(t *testing.T) {
+	usage := objectGrant{Privilege: "USAGE", GranteeType: sdk.ObjectTypeRole, GranteeName: `"ANALYST"`}
+	monitor := objectGrant{Privilege: "MONITOR", GranteeType: sdk.ObjectTypeRole, GranteeName: `"ANALYST"`}
+	usageWithGrantOption := objectGrant{Privilege: "USAGE", GranteeType: sdk.ObjectTypeRole, GranteeName: `"ANALYST"`, WithGrantOption: true}
+	sysadmin := objectGrant{Privilege: "USAGE", GranteeType: sdk.ObjectTypeRole, GranteeName: `"SYSADMIN"`}
+	ignored := func(grant objectGrant) bool { return grant.GranteeName == `"SYSADMIN"` }
+
+	toRevoke, toGrant := diffObjectGrants([]objectGrant{usage, monitor, sysadmin}, []objectGrant{usageWithGrantOption}, ignored)
+	assert.Equal(t, []objectGrant{usage, monitor}, toRevoke)
+	assert.Equal(t, []objectGrant{usageWithGrantOption}, toGrant)
+
+	toRevoke, toGrant = diffObjectGrants([]objectGrant{usage}, []objectGrant{{Privilege: "usage", GranteeType: sdk.ObjectTypeRole, GranteeName: "ANALYST"}}, ignored)
+	assert.Empty(t, toRevoke)
+	assert.Empty(t, toGrant)
+}
+
+func Test_groupObjectGrants(t *testing.T) {
+	grants := []objectGrant{
+		{Privilege: "usage", GranteeType: sdk.ObjectTypeRole, GranteeName: "ANALYST"},
+		{Privilege: "MONITOR", GranteeType: sdk.ObjectTypeRole, GranteeName: `"ANALYST"`, WithGrantOption: true},
+		{Privilege: "USAGE", GranteeType: sdk.ObjectTypeDatabaseRole, GranteeName: "DB.ROLE"},
+	}
+
+	assert.Equal(t, []objectGrantsGroup{
+		{granteeType: sdk.ObjectTypeDatabaseRole, granteeName: "DB.ROLE", privileges: []string{"USAGE"}},
+		{granteeType: sdk.ObjectTypeRole, granteeName: "ANALYST", privileges: []string{"USAGE", "MONITOR"}},
+	}, groupObjectGrants(grants, false))
+
+	assert.Equal(t, []objectGrantsGroup{
+		{granteeType: sdk.ObjectTypeDatabaseRole, granteeName: "DB.ROLE", privileges: []string{"USAGE"}},
+		{granteeType: sdk.ObjectTypeRole, granteeName: "ANALYST", privileges: []string{"USAGE"}},
+		{granteeType: sdk.ObjectTypeRole, granteeName: `"ANALYST"`, withGrantOption: true, privileges: []string{"MONITOR"}},
+	}, groupObjectGrants(grants, true))
+}
+
+func Test_objectGrantsId(t *testing.T) {
+	objectType, objectId, err := objectGrantsId(`TABLE|"DB"."SCHEMA"."TABLE"`)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.ObjectTypeTable, objectType)
+	assert.Equal(t, sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "TABLE"), objectId)
+
+	objectType, objectId, err = objectGrantsId(`SCHEMA|"DB"."SCHEMA"`)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.ObjectTypeSchema, objectType)
+	assert.Equal(t, sdk.NewDatabaseObjectIdentifier("DB", "SCHEMA"), objectId)
+
+	objectType, objectId, err = objectGrantsId(`WAREHOUSE|"WH"`)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.ObjectTypeWarehouse, objectType)
+	assert.Equal(t, sdk.NewAccountObjectIdentifier("WH"), objectId)
+
+	_, _, err = objectGrantsId(`"WH"`)
+	assert.ErrorContains(t, err, "invalid ID specified")
+
+	_, _, err = objectGrantsId(`ACCOUNT|"WH"`)
+	assert.ErrorContains(t, err, "unsupported object type ACCOUNT")
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var pipeSchema = map[string]*schema.Schema{
@@ -77,6 +78,62 @@ var pipeSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies the name of the notification integration used for error notifications.",
 	},
+	"paused": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the pipe should be paused (sets PIPE_EXECUTION_PAUSED).",
+	},
+	"force_resume": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true and the pipe is not paused in the configuration, a pipe that is not running (e.g. stalled or stopped after an ownership transfer) will be resumed with SYSTEM$PIPE_FORCE_RESUME on apply.",
+	},
+	"force_resume_options": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{string(sdk.StalenessCheckOverrideForceResumePipeOption), string(sdk.OwnershipTransferCheckOverrideForceResumePipeOption)}, true),
+		},
+		Optional:    true,
+		Description: "Options passed to SYSTEM$PIPE_FORCE_RESUME when `force_resume` is set. Valid values are (case-insensitive): STALENESS_CHECK_OVERRIDE | OWNERSHIP_TRANSFER_CHECK_OVERRIDE.",
+	},
+	"execution_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current execution state of the pipe as returned by SYSTEM$PIPE_STATUS. The status fields are empty when the role cannot read the status (it requires the MONITOR or OPERATE privilege on the pipe).",
+	},
+	"pending_file_count": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of files currently being processed by the pipe.",
+	},
+	"last_ingested_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp when the most recent file was loaded successfully by the pipe.",
+	},
+	"last_ingested_file_path": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Path of the file loaded at the time specified in `last_ingested_timestamp`.",
+	},
+	"last_received_message_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp of the last event message received from the message queue.",
+	},
+	"last_forwarded_message_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp of the last event message that matched the stage and was forwarded to the pipe.",
+	},
+	"error": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Error message produced when the pipe was last compiled for execution (if applicable).",
+	},
 }
 
 func Pipe() *schema.Resource {
@@ -90,7 +147,25 @@ func Pipe() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: pipeForceResumeCustomizeDiff,
+	}
+}
+
+// pipeForceResumeCustomizeDiff plans an update whenever the pipe should be running, force resume is requested,
+// and the last known execution state is other than RUNNING. The resume itself is done in UpdatePipe.
+func pipeForceResumeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.Get("force_resume").(bool) || diff.Get("paused").(bool) {
+		return nil
 	}
+	if executionState, _ := diff.GetChange("execution_state"); pipeNeedsForceResume(sdk.PipeExecutionState(executionState.(string))) {
+		return diff.SetNewComputed("execution_state")
+	}
+	return nil
+}
+
+func pipeNeedsForceResume(executionState sdk.PipeExecutionState) bool {
+	return executionState != "" && executionState != sdk.RunningPipeExecutionState && executionState != sdk.PausedPipeExecutionState
 }
 
 func pipeCopyStatementDiffSuppress(_, o, n string, _ *schema.ResourceData) bool {
@@ -145,6 +220,13 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
+	if d.Get("paused").(bool) {
+		err := client.Pipes.Alter(ctx, objectIdentifier, &sdk.AlterPipeOptions{Set: &sdk.PipeSet{PipeExecutionPaused: sdk.Bool(true)}})
+		if err != nil {
+			return fmt.Errorf("error pausing pipe %v: %w", objectIdentifier.Name(), err)
+		}
+	}
+
 	return ReadPipe(d, meta)
 }
 
@@ -204,6 +286,37 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// SYSTEM$PIPE_STATUS requires MONITOR or OPERATE on the pipe, so roles without them can still refresh and import the pipe
+	pipeStatus, err := client.SystemFunctions.PipeStatusDetails(objectIdentifier)
+	if err != nil {
+		log.Printf("[WARN] unable to read status of pipe %v, the status fields are left empty: %v", objectIdentifier.Name(), err)
+		return setPipeStatusFields(d, nil)
+	}
+
+	return setPipeStatusFields(d, pipeStatus)
+}
+
+// setPipeStatusFields sets the status fields of the pipe; with a nil pipeStatus they are emptied and paused is kept as is.
+func setPipeStatusFields(d *schema.ResourceData, pipeStatus *sdk.PipeStatusDetails) error {
+	if pipeStatus == nil {
+		pipeStatus = &sdk.PipeStatusDetails{}
+	} else if err := d.Set("paused", pipeStatus.ExecutionState == sdk.PausedPipeExecutionState); err != nil {
+		return err
+	}
+	fields := map[string]any{
+		"execution_state":                  string(pipeStatus.ExecutionState),
+		"pending_file_count":               pipeStatus.PendingFileCount,
+		"last_ingested_timestamp":          pipeStatus.LastIngestedTimestamp,
+		"last_ingested_file_path":          pipeStatus.LastIngestedFilePath,
+		"last_received_message_timestamp":  pipeStatus.LastReceivedMessageTimestamp,
+		"last_forwarded_message_timestamp": pipeStatus.LastForwardedMessageTimestamp,
+		"error":                            pipeStatus.Error,
+	}
+	for key, value := range fields {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -254,6 +367,31 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	paused := d.Get("paused").(bool)
+	if d.HasChange("paused") {
+		options := &sdk.AlterPipeOptions{Set: &sdk.PipeSet{PipeExecutionPaused: sdk.Bool(paused)}}
+		err := client.Pipes.Alter(ctx, objectIdentifier, options)
+		if err != nil {
+			return fmt.Errorf("error updating pipe %v: %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if !paused && d.Get("force_resume").(bool) {
+		executionState, err := client.SystemFunctions.PipeStatus(objectIdentifier)
+		if err != nil {
+			return fmt.Errorf("error reading status of pipe %v: %w", objectIdentifier.Name(), err)
+		}
+		if pipeNeedsForceResume(executionState) {
+			var options []sdk.ForceResumePipeOption
+			for _, option := range expandStringList(d.Get("force_resume_options").(*schema.Set).List()) {
+				options = append(options, sdk.ForceResumePipeOption(strings.ToUpper(option)))
+			}
+			if err := client.SystemFunctions.PipeForceResume(objectIdentifier, options); err != nil {
+				return fmt.Errorf("error force resuming pipe %v: %w", objectIdentifier.Name(), err)
+			}
+		}
+	}
+
 	return ReadPipe(d, meta)
 }
 
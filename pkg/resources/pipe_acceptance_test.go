@@ -33,6 +33,40 @@ func TestAcc_Pipe(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "comment", "Terraform acceptance test"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "auto_ingest", "false"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "notification_channel", ""),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "paused", "false"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "execution_state", "RUNNING"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "pending_file_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Pipe_paused(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Pipe),
+		Steps: []resource.TestStep{
+			{
+				Config: pipeConfigWithPaused(accName, acc.TestDatabaseName, acc.TestSchemaName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "paused", "true"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "execution_state", "PAUSED"),
+				),
+			},
+			{
+				Config: pipeConfigWithPaused(accName, acc.TestDatabaseName, acc.TestSchemaName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "paused", "false"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "execution_state", "RUNNING"),
 				),
 			},
 		},
@@ -81,3 +115,34 @@ CMD
 `
 	return fmt.Sprintf(s, databaseName, schemaName, name, name, databaseName, schemaName, databaseName, schemaName, name)
 }
+
+func pipeConfigWithPaused(name string, databaseName string, schemaName string, paused bool) string {
+	s := `
+resource "snowflake_table" "test" {
+  database = "%[2]s"
+  schema   = "%[3]s"
+  name     = "%[1]s"
+
+  column {
+    name = "id"
+    type = "NUMBER(5,0)"
+  }
+}
+
+resource "snowflake_stage" "test" {
+  name     = "%[1]s"
+  database = "%[2]s"
+  schema   = "%[3]s"
+}
+
+resource "snowflake_pipe" "test" {
+  database       = "%[2]s"
+  schema         = "%[3]s"
+  name           = "%[1]s"
+  copy_statement = "COPY INTO \"${snowflake_table.test.database}\".\"${snowflake_table.test.schema}\".\"${snowflake_table.test.name}\" FROM @\"${snowflake_stage.test.database}\".\"${snowflake_stage.test.schema}\".\"${snowflake_stage.test.name}\" FILE_FORMAT = (TYPE = CSV)"
+  paused         = %[4]t
+  force_resume   = true
+}
+`
+	return fmt.Sprintf(s, name, databaseName, schemaName, paused)
+}
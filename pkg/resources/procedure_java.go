@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var procedureJavaSchema = func() map[string]*schema.Schema {
+	s := functionCommonSchema("procedure")
+	// ALTER PROCEDURE does not support changing the secure flag
+	s["is_secure"].ForceNew = true
+	s["execute_as"] = procedureExecuteAsSchema()
+	s["return_not_null"] = procedureReturnNotNullSchema()
+	s["null_input_behavior"] = functionNullInputBehaviorSchema("procedure")
+	s["runtime_version"] = functionRuntimeVersionSchema("Java", true)
+	s["imports"] = functionImportsSchema()
+	s["packages"] = functionPackagesSchema(true)
+	s["handler"] = functionHandlerSchema("procedure")
+	s["external_access_integrations"] = functionExternalAccessIntegrationsSchema()
+	s["secrets"] = functionSecretsSchema()
+	s["target_path"] = functionTargetPathSchema()
+	s["procedure_definition"] = functionDefinitionSchema("procedure", "Java", false)
+	return s
+}()
+
+// ProcedureJava returns a pointer to the resource representing a Java stored procedure.
+func ProcedureJava() *schema.Resource {
+	read := readProcedureForLanguage(procedureJavaSchema)
+	return &schema.Resource{
+		CreateContext: CreateContextProcedureJava,
+		ReadContext:   read,
+		UpdateContext: updateProcedureForLanguage(read),
+		DeleteContext: deleteProcedureForLanguage,
+
+		Description: "Resource used to manage Java stored procedures. For more information, check [procedure documentation](https://docs.snowflake.com/en/sql-reference/sql/create-procedure).",
+
+		Schema: procedureJavaSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextProcedureJava(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := functionIdentifierFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	arguments, err := getProcedureArgumentRequests(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	returns, diags := getProcedureReturnsRequest(d)
+	if diags != nil {
+		return diags
+	}
+
+	request := sdk.NewCreateForJavaProcedureRequest(id.WithoutArguments(), *returns, d.Get("runtime_version").(string), getProcedurePackages(d), d.Get("handler").(string)).
+		WithArguments(arguments).
+		WithSecure(sdk.Bool(d.Get("is_secure").(bool))).
+		WithNullInputBehavior(sdk.Pointer(sdk.NullInputBehavior(d.Get("null_input_behavior").(string)))).
+		WithExecuteAs(getProcedureExecuteAs(d)).
+		WithImports(getProcedureImports(d)).
+		WithExternalAccessIntegrations(getFunctionExternalAccessIntegrations(d)).
+		WithSecrets(getFunctionSecrets(d))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("target_path"); ok {
+		request.WithTargetPath(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("procedure_definition"); ok {
+		request.WithProcedureDefinition(sdk.String(v.(string)))
+	}
+
+	if err := client.Procedures.CreateForJava(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	return readProcedureForLanguage(procedureJavaSchema)(ctx, d, meta)
+}
@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var procedureJavascriptSchema = func() map[string]*schema.Schema {
+	s := functionCommonSchema("procedure")
+	// ALTER PROCEDURE does not support changing the secure flag
+	s["is_secure"].ForceNew = true
+	s["execute_as"] = procedureExecuteAsSchema()
+	s["return_type"].Description = "Specifies the data type returned by the procedure. JavaScript procedures cannot return tables."
+	s["return_not_null"] = procedureReturnNotNullSchema()
+	s["null_input_behavior"] = functionNullInputBehaviorSchema("procedure")
+	s["procedure_definition"] = functionDefinitionSchema("procedure", "JavaScript", true)
+	return s
+}()
+
+// ProcedureJavascript returns a pointer to the resource representing a JavaScript stored procedure.
+func ProcedureJavascript() *schema.Resource {
+	read := readProcedureForLanguage(procedureJavascriptSchema)
+	return &schema.Resource{
+		CreateContext: CreateContextProcedureJavascript,
+		ReadContext:   read,
+		UpdateContext: updateProcedureForLanguage(read),
+		DeleteContext: deleteProcedureForLanguage,
+
+		Description: "Resource used to manage JavaScript stored procedures. For more information, check [procedure documentation](https://docs.snowflake.com/en/sql-reference/sql/create-procedure).",
+
+		Schema: procedureJavascriptSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextProcedureJavascript(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := functionIdentifierFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	arguments, err := getProcedureArgumentRequests(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	returnDataType, diags := convertProcedureDataType(d.Get("return_type").(string))
+	if diags != nil {
+		return diags
+	}
+
+	request := sdk.NewCreateForJavaScriptProcedureRequest(id.WithoutArguments(), returnDataType, d.Get("procedure_definition").(string)).
+		WithArguments(arguments).
+		WithSecure(sdk.Bool(d.Get("is_secure").(bool))).
+		WithNullInputBehavior(sdk.Pointer(sdk.NullInputBehavior(d.Get("null_input_behavior").(string)))).
+		WithExecuteAs(getProcedureExecuteAs(d))
+	if d.Get("return_not_null").(bool) {
+		request.WithNotNull(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Procedures.CreateForJavaScript(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	return readProcedureForLanguage(procedureJavascriptSchema)(ctx, d, meta)
+}
@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var procedurePythonSchema = func() map[string]*schema.Schema {
+	s := functionCommonSchema("procedure")
+	// ALTER PROCEDURE does not support changing the secure flag
+	s["is_secure"].ForceNew = true
+	s["execute_as"] = procedureExecuteAsSchema()
+	s["return_not_null"] = procedureReturnNotNullSchema()
+	s["null_input_behavior"] = functionNullInputBehaviorSchema("procedure")
+	s["runtime_version"] = functionRuntimeVersionSchema("Python", true)
+	s["imports"] = functionImportsSchema()
+	s["packages"] = functionPackagesSchema(true)
+	s["handler"] = functionHandlerSchema("procedure")
+	s["external_access_integrations"] = functionExternalAccessIntegrationsSchema()
+	s["secrets"] = functionSecretsSchema()
+	s["procedure_definition"] = functionDefinitionSchema("procedure", "Python", false)
+	return s
+}()
+
+// ProcedurePython returns a pointer to the resource representing a Python stored procedure.
+func ProcedurePython() *schema.Resource {
+	read := readProcedureForLanguage(procedurePythonSchema)
+	return &schema.Resource{
+		CreateContext: CreateContextProcedurePython,
+		ReadContext:   read,
+		UpdateContext: updateProcedureForLanguage(read),
+		DeleteContext: deleteProcedureForLanguage,
+
+		Description: "Resource used to manage Python stored procedures. For more information, check [procedure documentation](https://docs.snowflake.com/en/sql-reference/sql/create-procedure).",
+
+		Schema: procedurePythonSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextProcedurePython(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := functionIdentifierFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	arguments, err := getProcedureArgumentRequests(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	returns, diags := getProcedureReturnsRequest(d)
+	if diags != nil {
+		return diags
+	}
+
+	request := sdk.NewCreateForPythonProcedureRequest(id.WithoutArguments(), *returns, d.Get("runtime_version").(string), getProcedurePackages(d), d.Get("handler").(string)).
+		WithArguments(arguments).
+		WithSecure(sdk.Bool(d.Get("is_secure").(bool))).
+		WithNullInputBehavior(sdk.Pointer(sdk.NullInputBehavior(d.Get("null_input_behavior").(string)))).
+		WithExecuteAs(getProcedureExecuteAs(d)).
+		WithImports(getProcedureImports(d)).
+		WithExternalAccessIntegrations(getFunctionExternalAccessIntegrations(d)).
+		WithSecrets(getFunctionSecrets(d))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("procedure_definition"); ok {
+		request.WithProcedureDefinition(sdk.String(v.(string)))
+	}
+
+	if err := client.Procedures.CreateForPython(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	return readProcedureForLanguage(procedurePythonSchema)(ctx, d, meta)
+}
@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var procedureSQLSchema = func() map[string]*schema.Schema {
+	s := functionCommonSchema("procedure")
+	// ALTER PROCEDURE does not support changing the secure flag
+	s["is_secure"].ForceNew = true
+	s["execute_as"] = procedureExecuteAsSchema()
+	s["return_not_null"] = procedureReturnNotNullSchema()
+	s["null_input_behavior"] = functionNullInputBehaviorSchema("procedure")
+	s["procedure_definition"] = functionDefinitionSchema("procedure", "Snowflake Scripting", true)
+	return s
+}()
+
+// ProcedureSQL returns a pointer to the resource representing a SQL stored procedure.
+func ProcedureSQL() *schema.Resource {
+	read := readProcedureForLanguage(procedureSQLSchema)
+	return &schema.Resource{
+		CreateContext: CreateContextProcedureSQL,
+		ReadContext:   read,
+		UpdateContext: updateProcedureForLanguage(read),
+		DeleteContext: deleteProcedureForLanguage,
+
+		Description: "Resource used to manage SQL stored procedures. For more information, check [procedure documentation](https://docs.snowflake.com/en/sql-reference/sql/create-procedure).",
+
+		Schema: procedureSQLSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextProcedureSQL(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := functionIdentifierFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	arguments, err := getProcedureArgumentRequests(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	returns, diags := parseProcedureSQLReturnsRequest(d.Get("return_type").(string))
+	if diags != nil {
+		return diags
+	}
+	if d.Get("return_not_null").(bool) {
+		returns.WithNotNull(sdk.Bool(true))
+	}
+
+	request := sdk.NewCreateForSQLProcedureRequest(id.WithoutArguments(), *returns, d.Get("procedure_definition").(string)).
+		WithArguments(arguments).
+		WithSecure(sdk.Bool(d.Get("is_secure").(bool))).
+		WithNullInputBehavior(sdk.Pointer(sdk.NullInputBehavior(d.Get("null_input_behavior").(string)))).
+		WithExecuteAs(getProcedureExecuteAs(d))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Procedures.CreateForSQL(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	return readProcedureForLanguage(procedureSQLSchema)(ctx, d, meta)
+}
@@ -92,12 +92,29 @@ var resourceMonitorSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies whether the resource monitor should be applied globally to your Snowflake account (defaults to false).",
 		Default:     false,
+		Deprecated:  "Use the snowflake_resource_monitor_assignment resource instead",
 	},
 	"warehouses": {
 		Type:        schema.TypeSet,
 		Optional:    true,
 		Description: "A list of warehouses to apply the resource monitor to.",
 		Elem:        &schema.Schema{Type: schema.TypeString},
+		Deprecated:  "Use the snowflake_resource_monitor_assignment resource instead",
+	},
+	"used_credits": {
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "The number of credits used in the current monthly billing cycle by all the warehouses associated with the resource monitor.",
+	},
+	"remaining_credits": {
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "The number of credits still available to use in the current monthly billing cycle.",
+	},
+	"level": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The level at which the resource monitor is assigned: `ACCOUNT`, `WAREHOUSE` or empty when it is not assigned.",
 	},
 }
 
@@ -291,11 +308,24 @@ func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// Account level
-	if err := d.Set("set_for_account", resourceMonitor.Level == sdk.ResourceMonitorLevelAccount); err != nil {
+	if err := d.Set("used_credits", resourceMonitor.UsedCredits); err != nil {
+		return err
+	}
+	if err := d.Set("remaining_credits", resourceMonitor.RemainingCredits); err != nil {
+		return err
+	}
+	if err := d.Set("level", resourceMonitor.Level.String()); err != nil {
 		return err
 	}
 
+	// Account level; only drift from true is reported, so the monitor can be assigned to the account by a
+	// snowflake_resource_monitor_assignment without a diff here
+	if d.Get("set_for_account").(bool) {
+		if err := d.Set("set_for_account", resourceMonitor.Level == sdk.ResourceMonitorLevelAccount); err != nil {
+			return err
+		}
+	}
+
 	return err
 }
 
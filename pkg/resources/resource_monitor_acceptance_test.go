@@ -64,11 +64,12 @@ func TestAcc_ResourceMonitor(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "suspend_immediate_trigger", "95"),
 				),
 			},
-			// IMPORT
+			// IMPORT; set_for_account is refreshed only when true, the assignment is imported separately
 			{
-				ResourceName:      "snowflake_resource_monitor.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "snowflake_resource_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"set_for_account"},
 			},
 		},
 	})
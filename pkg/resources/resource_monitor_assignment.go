@@ -0,0 +1,166 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var resourceMonitorAssignmentSchema = map[string]*schema.Schema{
+	"resource_monitor": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Identifier of the resource monitor to assign.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"set_for_account": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Assigns the resource monitor to the account. Only one resource monitor can be assigned to the account.",
+		ExactlyOneOf: []string{"set_for_account", "warehouse"},
+	},
+	"warehouse": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "Identifier of the warehouse to assign the resource monitor to. Only one resource monitor can be assigned to a warehouse.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf:     []string{"set_for_account", "warehouse"},
+	},
+}
+
+// ResourceMonitorAssignment returns a pointer to the resource representing the assignment of a resource monitor to the
+// account or to a warehouse.
+func ResourceMonitorAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateContextResourceMonitorAssignment,
+		ReadContext:   ReadContextResourceMonitorAssignment,
+		DeleteContext: DeleteContextResourceMonitorAssignment,
+
+		Description: "Resource used to assign a resource monitor to the account or to a warehouse, so the assignment can be managed separately from the resource monitor. For more information, check [resource monitor documentation](https://docs.snowflake.com/en/user-guide/resource-monitors#assignment-of-resource-monitors).",
+
+		Schema: resourceMonitorAssignmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				resourceMonitorId, warehouseId, err := parseResourceMonitorAssignmentId(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				if err := d.Set("resource_monitor", resourceMonitorId.Name()); err != nil {
+					return nil, err
+				}
+				if warehouseId != nil {
+					if err := d.Set("warehouse", warehouseId.Name()); err != nil {
+						return nil, err
+					}
+				} else {
+					if err := d.Set("set_for_account", true); err != nil {
+						return nil, err
+					}
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+// parseResourceMonitorAssignmentId parses the id in the <resource_monitor>|ACCOUNT or
+// <resource_monitor>|WAREHOUSE|<warehouse> format; the warehouse identifier is nil for the account assignment.
+func parseResourceMonitorAssignmentId(id string) (sdk.AccountObjectIdentifier, *sdk.AccountObjectIdentifier, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	switch {
+	case len(parts) == 2 && parts[1] == sdk.ObjectTypeAccount.String():
+		return sdk.NewAccountObjectIdentifier(parts[0]), nil, nil
+	case len(parts) == 3 && parts[1] == sdk.ObjectTypeWarehouse.String():
+		return sdk.NewAccountObjectIdentifier(parts[0]), sdk.Pointer(sdk.NewAccountObjectIdentifier(parts[2])), nil
+	default:
+		return sdk.AccountObjectIdentifier{}, nil, fmt.Errorf("invalid ID specified: %v, expected <resource_monitor>|ACCOUNT or <resource_monitor>|WAREHOUSE|<warehouse>", id)
+	}
+}
+
+func CreateContextResourceMonitorAssignment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	resourceMonitorId := sdk.NewAccountObjectIdentifier(d.Get("resource_monitor").(string))
+
+	if v, ok := d.GetOk("warehouse"); ok {
+		warehouseId := sdk.NewAccountObjectIdentifier(v.(string))
+		if err := client.Warehouses.Alter(ctx, warehouseId, &sdk.AlterWarehouseOptions{Set: &sdk.WarehouseSet{ResourceMonitor: resourceMonitorId}}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting resource monitor %v on warehouse %v err = %w", resourceMonitorId.Name(), warehouseId.Name(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(resourceMonitorId.Name(), sdk.ObjectTypeWarehouse.String(), warehouseId.Name()))
+	} else {
+		if !d.Get("set_for_account").(bool) {
+			return diag.FromErr(fmt.Errorf("set_for_account has to be true when no warehouse is specified"))
+		}
+		if err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{Set: &sdk.AccountSet{ResourceMonitor: resourceMonitorId}}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting resource monitor %v on account err = %w", resourceMonitorId.Name(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(resourceMonitorId.Name(), sdk.ObjectTypeAccount.String()))
+	}
+
+	return ReadContextResourceMonitorAssignment(ctx, d, meta)
+}
+
+func ReadContextResourceMonitorAssignment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	resourceMonitorId, warehouseId, err := parseResourceMonitorAssignmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if warehouseId != nil {
+		warehouse, err := client.Warehouses.ShowByID(ctx, *warehouseId)
+		if err != nil {
+			log.Printf("[DEBUG] warehouse (%s) not found", warehouseId.Name())
+			d.SetId("")
+			return nil
+		}
+		if warehouse.ResourceMonitor != resourceMonitorId.Name() {
+			log.Printf("[DEBUG] resource monitor assignment (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return nil
+	}
+
+	resourceMonitor, err := client.ResourceMonitors.ShowByID(ctx, resourceMonitorId)
+	if err != nil {
+		log.Printf("[DEBUG] resource monitor (%s) not found", resourceMonitorId.Name())
+		d.SetId("")
+		return nil
+	}
+	if resourceMonitor.Level != sdk.ResourceMonitorLevelAccount {
+		log.Printf("[DEBUG] resource monitor assignment (%s) not found", d.Id())
+		d.SetId("")
+	}
+	return nil
+}
+
+func DeleteContextResourceMonitorAssignment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	resourceMonitorId, warehouseId, err := parseResourceMonitorAssignmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if warehouseId != nil {
+		if err := client.Warehouses.Alter(ctx, *warehouseId, &sdk.AlterWarehouseOptions{Unset: &sdk.WarehouseUnset{ResourceMonitor: sdk.Bool(true)}}); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting resource monitor %v on warehouse %v err = %w", resourceMonitorId.Name(), warehouseId.Name(), err))
+		}
+	} else {
+		if err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{Set: &sdk.AccountSet{ResourceMonitor: sdk.NewAccountObjectIdentifier("NULL")}}); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting resource monitor %v on account err = %w", resourceMonitorId.Name(), err))
+		}
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,64 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ResourceMonitorAssignment_warehouse(t *testing.T) {
+	resourceMonitorName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	warehouseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_resource_monitor_assignment.test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"resource_monitor": config.StringVariable(resourceMonitorName),
+			"warehouse":        config.StringVariable(warehouseName),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckResourceMonitorAssignmentDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ResourceMonitorAssignment/warehouse"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s|WAREHOUSE|%s", resourceMonitorName, warehouseName)),
+					resource.TestCheckResourceAttr(resourceName, "resource_monitor", resourceMonitorName),
+					resource.TestCheckResourceAttr(resourceName, "warehouse", warehouseName),
+					resource.TestCheckResourceAttr("snowflake_warehouse.test", "resource_monitor", resourceMonitorName),
+				),
+			},
+			// the level of the resource monitor is refreshed after the assignment
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ResourceMonitorAssignment/warehouse"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "level", "WAREHOUSE"),
+					resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "used_credits", "0"),
+					resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "remaining_credits", "10"),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_ResourceMonitorAssignment/warehouse"),
+				ConfigVariables:   m(),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
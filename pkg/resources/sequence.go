@@ -2,11 +2,14 @@ package resources
 
 import (
 	"context"
+	"fmt"
+	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,7 +18,6 @@ var sequenceSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "Specifies the name for the sequence.",
-		ForceNew:    true,
 	},
 	"comment": {
 		Type:        schema.TypeString,
@@ -23,6 +25,17 @@ var sequenceSchema = map[string]*schema.Schema{
 		Default:     "",
 		Description: "Specifies a comment for the sequence.",
 	},
+	"start": {
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "Specifies the first value returned by the sequence. Default is 1. It is used only when the sequence is created, so it is not read back from Snowflake and is not set on import.",
+		// the start value of an imported sequence is unknown, so it cannot cause a replacement
+		DiffSuppressFunc: func(_, old, _ string, d *schema.ResourceData) bool {
+			return d.Id() != "" && old == ""
+		},
+	},
 	"increment": {
 		Type:        schema.TypeInt,
 		Optional:    true,
@@ -33,23 +46,20 @@ var sequenceSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "The database in which to create the sequence. Don't use the | character.",
-		ForceNew:    true,
 	},
 	"schema": {
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "The schema in which to create the sequence. Don't use the | character.",
-		ForceNew:    true,
 	},
 	"next_value": {
 		Type:        schema.TypeInt,
-		Description: "The increment sequence interval.",
+		Description: "The next value the sequence will provide.",
 		Computed:    true,
-		ForceNew:    true,
 	},
 	"ordering": {
 		Type:        schema.TypeString,
-		Description: "The ordering of the sequence. Either ORDER or NOORDER. Default is ORDER.",
+		Description: "The ordering of the sequence. Either ORDER or NOORDER. Default is ORDER. A NOORDER sequence cannot be altered to ORDER, so such a change replaces the sequence (see `preserve_next_value_on_replace`).",
 		Optional:    true,
 		Default:     "ORDER",
 		ValidateDiagFunc: StringInSlice(
@@ -58,6 +68,12 @@ var sequenceSchema = map[string]*schema.Schema{
 				string(sdk.ValuesBehaviorOrder),
 			}, false),
 	},
+	"preserve_next_value_on_replace": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When the ordering changes from NOORDER to ORDER, recreates the sequence with CREATE OR REPLACE starting with its current next value, instead of replacing it with a sequence starting with `start`. The values already used by the tables are not provided again, but the grants on the sequence are lost. It applies only to this change: changing `start` (or anything else replacing the sequence) still replaces it with a sequence starting with the new `start`.",
+	},
 	"fully_qualified_name": {
 		Type:        schema.TypeString,
 		Description: "The fully qualified name of the sequence.",
@@ -67,134 +83,165 @@ var sequenceSchema = map[string]*schema.Schema{
 
 func Sequence() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSequence,
-		Read:   ReadSequence,
-		Delete: DeleteSequence,
-		Update: UpdateSequence,
+		CreateContext: CreateContextSequence,
+		ReadContext:   ReadContextSequence,
+		UpdateContext: UpdateContextSequence,
+		DeleteContext: DeleteContextSequence,
 
 		Schema: sequenceSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: sequenceOrderingCustomizeDiff,
 	}
 }
 
-func CreateSequence(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*provider.Context).Client
-	ctx := context.Background()
-	database := d.Get("database").(string)
-	schema := d.Get("schema").(string)
-	name := d.Get("name").(string)
-	id := sdk.NewSchemaObjectIdentifier(database, schema, name)
-	req := sdk.NewCreateSequenceRequest(id)
-
-	if v, ok := d.GetOk("increment"); ok {
-		req.WithIncrement(sdk.Int(v.(int)))
+// sequenceOrderingCustomizeDiff forces the replacement of the sequence when the ordering changes from NOORDER to ORDER,
+// which cannot be altered, unless the sequence should be recreated in place with its current next value.
+func sequenceOrderingCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" || !diff.HasChange("ordering") || diff.Get("preserve_next_value_on_replace").(bool) {
+		return nil
+	}
+	o, n := diff.GetChange("ordering")
+	if o.(string) == string(sdk.ValuesBehaviorNoOrder) && n.(string) == string(sdk.ValuesBehaviorOrder) {
+		return diff.ForceNew("ordering")
 	}
+	return nil
+}
 
+func createSequenceRequest(id sdk.SchemaObjectIdentifier, d *schema.ResourceData, start int) *sdk.CreateSequenceRequest {
+	request := sdk.NewCreateSequenceRequest(id).
+		WithStart(sdk.Int(start)).
+		WithIncrement(sdk.Int(d.Get("increment").(int))).
+		WithValuesBehavior(sdk.ValuesBehaviorPointer(sdk.ValuesBehavior(d.Get("ordering").(string))))
 	if v, ok := d.GetOk("comment"); ok {
-		req.WithComment(sdk.String(v.(string)))
+		request.WithComment(sdk.String(v.(string)))
 	}
-	if v, ok := d.GetOk("ordering"); ok {
-		req.WithValuesBehavior(sdk.ValuesBehaviorPointer(sdk.ValuesBehavior(v.(string))))
+	return request
+}
+
+func CreateContextSequence(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	start := 1
+	if !d.GetRawConfig().GetAttr("start").IsNull() {
+		start = d.Get("start").(int)
 	}
-	err := client.Sequences.Create(ctx, req)
-	if err != nil {
-		return err
+	if err := client.Sequences.Create(ctx, createSequenceRequest(id, d, start)); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating sequence %v err = %w", id.FullyQualifiedName(), err))
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	if err := d.Set("start", start); err != nil {
+		return diag.FromErr(err)
 	}
 
-	d.SetId(helpers.EncodeSnowflakeID(database, schema, name))
-
-	return ReadSequence(d, meta)
+	return ReadContextSequence(ctx, d, meta)
 }
 
-func ReadSequence(d *schema.ResourceData, meta interface{}) error {
+func ReadContextSequence(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
 	seq, err := client.Sequences.ShowByID(ctx, id)
 	if err != nil {
-		return err
+		log.Printf("[DEBUG] sequence (%s) not found", d.Id())
+		d.SetId("")
+		return nil
 	}
-
 	if err := d.Set("name", seq.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-
 	if err := d.Set("schema", seq.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-
 	if err := d.Set("database", seq.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-
 	if err := d.Set("comment", seq.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-
 	if err := d.Set("increment", seq.Interval); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-
 	if err := d.Set("next_value", seq.NextValue); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
+	ordering := sdk.ValuesBehaviorNoOrder
 	if seq.Ordered {
-		if err := d.Set("ordering", "ORDER"); err != nil {
-			return err
-		}
-	} else {
-		if err := d.Set("ordering", "NOORDER"); err != nil {
-			return err
-		}
+		ordering = sdk.ValuesBehaviorOrder
+	}
+	if err := d.Set("ordering", string(ordering)); err != nil {
+		return diag.FromErr(err)
 	}
-
 	if err := d.Set("fully_qualified_name", id.FullyQualifiedName()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func UpdateSequence(d *schema.ResourceData, meta interface{}) error {
+func UpdateContextSequence(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
+	// renaming to another database or schema moves the sequence, so its current value is kept
+	if d.HasChanges("name", "database", "schema") {
+		newId := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+		if err := client.Sequences.Alter(ctx, sdk.NewAlterSequenceRequest(id).WithRenameTo(&newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming sequence %v err = %w", id.FullyQualifiedName(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
+	}
+
+	// the change from NOORDER to ORDER reaches the update only with preserve_next_value_on_replace set
+	if o, n := d.GetChange("ordering"); o.(string) == string(sdk.ValuesBehaviorNoOrder) && n.(string) == string(sdk.ValuesBehaviorOrder) {
+		seq, err := client.Sequences.ShowByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request := createSequenceRequest(id, d, seq.NextValue).WithOrReplace(sdk.Bool(true))
+		if err := client.Sequences.Create(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error recreating sequence %v err = %w", id.FullyQualifiedName(), err))
+		}
+		return ReadContextSequence(ctx, d, meta)
+	}
+
 	if d.HasChange("comment") {
-		req := sdk.NewAlterSequenceRequest(id)
-		req.WithSet(sdk.NewSequenceSetRequest().WithComment(sdk.String(d.Get("comment").(string))))
-		if err := client.Sequences.Alter(ctx, req); err != nil {
-			return err
+		request := sdk.NewAlterSequenceRequest(id)
+		if comment := d.Get("comment").(string); comment != "" {
+			request.WithSet(sdk.NewSequenceSetRequest().WithComment(sdk.String(comment)))
+		} else {
+			request.WithUnsetComment(sdk.Bool(true))
+		}
+		if err := client.Sequences.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating sequence %v comment err = %w", id.FullyQualifiedName(), err))
 		}
 	}
 
 	if d.HasChange("increment") {
-		req := sdk.NewAlterSequenceRequest(id)
-		req.WithSetIncrement(sdk.Int(d.Get("increment").(int)))
-		if err := client.Sequences.Alter(ctx, req); err != nil {
-			return err
+		request := sdk.NewAlterSequenceRequest(id).WithSetIncrement(sdk.Int(d.Get("increment").(int)))
+		if err := client.Sequences.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating sequence %v increment err = %w", id.FullyQualifiedName(), err))
 		}
 	}
 
 	if d.HasChange("ordering") {
-		req := sdk.NewAlterSequenceRequest(id)
-		req.WithSet(sdk.NewSequenceSetRequest().WithValuesBehavior(sdk.ValuesBehaviorPointer(sdk.ValuesBehavior(d.Get("ordering").(string)))))
-		if err := client.Sequences.Alter(ctx, req); err != nil {
-			return err
+		request := sdk.NewAlterSequenceRequest(id).WithSet(sdk.NewSequenceSetRequest().WithValuesBehavior(sdk.ValuesBehaviorPointer(sdk.ValuesBehavior(d.Get("ordering").(string)))))
+		if err := client.Sequences.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating sequence %v ordering err = %w", id.FullyQualifiedName(), err))
 		}
 	}
-	return ReadSequence(d, meta)
+
+	return ReadContextSequence(ctx, d, meta)
 }
 
-func DeleteSequence(d *schema.ResourceData, meta interface{}) error {
+func DeleteContextSequence(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
-	err := client.Sequences.Drop(ctx, sdk.NewDropSequenceRequest(id).WithIfExists(sdk.Bool(true)))
-	if err != nil {
-		return err
+	if err := client.Sequences.Drop(ctx, sdk.NewDropSequenceRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting sequence %v err = %w", id.FullyQualifiedName(), err))
 	}
 	d.SetId("")
 	return nil
@@ -1,7 +1,9 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -59,14 +62,79 @@ func TestAcc_Sequence(t *testing.T) {
 			},
 			// IMPORT
 			{
-				ResourceName:      "snowflake_sequence.test_sequence",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "snowflake_sequence.test_sequence",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start", "preserve_next_value_on_replace"},
 			},
 		},
 	})
 }
 
+func TestAcc_Sequence_preserveNextValueOnReplace(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: acc.CheckDestroy(t, resources.Sequence),
+		Steps: []resource.TestStep{
+			{
+				Config: sequenceConfigWithOrdering(accName, acc.TestDatabaseName, acc.TestSchemaName, "NOORDER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "start", "100"),
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "next_value", "100"),
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "ordering", "NOORDER"),
+				),
+			},
+			// NOORDER cannot be altered to ORDER, so the sequence is recreated in place with its current next value
+			{
+				PreConfig: func() {
+					_, err := acc.Client(t).ExecForTests(context.Background(), fmt.Sprintf(`SELECT "%s"."%s"."%s".NEXTVAL`, acc.TestDatabaseName, acc.TestSchemaName, accName))
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: sequenceConfigWithOrdering(accName, acc.TestDatabaseName, acc.TestSchemaName, "ORDER"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_sequence.test_sequence", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "start", "100"),
+					// the value used before the recreation is not provided again
+					resource.TestCheckResourceAttrWith("snowflake_sequence.test_sequence", "next_value", func(value string) error {
+						if nextValue, err := strconv.Atoi(value); err != nil || nextValue <= 100 {
+							return fmt.Errorf("expected next_value greater than 100, got %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "ordering", "ORDER"),
+				),
+			},
+		},
+	})
+}
+
+func sequenceConfigWithOrdering(sequenceName string, databaseName string, schemaName string, ordering string) string {
+	s := `
+resource "snowflake_sequence" "test_sequence" {
+	name                           = "%s"
+	database                       = "%s"
+	schema                         = "%s"
+	start                          = 100
+	increment                      = 10
+	ordering                       = "%s"
+	preserve_next_value_on_replace = true
+}
+`
+	return fmt.Sprintf(s, sequenceName, databaseName, schemaName, ordering)
+}
+
 func sequenceConfigWithIncrement(sequenceName string, databaseName string, schemaName string) string {
 	s := `
 resource "snowflake_sequence" "test_sequence" {
@@ -39,6 +39,12 @@ var shareSchema = map[string]*schema.Schema{
 			"in the form of 'organization_name.account_name",
 		DiffSuppressFunc: diffCaseInsensitive,
 	},
+	"managed_accounts": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of managed (reader) accounts to be added to the share, e.g. `snowflake_managed_account.reader.name`. The accounts are added with their account locators.",
+	},
 }
 
 // Share returns a pointer to the resource representing a share.
@@ -75,7 +81,11 @@ func CreateShare(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(name)
 
 	accounts := expandStringList(d.Get("accounts").([]interface{}))
-	if len(accounts) > 0 {
+	managedAccountIdentifiers, err := managedAccountLocators(ctx, client, expandStringList(d.Get("managed_accounts").(*schema.Set).List()))
+	if err != nil {
+		return err
+	}
+	if len(accounts) > 0 || len(managedAccountIdentifiers) > 0 {
 		shareID := sdk.NewAccountObjectIdentifier(name)
 		accountIdentifiers := make([]sdk.AccountIdentifier, len(accounts))
 		for i, account := range accounts {
@@ -84,7 +94,7 @@ func CreateShare(d *schema.ResourceData, meta interface{}) error {
 			accountName := parts[1]
 			accountIdentifiers[i] = sdk.NewAccountIdentifier(orgName, accountName)
 		}
-		err := setShareAccounts(ctx, client, shareID, accountIdentifiers)
+		err := setShareAccounts(ctx, client, shareID, append(accountIdentifiers, managedAccountIdentifiers...))
 		if err != nil {
 			return err
 		}
@@ -92,6 +102,19 @@ func CreateShare(d *schema.ResourceData, meta interface{}) error {
 	return ReadShare(d, meta)
 }
 
+// managedAccountLocators returns the account identifiers (by account locator) of the managed accounts with the given names.
+func managedAccountLocators(ctx context.Context, client *sdk.Client, names []string) ([]sdk.AccountIdentifier, error) {
+	accountIdentifiers := make([]sdk.AccountIdentifier, len(names))
+	for i, name := range names {
+		managedAccount, err := client.ManagedAccounts.ShowByID(ctx, sdk.NewAccountObjectIdentifier(name))
+		if err != nil {
+			return nil, fmt.Errorf("error reading managed account (%v) err = %w", name, err)
+		}
+		accountIdentifiers[i] = sdk.NewAccountIdentifierFromAccountLocator(managedAccount.Locator)
+	}
+	return accountIdentifiers, nil
+}
+
 func setShareAccounts(ctx context.Context, client *sdk.Client, shareID sdk.AccountObjectIdentifier, accounts []sdk.AccountIdentifier) error {
 	// There is a race condition where error accounts cannot be added to a
 	// share until after a database is added to the share. Since a database
@@ -170,9 +193,32 @@ func ReadShare(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("comment", share.Comment); err != nil {
 		return err
 	}
-	accounts := make([]string, len(share.To))
-	for i, accountIdentifier := range share.To {
-		accounts[i] = accountIdentifier.Name()
+	// the managed accounts are listed either by their locators or by their names within the organization
+	managedAccountNames := make(map[string]string)
+	for _, name := range expandStringList(d.Get("managed_accounts").(*schema.Set).List()) {
+		managedAccount, err := client.ManagedAccounts.ShowByID(ctx, sdk.NewAccountObjectIdentifier(name))
+		if err != nil {
+			log.Printf("[DEBUG] managed account (%v) not found", name)
+			continue
+		}
+		managedAccountNames[strings.ToUpper(managedAccount.Locator)] = name
+		managedAccountNames[strings.ToUpper(managedAccount.Name)] = name
+	}
+	accounts := make([]string, 0, len(share.To))
+	managedAccounts := make([]string, 0)
+	for _, accountIdentifier := range share.To {
+		accountName := accountIdentifier.Name()
+		if parts := strings.Split(accountName, "."); len(parts) > 1 {
+			accountName = parts[len(parts)-1]
+		}
+		if name, ok := managedAccountNames[strings.ToUpper(accountName)]; ok {
+			managedAccounts = append(managedAccounts, name)
+			continue
+		}
+		accounts = append(accounts, accountIdentifier.Name())
+	}
+	if err := d.Set("managed_accounts", managedAccounts); err != nil {
+		return err
 	}
 
 	currentAccount := d.Get("accounts")
@@ -226,6 +272,34 @@ func UpdateShare(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	}
+	if d.HasChange("managed_accounts") {
+		o, n := d.GetChange("managed_accounts")
+		removed := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		added := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+		if len(removed) > 0 {
+			accountIdentifiers, err := managedAccountLocators(ctx, client, removed)
+			if err != nil {
+				return err
+			}
+			err = client.Shares.Alter(ctx, sdk.NewAccountObjectIdentifier(d.Id()), &sdk.AlterShareOptions{
+				Remove: &sdk.ShareRemove{
+					Accounts: accountIdentifiers,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error removing managed accounts from share (%v) err = %w", d.Id(), err)
+			}
+		}
+		if len(added) > 0 {
+			accountIdentifiers, err := managedAccountLocators(ctx, client, added)
+			if err != nil {
+				return err
+			}
+			if err := setShareAccounts(ctx, client, sdk.NewAccountObjectIdentifier(d.Id()), accountIdentifiers); err != nil {
+				return err
+			}
+		}
+	}
 	if d.HasChange("comment") {
 		comment := d.Get("comment").(string)
 		err := client.Shares.Alter(ctx, sdk.NewAccountObjectIdentifier(d.Id()), &sdk.AlterShareOptions{
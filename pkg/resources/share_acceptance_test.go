@@ -7,6 +7,7 @@ import (
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -69,6 +70,60 @@ func TestAcc_Share(t *testing.T) {
 	})
 }
 
+func TestAcc_Share_managedAccounts(t *testing.T) {
+	// TODO [SNOW-1011985]: unskip the tests
+	testenvs.SkipTestIfSet(t, testenvs.SkipManagedAccountTest, "error: 090337 (23001): Number of managed accounts allowed exceeded the limit. Please contact Snowflake support")
+
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	managedAccountName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	adminPass := fmt.Sprintf("A1%v", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: acc.CheckDestroy(t, resources.Share),
+		Steps: []resource.TestStep{
+			{
+				Config: shareConfigManagedAccount(name, managedAccountName, adminPass, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_share.test", "managed_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_share.test", "managed_accounts.*", managedAccountName),
+					resource.TestCheckResourceAttr("snowflake_share.test", "accounts.#", "0"),
+				),
+			},
+			{
+				Config: shareConfigManagedAccount(name, managedAccountName, adminPass, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_share.test", "managed_accounts.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_share.test", "accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func shareConfigManagedAccount(name string, managedAccountName string, adminPass string, withManagedAccount bool) string {
+	managedAccounts := "[]"
+	if withManagedAccount {
+		managedAccounts = "[snowflake_managed_account.test.name]"
+	}
+	return fmt.Sprintf(`
+resource "snowflake_managed_account" "test" {
+	name           = "%v"
+	admin_name     = "admin"
+	admin_password = "%v"
+}
+
+resource "snowflake_share" "test" {
+	name             = "%v"
+	managed_accounts = %v
+}
+`, managedAccountName, adminPass, name, managedAccounts)
+}
+
 func shareConfig(name string, comment string) string {
 	return fmt.Sprintf(`
 resource "snowflake_share" "test" {
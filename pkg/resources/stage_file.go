@@ -0,0 +1,330 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var stageFileSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which the stage is located.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which the stage is located.",
+	},
+	"stage": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the internal stage to which the file is uploaded.",
+	},
+	"path": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "",
+		DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+			return strings.Trim(old, "/") == strings.Trim(new, "/")
+		},
+		Description: "The directory inside the stage to which the file is uploaded (e.g. `code/python`). By default the file is uploaded to the root of the stage.",
+	},
+	"source": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "The path to the local file that is uploaded. The file name is kept in the stage.",
+	},
+	"auto_compress": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     false,
+		Description: "Specifies whether Snowflake uses gzip to compress the file during upload. Keep it disabled for files referenced in function and procedure `imports`.",
+	},
+	"content_hash": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The hash of the file content; a change uploads the file again. By default it is the SHA256 hash of the local file computed during plan. Set it explicitly (e.g. to `output_sha256` of an `archive_file`) when the file does not exist before apply.",
+	},
+	"file_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the file in the stage.",
+	},
+	"staged_path": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The location of the file in the stage (e.g. `@\"db\".\"schema\".\"stage\"/path/file.zip`), which can be used in function and procedure `imports`.",
+	},
+}
+
+// StageFile returns a pointer to the resource representing a file uploaded to an internal stage.
+func StageFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateStageFile,
+		ReadContext:   ReadStageFile,
+		UpdateContext: UpdateStageFile,
+		DeleteContext: DeleteStageFile,
+
+		Description: "Resource used to upload a local file to an internal stage with the [PUT](https://docs.snowflake.com/en/sql-reference/sql/put) command, e.g. code artifacts referenced in function and procedure `imports`.",
+
+		Schema:        stageFileSchema,
+		CustomizeDiff: stageFileCustomizeDiff,
+	}
+}
+
+// stageFileCustomizeDiff plans a new upload whenever the local file content changes and computes the staged file location upfront,
+// so that it can be referenced by functions and procedures in the same plan.
+func stageFileCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("source") {
+		return errors.Join(
+			d.SetNewComputed("content_hash"),
+			d.SetNewComputed("file_name"),
+			d.SetNewComputed("staged_path"),
+		)
+	}
+
+	source := d.Get("source").(string)
+	fileName := stageFileName(source, d.Get("auto_compress").(bool))
+	stagedPath := stageFileLocation(
+		sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string)),
+		d.Get("path").(string),
+		fileName,
+	).String()
+	if d.Get("file_name").(string) != fileName {
+		if err := d.SetNew("file_name", fileName); err != nil {
+			return err
+		}
+	}
+	if d.Get("staged_path").(string) != stagedPath {
+		if err := d.SetNew("staged_path", stagedPath); err != nil {
+			return err
+		}
+	}
+
+	if !d.GetRawConfig().GetAttr("content_hash").IsNull() {
+		return nil
+	}
+	hash, err := stageFileContentHash(source)
+	if err != nil {
+		// the file may be created by another resource during apply
+		if errors.Is(err, os.ErrNotExist) {
+			return d.SetNewComputed("content_hash")
+		}
+		return err
+	}
+	if d.Get("content_hash").(string) != hash {
+		return d.SetNew("content_hash", hash)
+	}
+	return nil
+}
+
+func stageFileContentHash(source string) (string, error) {
+	f, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// stageFileName returns the name under which PUT stores the file; AUTO_COMPRESS adds the .gz extension to files that are not compressed yet.
+func stageFileName(source string, autoCompress bool) string {
+	fileName := filepath.Base(source)
+	if autoCompress && !strings.HasSuffix(strings.ToLower(fileName), ".gz") {
+		fileName += ".gz"
+	}
+	return fileName
+}
+
+func stageFileLocation(stageId sdk.SchemaObjectIdentifier, directory string, fileName string) sdk.StageLocation {
+	return sdk.NewStageLocation(stageId, path.Join(strings.Trim(directory, "/"), fileName))
+}
+
+// stageFilePattern escapes regular expression metacharacters with character classes instead of backslashes,
+// which would have to be escaped again inside of a string literal.
+func stageFilePattern(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(`.+*?()|[]{}^$`, r) {
+			b.WriteString("[" + string(r) + "]")
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func stageFileIdFromData(d *schema.ResourceData) (sdk.SchemaObjectIdentifier, string, error) {
+	parts := strings.Split(d.Id(), helpers.IDDelimiter)
+	if len(parts) != 4 {
+		return sdk.SchemaObjectIdentifier{}, "", fmt.Errorf("unexpected stage file id format: %s, expected <database>|<schema>|<stage>|<path>", d.Id())
+	}
+	return sdk.NewSchemaObjectIdentifier(parts[0], parts[1], parts[2]), parts[3], nil
+}
+
+func putStageFile(ctx context.Context, client *sdk.Client, d *schema.ResourceData) (string, error) {
+	source, err := filepath.Abs(d.Get("source").(string))
+	if err != nil {
+		return "", err
+	}
+	stageId := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string))
+	location := sdk.NewStageLocation(stageId, d.Get("path").(string))
+
+	results, err := client.StageFiles.Put(ctx, filepath.ToSlash(source), location, &sdk.PutStageFileOptions{
+		AutoCompress: sdk.Bool(d.Get("auto_compress").(bool)),
+		Overwrite:    sdk.Bool(true),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(results) != 1 {
+		return "", fmt.Errorf("expected exactly one uploaded file for %s, got %d", source, len(results))
+	}
+	if status := strings.ToUpper(results[0].Status); status != "UPLOADED" && status != "SKIPPED" {
+		return "", fmt.Errorf("uploading %s to %s failed with status %s: %s", source, location.String(), results[0].Status, results[0].Message)
+	}
+
+	if _, ok := d.GetOk("content_hash"); !ok {
+		hash, err := stageFileContentHash(source)
+		if err != nil {
+			return "", err
+		}
+		if err := d.Set("content_hash", hash); err != nil {
+			return "", err
+		}
+	}
+	if err := d.Set("file_name", results[0].Target); err != nil {
+		return "", err
+	}
+	return path.Join(location.Path(), results[0].Target), nil
+}
+
+// CreateStageFile implements schema.CreateContextFunc.
+func CreateStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	stagePath, err := putStageFile(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string), stagePath))
+
+	return ReadStageFile(ctx, d, meta)
+}
+
+// ReadStageFile implements schema.ReadContextFunc.
+func ReadStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	stageId, stagePath, err := stageFileIdFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	location := sdk.NewStageLocation(stageId, stagePath)
+
+	files, err := client.StageFiles.List(ctx, location, nil)
+	if err != nil {
+		if _, showErr := client.Stages.ShowByID(ctx, stageId); showErr != nil {
+			log.Printf("[DEBUG] stage (%s) not found, removing stage file %s from state", stageId.FullyQualifiedName(), d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	// LIST matches by prefix, so the exact file has to be looked up
+	var file *sdk.StageFile
+	for i := range files {
+		if strings.HasSuffix(files[i].Name, "/"+stagePath) {
+			file = &files[i]
+			break
+		}
+	}
+	if file == nil {
+		log.Printf("[DEBUG] stage file (%s) not found, removing from state", location.String())
+		d.SetId("")
+		return nil
+	}
+
+	directory, fileName := path.Split(stagePath)
+	if err := d.Set("database", stageId.DatabaseName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schema", stageId.SchemaName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("stage", stageId.Name()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("path", strings.TrimSuffix(directory, "/")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("file_name", fileName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("staged_path", location.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// UpdateStageFile implements schema.UpdateContextFunc.
+func UpdateStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if d.HasChange("content_hash") {
+		if _, err := putStageFile(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadStageFile(ctx, d, meta)
+}
+
+// DeleteStageFile implements schema.DeleteContextFunc.
+func DeleteStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	stageId, stagePath, err := stageFileIdFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// REMOVE matches by prefix, so the pattern is needed to leave files like <name>.bak untouched
+	err = client.StageFiles.Remove(ctx, sdk.NewStageLocation(stageId, stagePath), &sdk.RemoveStageFilesOptions{
+		Pattern: sdk.String(".*" + stageFilePattern("/"+stagePath)),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,86 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_StageFile(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	stageId := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name)
+	source := filepath.Join(t.TempDir(), "handler.py")
+	writeSource := func(content string) func() {
+		return func() {
+			require.NoError(t, os.WriteFile(source, []byte(content), 0o600))
+		}
+	}
+	writeSource("def addone(i):\n  return i+1\n")()
+
+	var firstHash string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Stage),
+		Steps: []resource.TestStep{
+			{
+				Config: stageFileConfig(name, acc.TestDatabaseName, acc.TestSchemaName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_stage_file.test", "file_name", "handler.py"),
+					resource.TestCheckResourceAttr("snowflake_stage_file.test", "path", "code/python"),
+					resource.TestCheckResourceAttr("snowflake_stage_file.test", "staged_path", fmt.Sprintf("@%s/code/python/handler.py", stageId.FullyQualifiedName())),
+					resource.TestCheckResourceAttrWith("snowflake_stage_file.test", "content_hash", func(value string) error {
+						firstHash = value
+						return nil
+					}),
+				),
+			},
+			// change of the local file content uploads it again
+			{
+				PreConfig: writeSource("def addone(i):\n  return i+2\n"),
+				Config:    stageFileConfig(name, acc.TestDatabaseName, acc.TestSchemaName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_stage_file.test", "file_name", "handler.py"),
+					resource.TestCheckResourceAttrWith("snowflake_stage_file.test", "content_hash", func(value string) error {
+						if value == firstHash {
+							return fmt.Errorf("expected content hash to change from %s", firstHash)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func stageFileConfig(name string, databaseName string, schemaName string, source string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage" "test" {
+  name     = "%[1]s"
+  database = "%[2]s"
+  schema   = "%[3]s"
+}
+
+resource "snowflake_stage_file" "test" {
+  database = snowflake_stage.test.database
+  schema   = snowflake_stage.test.schema
+  stage    = snowflake_stage.test.name
+  path     = "code/python"
+  source   = "%[4]s"
+}
+`, name, databaseName, schemaName, filepath.ToSlash(source))
+}
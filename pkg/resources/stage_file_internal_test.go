@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_stageFileName(t *testing.T) {
+	assert.Equal(t, "handler.zip", stageFileName("/tmp/build/handler.zip", false))
+	assert.Equal(t, "handler.zip.gz", stageFileName("/tmp/build/handler.zip", true))
+	assert.Equal(t, "data.csv.gz", stageFileName("data.csv.gz", true))
+}
+
+func Test_stageFileLocation(t *testing.T) {
+	stageId := sdk.NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	assert.Equal(t, `@"db"."schema"."stage"/handler.zip`, stageFileLocation(stageId, "", "handler.zip").String())
+	assert.Equal(t, `@"db"."schema"."stage"/code/python/handler.zip`, stageFileLocation(stageId, "/code/python/", "handler.zip").String())
+}
+
+func Test_stageFilePattern(t *testing.T) {
+	pattern := regexp.MustCompile("^.*" + stageFilePattern("/code/handler.py") + "$")
+
+	assert.Equal(t, "/code/handler[.]py", stageFilePattern("/code/handler.py"))
+	assert.True(t, pattern.MatchString("stage/code/handler.py"))
+	assert.False(t, pattern.MatchString("stage/code/handler.py.bak"))
+	assert.False(t, pattern.MatchString("stage/code/handlerxpy"))
+}
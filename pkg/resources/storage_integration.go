@@ -114,6 +114,8 @@ func StorageIntegration() *schema.Resource {
 		Update: UpdateStorageIntegration,
 		Delete: DeleteStorageIntegration,
 
+		DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_storage_integration_aws, snowflake_storage_integration_gcs or snowflake_storage_integration_azure instead.",
+
 		Schema: storageIntegrationSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
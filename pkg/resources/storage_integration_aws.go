@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var storageIntegrationAwsSchema = func() map[string]*schema.Schema {
+	s := storageIntegrationCommonSchema()
+	s["storage_aws_role_arn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the Amazon Resource Name (ARN) of the AWS IAM role that grants privileges on the S3 bucket containing your data files.",
+	}
+	s["storage_aws_object_acl"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"bucket-owner-full-control"}, false),
+		Description:  "Enables support for AWS access control lists (ACLs) to grant the bucket owner full control. The only supported value is `bucket-owner-full-control`.",
+	}
+	s["storage_aws_iam_user_arn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ARN of the Snowflake IAM user that assumes the IAM role, to be added to the trust policy of the role. It does not change until the integration is recreated.",
+	}
+	s["storage_aws_external_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The external ID that Snowflake uses when assuming the IAM role, to be added to the trust policy of the role. It does not change until the integration is recreated.",
+	}
+	return storageIntegrationUsePrivatelinkEndpointSchema(s)
+}()
+
+var storageIntegrationAwsProperties = map[string]string{
+	"STORAGE_AWS_ROLE_ARN":     "storage_aws_role_arn",
+	"STORAGE_AWS_OBJECT_ACL":   "storage_aws_object_acl",
+	"STORAGE_AWS_IAM_USER_ARN": "storage_aws_iam_user_arn",
+	"STORAGE_AWS_EXTERNAL_ID":  "storage_aws_external_id",
+	"USE_PRIVATELINK_ENDPOINT": "use_privatelink_endpoint",
+}
+
+// StorageIntegrationAws returns a pointer to the resource representing a storage integration for Amazon S3.
+func StorageIntegrationAws() *schema.Resource {
+	read := readStorageIntegrationForProvider([]string{"S3", "S3GOV", "S3CHINA"}, storageIntegrationAwsProperties)
+	return &schema.Resource{
+		CreateContext: createStorageIntegrationForProvider(read, func(d *schema.ResourceData, request *sdk.CreateStorageIntegrationRequest) {
+			params := sdk.NewS3StorageParamsRequest(d.Get("storage_aws_role_arn").(string)).
+				WithUsePrivatelinkEndpoint(sdk.Bool(d.Get("use_privatelink_endpoint").(bool)))
+			if v, ok := d.GetOk("storage_aws_object_acl"); ok {
+				params.WithStorageAwsObjectAcl(sdk.String(v.(string)))
+			}
+			request.WithS3StorageProviderParams(params)
+		}),
+		ReadContext: read,
+		UpdateContext: updateStorageIntegrationForProvider(read, func(d *schema.ResourceData, set *sdk.StorageIntegrationSetRequest, unset *sdk.StorageIntegrationUnsetRequest) (bool, bool) {
+			var runSet, runUnset bool
+			// the role is required whenever any of the S3 parameters is set
+			params := sdk.NewSetS3StorageParamsRequest(d.Get("storage_aws_role_arn").(string))
+			if d.HasChange("storage_aws_role_arn") {
+				runSet = true
+			}
+			if d.HasChange("storage_aws_object_acl") {
+				if v, ok := d.GetOk("storage_aws_object_acl"); ok {
+					runSet = true
+					params.WithStorageAwsObjectAcl(sdk.String(v.(string)))
+				} else {
+					runUnset = true
+					unset.WithStorageAwsObjectAcl(sdk.Bool(true))
+				}
+			}
+			if d.HasChange("use_privatelink_endpoint") {
+				runSet = true
+				params.WithUsePrivatelinkEndpoint(sdk.Bool(d.Get("use_privatelink_endpoint").(bool)))
+			}
+			if runSet {
+				set.WithS3Params(params)
+			}
+			return runSet, runUnset
+		}),
+		DeleteContext: deleteStorageIntegrationForProvider,
+
+		Description: "Resource used to manage storage integrations for Amazon S3 external stages. For more information, check [storage integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-storage-integration).",
+
+		Schema: storageIntegrationAwsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StorageIntegrationAws(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_storage_integration_aws.test"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":              config.StringVariable(name),
+			"allowed_locations": config.ListVariable(config.StringVariable("s3://foo/")),
+			"aws_role_arn":      config.StringVariable("arn:aws:iam::000000000001:/role/test"),
+		}
+	}
+	variableSet2 := m()
+	variableSet2["comment"] = config.StringVariable("some comment")
+	variableSet2["allowed_locations"] = config.ListVariable(config.StringVariable("s3://foo/"), config.StringVariable("s3://bar/"))
+	variableSet2["blocked_locations"] = config.ListVariable(config.StringVariable("s3://foo/blocked/"))
+	variableSet2["aws_role_arn"] = config.StringVariable("arn:aws:iam::000000000001:/role/other")
+	variableSet2["aws_object_acl"] = config.StringVariable("bucket-owner-full-control")
+
+	var externalId, iamUserArn string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StorageIntegrationAws),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_StorageIntegrationAws/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_allowed_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_allowed_locations.0", "s3://foo/"),
+					resource.TestCheckResourceAttr(resourceName, "storage_blocked_locations.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_aws_role_arn", "arn:aws:iam::000000000001:/role/test"),
+					resource.TestCheckResourceAttr(resourceName, "storage_aws_object_acl", ""),
+					resource.TestCheckResourceAttr(resourceName, "use_privatelink_endpoint", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_aws_iam_user_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_aws_external_id"),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources[resourceName].Primary.Attributes
+						externalId, iamUserArn = attributes["storage_aws_external_id"], attributes["storage_aws_iam_user_arn"]
+						return nil
+					},
+				),
+			},
+			// change the role and set the optional attributes in place, keeping the trust outputs
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_StorageIntegrationAws/basic"),
+				ConfigVariables: variableSet2,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "storage_allowed_locations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_blocked_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_blocked_locations.0", "s3://foo/blocked/"),
+					resource.TestCheckResourceAttr(resourceName, "storage_aws_role_arn", "arn:aws:iam::000000000001:/role/other"),
+					resource.TestCheckResourceAttr(resourceName, "storage_aws_object_acl", "bucket-owner-full-control"),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources[resourceName].Primary.Attributes
+						if attributes["storage_aws_external_id"] != externalId || attributes["storage_aws_iam_user_arn"] != iamUserArn {
+							return fmt.Errorf("expected the trust outputs to stay the same after an update")
+						}
+						return nil
+					},
+				),
+			},
+			// unset the optional attributes
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_StorageIntegrationAws/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "storage_blocked_locations.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_aws_object_acl", ""),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_StorageIntegrationAws/basic"),
+				ConfigVariables:   m(),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var storageIntegrationAzureSchema = func() map[string]*schema.Schema {
+	s := storageIntegrationCommonSchema()
+	s["azure_tenant_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the ID of the Azure Active Directory tenant used for identity management of the storage accounts.",
+	}
+	s["azure_consent_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the Microsoft permissions request page, to be visited to grant Snowflake access to the storage accounts.",
+	}
+	s["azure_multi_tenant_app_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the Snowflake client application created for your account. It does not change until the integration is recreated.",
+	}
+	return storageIntegrationUsePrivatelinkEndpointSchema(s)
+}()
+
+var storageIntegrationAzureProperties = map[string]string{
+	"AZURE_TENANT_ID":             "azure_tenant_id",
+	"AZURE_CONSENT_URL":           "azure_consent_url",
+	"AZURE_MULTI_TENANT_APP_NAME": "azure_multi_tenant_app_name",
+	"USE_PRIVATELINK_ENDPOINT":    "use_privatelink_endpoint",
+}
+
+// StorageIntegrationAzure returns a pointer to the resource representing a storage integration for Microsoft Azure.
+func StorageIntegrationAzure() *schema.Resource {
+	read := readStorageIntegrationForProvider([]string{"AZURE"}, storageIntegrationAzureProperties)
+	return &schema.Resource{
+		CreateContext: createStorageIntegrationForProvider(read, func(d *schema.ResourceData, request *sdk.CreateStorageIntegrationRequest) {
+			request.WithAzureStorageProviderParams(
+				sdk.NewAzureStorageParamsRequest(sdk.String(d.Get("azure_tenant_id").(string))).
+					WithUsePrivatelinkEndpoint(sdk.Bool(d.Get("use_privatelink_endpoint").(bool))),
+			)
+		}),
+		ReadContext: read,
+		UpdateContext: updateStorageIntegrationForProvider(read, func(d *schema.ResourceData, set *sdk.StorageIntegrationSetRequest, _ *sdk.StorageIntegrationUnsetRequest) (bool, bool) {
+			if !d.HasChanges("azure_tenant_id", "use_privatelink_endpoint") {
+				return false, false
+			}
+			// the tenant is required whenever any of the Azure parameters is set
+			params := sdk.NewSetAzureStorageParamsRequest(d.Get("azure_tenant_id").(string))
+			if d.HasChange("use_privatelink_endpoint") {
+				params.WithUsePrivatelinkEndpoint(sdk.Bool(d.Get("use_privatelink_endpoint").(bool)))
+			}
+			set.WithAzureParams(params)
+			return true, false
+		}),
+		DeleteContext: deleteStorageIntegrationForProvider,
+
+		Description: "Resource used to manage storage integrations for Microsoft Azure external stages. For more information, check [storage integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-storage-integration).",
+
+		Schema: storageIntegrationAzureSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package resources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_StorageIntegrationAzure(t *testing.T) {
+	azureBucketUrl := testenvs.GetOrSkipTest(t, testenvs.AzureExternalBucketUrl)
+
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_storage_integration_azure.test"
+	azureTenantId, err := uuid.GenerateUUID()
+	require.NoError(t, err)
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":              config.StringVariable(name),
+			"allowed_locations": config.ListVariable(config.StringVariable(azureBucketUrl + "/foo")),
+			"azure_tenant_id":   config.StringVariable(azureTenantId),
+		}
+	}
+	variableSet2 := m()
+	variableSet2["comment"] = config.StringVariable("some comment")
+	variableSet2["blocked_locations"] = config.ListVariable(config.StringVariable(azureBucketUrl + "/foo/blocked"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StorageIntegrationAzure),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_StorageIntegrationAzure/basic"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "azure_tenant_id", azureTenantId),
+					resource.TestCheckResourceAttr(resourceName, "use_privatelink_endpoint", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "azure_consent_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "azure_multi_tenant_app_name"),
+				),
+			},
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_StorageIntegrationAzure/basic"),
+				ConfigVariables: variableSet2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "storage_blocked_locations.#", "1"),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_StorageIntegrationAzure/basic"),
+				ConfigVariables:   variableSet2,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,238 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// storageIntegrationCommonSchema returns attributes shared by all the per-cloud storage integration resources.
+func storageIntegrationCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Specifies the identifier (i.e. name) for the storage integration. This value must be unique in your account.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Specifies whether the storage integration can be used in stages.",
+		},
+		"storage_allowed_locations": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Required:    true,
+			MinItems:    1,
+			Description: "Explicitly limits external stages that use the integration to reference one or more storage locations.",
+		},
+		"storage_blocked_locations": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Explicitly prohibits external stages that use the integration from referencing one or more storage locations.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies a comment for the storage integration.",
+		},
+		"created_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time when the storage integration was created.",
+		},
+	}
+}
+
+func storageIntegrationUsePrivatelinkEndpointSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["use_privatelink_endpoint"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to use outbound private connectivity to harden the security posture.",
+	}
+	return s
+}
+
+func toStorageLocations(v any) []sdk.StorageLocation {
+	paths := expandStringList(v.([]any))
+	locations := make([]sdk.StorageLocation, len(paths))
+	for i, path := range paths {
+		locations[i] = sdk.StorageLocation{Path: path}
+	}
+	return locations
+}
+
+func createStorageIntegrationForProvider(read schema.ReadContextFunc, withProviderParams func(d *schema.ResourceData, request *sdk.CreateStorageIntegrationRequest)) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+		request := sdk.NewCreateStorageIntegrationRequest(id, d.Get("enabled").(bool), toStorageLocations(d.Get("storage_allowed_locations")))
+		if v, ok := d.GetOk("storage_blocked_locations"); ok {
+			request.WithStorageBlockedLocations(toStorageLocations(v))
+		}
+		if v, ok := d.GetOk("comment"); ok {
+			request.WithComment(sdk.String(v.(string)))
+		}
+		withProviderParams(d, request)
+
+		if err := client.StorageIntegrations.Create(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating storage integration %v err = %w", id.Name(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(id))
+
+		return read(ctx, d, meta)
+	}
+}
+
+// readStorageIntegrationForProvider returns a read function setting the common attributes and the provider specific
+// attributes from DESCRIBE INTEGRATION; properties maps the property names to the attribute names.
+func readStorageIntegrationForProvider(storageProviders []string, properties map[string]string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+		integration, err := client.StorageIntegrations.ShowByID(ctx, id)
+		if err != nil {
+			log.Printf("[DEBUG] storage integration (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		if c := integration.Category; c != "STORAGE" {
+			return diag.FromErr(fmt.Errorf("expected %v to be a STORAGE integration, got %v", id, c))
+		}
+		if err := d.Set("name", integration.Name); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("enabled", integration.Enabled); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("comment", integration.Comment); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		integrationProperties, err := client.StorageIntegrations.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("could not describe storage integration: %w", err))
+		}
+		for _, property := range integrationProperties {
+			switch property.Name {
+			case "STORAGE_PROVIDER":
+				if !slices.Contains(storageProviders, property.Value) {
+					return diag.FromErr(fmt.Errorf("expected %v to be a storage integration with one of %v providers, got %v", id, storageProviders, property.Value))
+				}
+			case "STORAGE_ALLOWED_LOCATIONS":
+				if err := d.Set("storage_allowed_locations", strings.Split(property.Value, ",")); err != nil {
+					return diag.FromErr(err)
+				}
+			case "STORAGE_BLOCKED_LOCATIONS":
+				var blockedLocations []string
+				if property.Value != "" {
+					blockedLocations = strings.Split(property.Value, ",")
+				}
+				if err := d.Set("storage_blocked_locations", blockedLocations); err != nil {
+					return diag.FromErr(err)
+				}
+			default:
+				attribute, ok := properties[property.Name]
+				if !ok {
+					continue
+				}
+				var value any = property.Value
+				if property.Type == "Boolean" {
+					if value, err = strconv.ParseBool(property.Value); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+				if err := d.Set(attribute, value); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// updateStorageIntegrationForProvider returns an update function altering the common attributes and the provider
+// specific ones set by withProviderParams, which reports whether it filled the set or the unset request.
+func updateStorageIntegrationForProvider(read schema.ReadContextFunc, withProviderParams func(d *schema.ResourceData, set *sdk.StorageIntegrationSetRequest, unset *sdk.StorageIntegrationUnsetRequest) (runSet bool, runUnset bool)) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+		var runSetStatement, runUnsetStatement bool
+		set, unset := sdk.NewStorageIntegrationSetRequest(), sdk.NewStorageIntegrationUnsetRequest()
+		if d.HasChange("enabled") {
+			runSetStatement = true
+			set.WithEnabled(d.Get("enabled").(bool))
+		}
+		if d.HasChange("storage_allowed_locations") {
+			runSetStatement = true
+			set.WithStorageAllowedLocations(toStorageLocations(d.Get("storage_allowed_locations")))
+		}
+		// Snowflake does not accept an empty list, so removing all the blocked locations needs an UNSET
+		if d.HasChange("storage_blocked_locations") {
+			if v := d.Get("storage_blocked_locations").([]any); len(v) > 0 {
+				runSetStatement = true
+				set.WithStorageBlockedLocations(toStorageLocations(v))
+			} else {
+				runUnsetStatement = true
+				unset.WithStorageBlockedLocations(sdk.Bool(true))
+			}
+		}
+		if d.HasChange("comment") {
+			if v := d.Get("comment").(string); v != "" {
+				runSetStatement = true
+				set.WithComment(sdk.String(v))
+			} else {
+				runUnsetStatement = true
+				unset.WithComment(sdk.Bool(true))
+			}
+		}
+		if withProviderParams != nil {
+			runSet, runUnset := withProviderParams(d, set, unset)
+			runSetStatement = runSetStatement || runSet
+			runUnsetStatement = runUnsetStatement || runUnset
+		}
+
+		if runSetStatement {
+			if err := client.StorageIntegrations.Alter(ctx, sdk.NewAlterStorageIntegrationRequest(id).WithSet(set)); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating storage integration %v err = %w", id.Name(), err))
+			}
+		}
+		if runUnsetStatement {
+			if err := client.StorageIntegrations.Alter(ctx, sdk.NewAlterStorageIntegrationRequest(id).WithUnset(unset)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting storage integration %v properties err = %w", id.Name(), err))
+			}
+		}
+
+		return read(ctx, d, meta)
+	}
+}
+
+func deleteStorageIntegrationForProvider(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.StorageIntegrations.Drop(ctx, sdk.NewDropStorageIntegrationRequest(id)); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting storage integration %v err = %w", id.Name(), err))
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var storageIntegrationGcsSchema = func() map[string]*schema.Schema {
+	s := storageIntegrationCommonSchema()
+	s["storage_gcp_service_account"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The Snowflake service account to be granted access to the buckets. It does not change until the integration is recreated.",
+	}
+	return s
+}()
+
+var storageIntegrationGcsProperties = map[string]string{
+	"STORAGE_GCP_SERVICE_ACCOUNT": "storage_gcp_service_account",
+}
+
+// StorageIntegrationGcs returns a pointer to the resource representing a storage integration for Google Cloud Storage.
+func StorageIntegrationGcs() *schema.Resource {
+	read := readStorageIntegrationForProvider([]string{"GCS"}, storageIntegrationGcsProperties)
+	return &schema.Resource{
+		CreateContext: createStorageIntegrationForProvider(read, func(d *schema.ResourceData, request *sdk.CreateStorageIntegrationRequest) {
+			request.WithGCSStorageProviderParams(sdk.NewGCSStorageParamsRequest())
+		}),
+		ReadContext:   read,
+		UpdateContext: updateStorageIntegrationForProvider(read, nil),
+		DeleteContext: deleteStorageIntegrationForProvider,
+
+		Description: "Resource used to manage storage integrations for Google Cloud Storage external stages. For more information, check [storage integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-storage-integration).",
+
+		Schema: storageIntegrationGcsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
@@ -43,6 +45,19 @@ var tagSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "List of allowed values for the tag.",
 	},
+	"propagate": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      fmt.Sprintf("Specifies that the tag should be automatically propagated from source objects to target objects. Valid values are (case-insensitive): %v.", sdk.AsStringList(sdk.AllTagPropagations)),
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(sdk.AsStringList(sdk.AllTagPropagations), true)),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+	},
+	"propagation_on_conflict": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Specifies the value of the propagated tag when the values propagated from different sources conflict. Either `ALLOWED_VALUES_SEQUENCE` (the first value from the `allowed_values` list is used) or a string used as the tag value.",
+		RequiredWith: []string{"propagate"},
+	},
 }
 
 var tagReferenceSchema = &schema.Schema{
@@ -106,6 +121,12 @@ func CreateContextTag(ctx context.Context, d *schema.ResourceData, meta any) dia
 	if v, ok := d.GetOk("allowed_values"); ok {
 		request.WithAllowedValues(expandStringListAllowEmpty(v.([]any)))
 	}
+	if v, ok := d.GetOk("propagate"); ok {
+		request.WithPropagate(sdk.Pointer(sdk.TagPropagation(strings.ToUpper(v.(string)))))
+		if v, ok := d.GetOk("propagation_on_conflict"); ok {
+			request.WithOnConflict(tagPropagationOnConflict(v.(string)))
+		}
+	}
 	if err := client.Tags.Create(ctx, request); err != nil {
 		return diag.FromErr(err)
 	}
@@ -137,6 +158,20 @@ func ReadContextTag(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	if err := d.Set("allowed_values", tag.AllowedValues); err != nil {
 		return diag.FromErr(err)
 	}
+	var propagate string
+	if tag.Propagate != nil {
+		propagate = string(*tag.Propagate)
+	}
+	if err := d.Set("propagate", propagate); err != nil {
+		return diag.FromErr(err)
+	}
+	var onConflict string
+	if tag.OnConflict != nil {
+		onConflict = *tag.OnConflict
+	}
+	if err := d.Set("propagation_on_conflict", onConflict); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
 
@@ -181,12 +216,43 @@ func UpdateContextTag(ctx context.Context, d *schema.ResourceData, meta any) dia
 			}
 		}
 
-		if len(allowedValuesToRemove) > 0 {
+		// dropping all the values would leave an empty list, which does not accept any value, so the list is unset instead
+		switch {
+		case len(newAllowedValues) == 0 && len(oldAllowedValues) > 0:
+			unset := sdk.NewTagUnsetRequest().WithAllowedValues(true)
+			if err := client.Tags.Alter(ctx, sdk.NewAlterTagRequest(id).WithUnset(unset)); err != nil {
+				return diag.FromErr(err)
+			}
+		case len(allowedValuesToRemove) > 0:
 			if err := client.Tags.Alter(ctx, sdk.NewAlterTagRequest(id).WithDrop(allowedValuesToRemove)); err != nil {
 				return diag.FromErr(err)
 			}
 		}
 	}
+	if d.HasChanges("propagate", "propagation_on_conflict") {
+		propagate := d.Get("propagate").(string)
+		onConflict := d.Get("propagation_on_conflict").(string)
+		if propagate != "" {
+			set := sdk.NewTagSetRequest().WithPropagate(sdk.TagPropagation(strings.ToUpper(propagate)))
+			if onConflict != "" {
+				set.WithOnConflict(tagPropagationOnConflict(onConflict))
+			}
+			if err := client.Tags.Alter(ctx, sdk.NewAlterTagRequest(id).WithSet(set)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			unset := sdk.NewTagUnsetRequest().WithPropagate(true)
+			if err := client.Tags.Alter(ctx, sdk.NewAlterTagRequest(id).WithUnset(unset)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if o, _ := d.GetChange("propagation_on_conflict"); onConflict == "" && o.(string) != "" {
+			unset := sdk.NewTagUnsetRequest().WithOnConflict(true)
+			if err := client.Tags.Alter(ctx, sdk.NewAlterTagRequest(id).WithUnset(unset)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
 	return ReadContextTag(ctx, d, meta)
 }
 
@@ -200,6 +266,14 @@ func DeleteContextTag(ctx context.Context, d *schema.ResourceData, meta any) dia
 	return nil
 }
 
+// tagPropagationOnConflict maps ALLOWED_VALUES_SEQUENCE to the keyword; any other value is used as the tag value.
+func tagPropagationOnConflict(value string) *sdk.TagPropagationOnConflict {
+	if strings.EqualFold(value, "ALLOWED_VALUES_SEQUENCE") {
+		return &sdk.TagPropagationOnConflict{AllowedValuesSequence: sdk.Bool(true)}
+	}
+	return &sdk.TagPropagationOnConflict{Value: sdk.String(value)}
+}
+
 // Returns the slice of strings for inputed allowed values.
 func expandAllowedValues(avChangeSet any) []string {
 	avList := avChangeSet.([]any)
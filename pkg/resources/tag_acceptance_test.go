@@ -86,3 +86,57 @@ func TestAcc_Tag(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_Tag_propagate(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_tag.t"
+	m := func(allowedValues []config.Variable, propagate string, onConflict string) map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":                    config.StringVariable(name),
+			"database":                config.StringVariable(acc.TestDatabaseName),
+			"schema":                  config.StringVariable(acc.TestSchemaName),
+			"allowed_values":          config.ListVariable(allowedValues...),
+			"propagate":               config.StringVariable(propagate),
+			"propagation_on_conflict": config.StringVariable(onConflict),
+		}
+	}
+	allowedValues := []config.Variable{config.StringVariable("alv1"), config.StringVariable("alv2")}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Tag),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Tag/propagate"),
+				ConfigVariables: m(allowedValues, "ON_DEPENDENCY", "ALLOWED_VALUES_SEQUENCE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "propagate", "ON_DEPENDENCY"),
+					resource.TestCheckResourceAttr(resourceName, "propagation_on_conflict", "ALLOWED_VALUES_SEQUENCE"),
+				),
+			},
+			// change propagation
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Tag/propagate"),
+				ConfigVariables: m(allowedValues, "ON_DEPENDENCY_AND_DATA_MOVEMENT", "alv2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "propagate", "ON_DEPENDENCY_AND_DATA_MOVEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "propagation_on_conflict", "alv2"),
+				),
+			},
+			// unset propagation and remove all the allowed values
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Tag/propagate"),
+				ConfigVariables: m([]config.Variable{}, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_values.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "propagate", ""),
+					resource.TestCheckResourceAttr(resourceName, "propagation_on_conflict", ""),
+				),
+			},
+		},
+	})
+}
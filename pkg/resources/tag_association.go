@@ -72,7 +72,6 @@ var tagAssociationSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "Specifies the value of the tag, (e.g. 'finance' or 'engineering')",
-		ForceNew:    true,
 	},
 	"skip_validation": {
 		Type:        schema.TypeBool,
@@ -189,20 +188,50 @@ func CreateContextTagAssociation(ctx context.Context, d *schema.ResourceData, me
 }
 
 func ReadContextTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	diags := diag.Diagnostics{}
 	client := meta.(*provider.Context).Client
+	tagValue := d.Get("tag_value").(string)
 
 	tid, ids, ot := TagIdentifierAndObjectIdentifier(d)
 	for _, oid := range ids {
-		tagValue, err := client.SystemFunctions.GetTag(ctx, tid, oid, ot)
+		objectTagValue, err := readDirectTagValue(ctx, client, tid, oid, ot)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		if err := d.Set("tag_value", tagValue); err != nil {
-			return diag.FromErr(err)
+		// any object with a missing or different value makes the whole association drift
+		if objectTagValue != tagValue {
+			log.Printf("[DEBUG] tag %s on %s %s has value %q, expected %q", tid.FullyQualifiedName(), ot, oid.FullyQualifiedName(), objectTagValue, tagValue)
+			tagValue = objectTagValue
+			break
+		}
+	}
+	if err := d.Set("tag_value", tagValue); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// readDirectTagValue returns the value of the tag set directly on the object, or an empty string when the tag is not set
+// or is only inherited from a parent object (e.g. from the table of a column).
+func readDirectTagValue(ctx context.Context, client *sdk.Client, tid sdk.SchemaObjectIdentifier, oid sdk.ObjectIdentifier, ot sdk.ObjectType) (string, error) {
+	tagValue, err := client.SystemFunctions.GetTag(ctx, tid, oid, ot)
+	if err != nil {
+		return "", err
+	}
+	if tagValue == "" {
+		return "", nil
+	}
+
+	references, err := client.TagReferences.GetForEntity(ctx, sdk.NewGetForEntityTagReferenceRequest(oid, sdk.TagReferenceObjectDomainFromObjectType(ot)))
+	if err != nil {
+		log.Printf("[DEBUG] could not get tag references of %s %s, assuming the tag is set directly: %v", ot, oid.FullyQualifiedName(), err)
+		return tagValue, nil
+	}
+	for _, reference := range references {
+		if reference.TagId().FullyQualifiedName() == tid.FullyQualifiedName() && !reference.IsInherited() {
+			return reference.TagValue, nil
 		}
 	}
-	return diags
+	return "", nil
 }
 
 func UpdateContextTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
@@ -114,6 +115,52 @@ func TestAcc_TagAssociationColumn(t *testing.T) {
 	})
 }
 
+func TestAcc_TagAssociationColumn_inheritedTag(t *testing.T) {
+	tagName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_tag_association.column"
+	m := func(columnTagValue string) map[string]config.Variable {
+		return map[string]config.Variable{
+			"tag_name":         config.StringVariable(tagName),
+			"table_name":       config.StringVariable(tableName),
+			"database":         config.StringVariable(acc.TestDatabaseName),
+			"schema":           config.StringVariable(acc.TestSchemaName),
+			"column_tag_value": config.StringVariable(columnTagValue),
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_TagAssociation/inherited"),
+				ConfigVariables: m("COLUMN_VALUE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag_value", "COLUMN_VALUE"),
+					resource.TestCheckResourceAttr("snowflake_tag_association.table", "tag_value", "TABLE_VALUE"),
+				),
+			},
+			// the tag value is updated in place
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_TagAssociation/inherited"),
+				ConfigVariables: m("UPDATED_COLUMN_VALUE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag_value", "UPDATED_COLUMN_VALUE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_TagAssociationIssue1202(t *testing.T) {
 	tagName := "tag-" + strings.ToUpper(acctest.RandStringFromCharSet(4, acctest.CharSetAlpha))
 	tableName := "table-" + strings.ToUpper(acctest.RandStringFromCharSet(4, acctest.CharSetAlpha))
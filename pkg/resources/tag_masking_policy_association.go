@@ -2,8 +2,6 @@ package resources
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -14,7 +12,6 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 )
 
 const (
@@ -105,7 +102,6 @@ func CreateContextTagMaskingPolicyAssociation(ctx context.Context, d *schema.Res
 		MaskingPolicySchemaName:   mid.SchemaName(),
 		MaskingPolicyName:         mid.Name(),
 	}
-	fmt.Printf("attachment id: %s\n", aid.String())
 	d.SetId(aid.String())
 	return ReadContextTagMaskingPolicyAssociation(ctx, d, meta)
 }
@@ -113,7 +109,6 @@ func CreateContextTagMaskingPolicyAssociation(ctx context.Context, d *schema.Res
 func ReadContextTagMaskingPolicyAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 	client := meta.(*provider.Context).Client
-	db := client.GetConn().DB
 	aid, err := parseAttachmentID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -143,19 +138,27 @@ func ReadContextTagMaskingPolicyAssociation(ctx context.Context, d *schema.Resou
 	// show attached masking policy
 	tid := sdk.NewSchemaObjectIdentifier(aid.TagDatabaseName, aid.TagSchemaName, aid.TagName)
 	mid := sdk.NewSchemaObjectIdentifier(aid.MaskingPolicyDatabaseName, aid.MaskingPolicySchemaName, aid.MaskingPolicyName)
-	builder := snowflake.NewTagBuilder(tid).WithMaskingPolicy(mid)
-	row := snowflake.QueryRow(db, builder.ShowAttachedPolicy())
-	t, err := snowflake.ScanTagPolicy(row)
-	if errors.Is(err, sql.ErrNoRows) {
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(tid, sdk.PolicyEntityDomainTag))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var policyReference *sdk.PolicyReference
+	for _, reference := range policyReferences {
+		if reference.PolicyKind == "MASKING_POLICY" &&
+			reference.PolicyDb != nil && *reference.PolicyDb == mid.DatabaseName() &&
+			reference.PolicySchema != nil && *reference.PolicySchema == mid.SchemaName() &&
+			reference.PolicyName == mid.Name() {
+			policyReference = &reference
+			break
+		}
+	}
+	if policyReference == nil {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Printf("[DEBUG] attached policy (%s) not found", d.Id())
 		d.SetId("")
 		return nil
 	}
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	id := helpers.EncodeSnowflakeID(t.PolicyDB.String, t.PolicySchema.String, t.PolicyName.String)
+	id := helpers.EncodeSnowflakeID(*policyReference.PolicyDb, *policyReference.PolicySchema, policyReference.PolicyName)
 	if err := d.Set("masking_policy_id", id); err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,7 @@
+resource "snowflake_api_integration" "test_git_int" {
+  name                 = var.name
+  api_provider         = "git_https_api"
+  api_allowed_prefixes = var.api_allowed_prefixes
+  comment              = var.comment
+  enabled              = var.enabled
+}
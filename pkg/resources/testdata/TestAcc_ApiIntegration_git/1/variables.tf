@@ -0,0 +1,15 @@
+variable "name" {
+  type = string
+}
+
+variable "api_allowed_prefixes" {
+  type = list(string)
+}
+
+variable "comment" {
+  type = string
+}
+
+variable "enabled" {
+  type = bool
+}
@@ -0,0 +1,31 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "warehouse" {
+  type = string
+}
+
+variable "query" {
+  type = string
+}
+
+variable "table_name" {
+  type = string
+}
+
+variable "cluster_by" {
+  type = list(string)
+}
+
+variable "suspended" {
+  type = bool
+}
@@ -0,0 +1,8 @@
+resource "snowflake_file_format_avro" "test" {
+  name        = var.name
+  database    = var.database
+  schema      = var.schema
+  compression = var.compression
+  trim_space  = var.trim_space
+  null_if     = var.null_if
+}
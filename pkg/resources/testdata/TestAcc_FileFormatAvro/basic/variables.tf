@@ -0,0 +1,26 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "compression" {
+  type    = string
+  default = null
+}
+
+variable "trim_space" {
+  type    = bool
+  default = null
+}
+
+variable "null_if" {
+  type    = list(string)
+  default = null
+}
@@ -0,0 +1,10 @@
+resource "snowflake_file_format_csv" "test" {
+  name            = var.name
+  database        = var.database
+  schema          = var.schema
+  comment         = var.comment
+  compression     = var.compression
+  field_delimiter = var.field_delimiter
+  skip_header     = var.skip_header
+  null_if         = var.null_if
+}
@@ -0,0 +1,36 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "comment" {
+  type    = string
+  default = null
+}
+
+variable "compression" {
+  type    = string
+  default = null
+}
+
+variable "field_delimiter" {
+  type    = string
+  default = null
+}
+
+variable "skip_header" {
+  type    = number
+  default = null
+}
+
+variable "null_if" {
+  type    = list(string)
+  default = null
+}
@@ -0,0 +1,9 @@
+resource "snowflake_file_format_json" "test" {
+  name              = var.name
+  database          = var.database
+  schema            = var.schema
+  comment           = var.comment
+  compression       = var.compression
+  strip_outer_array = var.strip_outer_array
+  null_if           = var.null_if
+}
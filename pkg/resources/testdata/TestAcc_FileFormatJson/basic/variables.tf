@@ -0,0 +1,31 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "comment" {
+  type    = string
+  default = null
+}
+
+variable "compression" {
+  type    = string
+  default = null
+}
+
+variable "strip_outer_array" {
+  type    = bool
+  default = null
+}
+
+variable "null_if" {
+  type    = list(string)
+  default = null
+}
@@ -0,0 +1,8 @@
+resource "snowflake_file_format_orc" "test" {
+  name                       = var.name
+  database                   = var.database
+  schema                     = var.schema
+  trim_space                 = var.trim_space
+  replace_invalid_characters = var.replace_invalid_characters
+  null_if                    = var.null_if
+}
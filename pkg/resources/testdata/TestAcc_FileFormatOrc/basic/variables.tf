@@ -0,0 +1,26 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "trim_space" {
+  type    = bool
+  default = null
+}
+
+variable "replace_invalid_characters" {
+  type    = bool
+  default = null
+}
+
+variable "null_if" {
+  type    = list(string)
+  default = null
+}
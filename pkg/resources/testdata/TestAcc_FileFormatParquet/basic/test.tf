@@ -0,0 +1,7 @@
+resource "snowflake_file_format_parquet" "test" {
+  name           = var.name
+  database       = var.database
+  schema         = var.schema
+  compression    = var.compression
+  binary_as_text = var.binary_as_text
+}
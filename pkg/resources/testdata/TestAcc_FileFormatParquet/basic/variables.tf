@@ -0,0 +1,21 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "compression" {
+  type    = string
+  default = null
+}
+
+variable "binary_as_text" {
+  type    = bool
+  default = null
+}
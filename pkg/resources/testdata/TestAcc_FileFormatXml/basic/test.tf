@@ -0,0 +1,9 @@
+resource "snowflake_file_format_xml" "test" {
+  name                 = var.name
+  database             = var.database
+  schema               = var.schema
+  compression          = var.compression
+  preserve_space       = var.preserve_space
+  strip_outer_element  = var.strip_outer_element
+  skip_byte_order_mark = var.skip_byte_order_mark
+}
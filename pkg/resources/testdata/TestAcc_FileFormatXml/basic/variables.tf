@@ -0,0 +1,31 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "compression" {
+  type    = string
+  default = null
+}
+
+variable "preserve_space" {
+  type    = bool
+  default = null
+}
+
+variable "strip_outer_element" {
+  type    = bool
+  default = null
+}
+
+variable "skip_byte_order_mark" {
+  type    = bool
+  default = null
+}
@@ -0,0 +1,16 @@
+resource "snowflake_api_integration" "test" {
+  name                 = var.api_integration_name
+  api_provider         = "git_https_api"
+  api_allowed_prefixes = ["https://github.com/Snowflake-Labs/"]
+  enabled              = true
+}
+
+resource "snowflake_git_repository" "test" {
+  name            = var.name
+  database        = var.database
+  schema          = var.schema
+  origin          = "https://github.com/Snowflake-Labs/terraform-provider-snowflake.git"
+  api_integration = snowflake_api_integration.test.name
+  comment         = var.comment
+  fetch_on_apply  = var.fetch_on_apply
+}
@@ -0,0 +1,23 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "api_integration_name" {
+  type = string
+}
+
+variable "comment" {
+  type = string
+}
+
+variable "fetch_on_apply" {
+  type = bool
+}
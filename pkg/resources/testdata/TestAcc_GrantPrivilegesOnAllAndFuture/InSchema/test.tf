@@ -0,0 +1,6 @@
+resource "snowflake_grant_privileges_on_all_and_future" "test" {
+  account_role_name  = var.name
+  privileges         = var.privileges
+  object_type_plural = "TABLES"
+  in_schema          = var.schema
+}
@@ -0,0 +1,11 @@
+variable "name" {
+  type = string
+}
+
+variable "privileges" {
+  type = list(string)
+}
+
+variable "schema" {
+  type = string
+}
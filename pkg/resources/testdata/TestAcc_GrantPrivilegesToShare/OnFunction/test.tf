@@ -0,0 +1,40 @@
+resource "snowflake_share" "test" {
+  depends_on = [snowflake_database.test]
+  name       = var.to_share
+}
+
+resource "snowflake_database" "test" {
+  name = var.database
+}
+
+resource "snowflake_schema" "test" {
+  name     = var.schema
+  database = snowflake_database.test.name
+}
+
+resource "snowflake_function" "test" {
+  name     = var.on_function
+  database = snowflake_database.test.name
+  schema   = snowflake_schema.test.name
+  arguments {
+    name = "x"
+    type = "NUMBER"
+  }
+  return_type = "NUMBER"
+  language    = "SQL"
+  is_secure   = true
+  statement   = "x + 1"
+}
+
+resource "snowflake_grant_privileges_to_share" "test_setup" {
+  to_share    = snowflake_share.test.name
+  privileges  = ["USAGE"]
+  on_database = snowflake_database.test.name
+}
+
+resource "snowflake_grant_privileges_to_share" "test" {
+  to_share    = snowflake_share.test.name
+  privileges  = var.privileges
+  on_function = "\"${snowflake_database.test.name}\".\"${snowflake_schema.test.name}\".\"${snowflake_function.test.name}\"(NUMBER)"
+  depends_on  = [snowflake_grant_privileges_to_share.test_setup]
+}
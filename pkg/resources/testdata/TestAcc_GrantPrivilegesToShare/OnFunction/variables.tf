@@ -0,0 +1,19 @@
+variable "to_share" {
+  type = string
+}
+
+variable "privileges" {
+  type = list(string)
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "on_function" {
+  type = string
+}
@@ -0,0 +1,27 @@
+resource "snowflake_share" "test" {
+  depends_on = [snowflake_database.test]
+  name       = var.to_share
+}
+
+resource "snowflake_database" "test" {
+  name = var.database
+}
+
+resource "snowflake_schema" "test" {
+  name     = var.schema
+  database = snowflake_database.test.name
+}
+
+resource "snowflake_function" "test" {
+  name     = var.on_function
+  database = snowflake_database.test.name
+  schema   = snowflake_schema.test.name
+  arguments {
+    name = "x"
+    type = "NUMBER"
+  }
+  return_type = "NUMBER"
+  language    = "SQL"
+  is_secure   = true
+  statement   = "x + 1"
+}
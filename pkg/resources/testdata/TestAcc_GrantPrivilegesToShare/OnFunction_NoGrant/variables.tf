@@ -0,0 +1,15 @@
+variable "to_share" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "on_function" {
+  type = string
+}
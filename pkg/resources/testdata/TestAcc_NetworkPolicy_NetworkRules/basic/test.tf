@@ -0,0 +1,40 @@
+resource "snowflake_network_rule" "allowed_1" {
+  name       = "${var.name}_ALLOWED_1"
+  database   = var.database
+  schema     = var.schema
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.100/24"]
+}
+
+resource "snowflake_network_rule" "allowed_2" {
+  name       = "${var.name}_ALLOWED_2"
+  database   = var.database
+  schema     = var.schema
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["29.254.123.20"]
+}
+
+resource "snowflake_network_rule" "blocked" {
+  name       = "${var.name}_BLOCKED"
+  database   = var.database
+  schema     = var.schema
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.101"]
+}
+
+locals {
+  rules = {
+    "allowed_1" = snowflake_network_rule.allowed_1.qualified_name
+    "allowed_2" = snowflake_network_rule.allowed_2.qualified_name
+    "blocked"   = snowflake_network_rule.blocked.qualified_name
+  }
+}
+
+resource "snowflake_network_policy" "test" {
+  name                      = var.name
+  allowed_network_rule_list = [for rule in var.allowed_rules : local.rules[rule]]
+  blocked_network_rule_list = [for rule in var.blocked_rules : local.rules[rule]]
+}
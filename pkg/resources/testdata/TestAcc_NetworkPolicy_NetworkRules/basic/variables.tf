@@ -0,0 +1,19 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "allowed_rules" {
+  type = set(string)
+}
+
+variable "blocked_rules" {
+  type = set(string)
+}
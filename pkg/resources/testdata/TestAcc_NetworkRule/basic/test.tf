@@ -0,0 +1,9 @@
+resource "snowflake_network_rule" "test" {
+  name       = var.name
+  database   = var.database
+  schema     = var.schema
+  comment    = var.comment
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = var.value_list
+}
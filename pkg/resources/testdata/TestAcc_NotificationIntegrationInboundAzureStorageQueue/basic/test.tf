@@ -0,0 +1,7 @@
+resource "snowflake_notification_integration_inbound_azure_storage_queue" "test" {
+  name                            = var.name
+  enabled                         = var.enabled
+  comment                         = var.comment
+  azure_storage_queue_primary_uri = var.azure_storage_queue_primary_uri
+  azure_tenant_id                 = var.azure_tenant_id
+}
@@ -0,0 +1,19 @@
+variable "name" {
+  type = string
+}
+
+variable "enabled" {
+  type = bool
+}
+
+variable "comment" {
+  type = string
+}
+
+variable "azure_storage_queue_primary_uri" {
+  type = string
+}
+
+variable "azure_tenant_id" {
+  type = string
+}
@@ -0,0 +1,6 @@
+resource "snowflake_notification_integration_inbound_gcp_pubsub" "test" {
+  name                         = var.name
+  enabled                      = var.enabled
+  comment                      = var.comment
+  gcp_pubsub_subscription_name = var.gcp_pubsub_subscription_name
+}
@@ -0,0 +1,15 @@
+variable "name" {
+  type = string
+}
+
+variable "enabled" {
+  type = bool
+}
+
+variable "comment" {
+  type = string
+}
+
+variable "gcp_pubsub_subscription_name" {
+  type = string
+}
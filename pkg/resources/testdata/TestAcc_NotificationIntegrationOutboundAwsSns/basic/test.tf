@@ -0,0 +1,7 @@
+resource "snowflake_notification_integration_outbound_aws_sns" "test" {
+  name              = var.name
+  enabled           = var.enabled
+  comment           = var.comment
+  aws_sns_topic_arn = var.aws_sns_topic_arn
+  aws_sns_role_arn  = var.aws_sns_role_arn
+}
@@ -0,0 +1,19 @@
+variable "name" {
+  type = string
+}
+
+variable "enabled" {
+  type = bool
+}
+
+variable "comment" {
+  type = string
+}
+
+variable "aws_sns_topic_arn" {
+  type = string
+}
+
+variable "aws_sns_role_arn" {
+  type = string
+}
@@ -0,0 +1,12 @@
+resource "snowflake_object_grants" "test" {
+  object_type = "DATABASE"
+  object_name = var.database_name
+
+  dynamic "grant" {
+    for_each = var.privileges
+    content {
+      privilege         = grant.value
+      account_role_name = var.account_role_name
+    }
+  }
+}
@@ -0,0 +1,11 @@
+variable "account_role_name" {
+  type = string
+}
+
+variable "database_name" {
+  type = string
+}
+
+variable "privileges" {
+  type = list(string)
+}
@@ -0,0 +1,14 @@
+resource "snowflake_resource_monitor" "test" {
+  name         = var.resource_monitor
+  credit_quota = 10
+}
+
+resource "snowflake_warehouse" "test" {
+  name           = var.warehouse
+  warehouse_size = "XSMALL"
+}
+
+resource "snowflake_resource_monitor_assignment" "test" {
+  resource_monitor = snowflake_resource_monitor.test.name
+  warehouse        = snowflake_warehouse.test.name
+}
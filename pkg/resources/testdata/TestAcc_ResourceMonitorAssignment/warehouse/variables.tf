@@ -0,0 +1,7 @@
+variable "resource_monitor" {
+  type = string
+}
+
+variable "warehouse" {
+  type = string
+}
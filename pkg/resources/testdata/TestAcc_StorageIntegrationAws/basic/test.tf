@@ -0,0 +1,8 @@
+resource "snowflake_storage_integration_aws" "test" {
+  name                      = var.name
+  comment                   = var.comment
+  storage_allowed_locations = var.allowed_locations
+  storage_blocked_locations = var.blocked_locations
+  storage_aws_role_arn      = var.aws_role_arn
+  storage_aws_object_acl    = var.aws_object_acl
+}
@@ -0,0 +1,26 @@
+variable "name" {
+  type = string
+}
+
+variable "comment" {
+  type    = string
+  default = null
+}
+
+variable "allowed_locations" {
+  type = list(string)
+}
+
+variable "blocked_locations" {
+  type    = list(string)
+  default = null
+}
+
+variable "aws_role_arn" {
+  type = string
+}
+
+variable "aws_object_acl" {
+  type    = string
+  default = null
+}
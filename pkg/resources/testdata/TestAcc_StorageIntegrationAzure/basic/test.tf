@@ -0,0 +1,7 @@
+resource "snowflake_storage_integration_azure" "test" {
+  name                      = var.name
+  comment                   = var.comment
+  storage_allowed_locations = var.allowed_locations
+  storage_blocked_locations = var.blocked_locations
+  azure_tenant_id           = var.azure_tenant_id
+}
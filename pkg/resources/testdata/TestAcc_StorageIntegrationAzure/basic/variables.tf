@@ -0,0 +1,21 @@
+variable "name" {
+  type = string
+}
+
+variable "comment" {
+  type    = string
+  default = null
+}
+
+variable "allowed_locations" {
+  type = list(string)
+}
+
+variable "blocked_locations" {
+  type    = list(string)
+  default = null
+}
+
+variable "azure_tenant_id" {
+  type = string
+}
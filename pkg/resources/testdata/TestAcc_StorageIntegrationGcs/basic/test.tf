@@ -0,0 +1,6 @@
+resource "snowflake_storage_integration_gcs" "test" {
+  name                      = var.name
+  comment                   = var.comment
+  storage_allowed_locations = var.allowed_locations
+  storage_blocked_locations = var.blocked_locations
+}
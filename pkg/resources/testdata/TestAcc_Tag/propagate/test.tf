@@ -0,0 +1,8 @@
+resource "snowflake_tag" "t" {
+  name                    = var.name
+  database                = var.database
+  schema                  = var.schema
+  allowed_values          = var.allowed_values
+  propagate               = var.propagate
+  propagation_on_conflict = var.propagation_on_conflict
+}
@@ -0,0 +1,23 @@
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "name" {
+  type = string
+}
+
+variable "allowed_values" {
+  type = list(string)
+}
+
+variable "propagate" {
+  type = string
+}
+
+variable "propagation_on_conflict" {
+  type = string
+}
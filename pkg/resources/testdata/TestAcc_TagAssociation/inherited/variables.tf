@@ -0,0 +1,19 @@
+variable "tag_name" {
+  type = string
+}
+
+variable "table_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "column_tag_value" {
+  type = string
+}
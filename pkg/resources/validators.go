@@ -95,6 +95,29 @@ func IsValidIdentifier[T sdk.AccountObjectIdentifier | sdk.DatabaseObjectIdentif
 	}
 }
 
+// isValidFunctionIdentifier validates fully qualified function names followed by their argument data types,
+// e.g. "database"."schema"."function"(NUMBER, VARCHAR).
+func isValidFunctionIdentifier() schema.SchemaValidateDiagFunc {
+	return func(value any, path cty.Path) diag.Diagnostics {
+		stringValue, ok := value.(string)
+		if !ok {
+			return diag.Errorf("expected type of %v to be string", path)
+		}
+		argumentsStart := strings.Index(stringValue, "(")
+		if argumentsStart < 0 || !strings.HasSuffix(stringValue, ")") {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Invalid function identifier",
+					Detail:        fmt.Sprintf("Function identifier %s has to contain argument data types in the form of: <database_name>.<schema_name>.<name>(<argument_types>)", stringValue),
+					AttributePath: path,
+				},
+			}
+		}
+		return IsValidIdentifier[sdk.SchemaObjectIdentifier]()(stringValue[:argumentsStart], path)
+	}
+}
+
 func getExpectedIdentifierRepresentationFromGeneric[T sdk.AccountObjectIdentifier | sdk.DatabaseObjectIdentifier | sdk.SchemaObjectIdentifier | sdk.TableColumnIdentifier]() string {
 	return getExpectedIdentifierForm(new(T))
 }
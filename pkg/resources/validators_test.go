@@ -132,6 +132,45 @@ func TestIsValidIdentifier(t *testing.T) {
 	}
 }
 
+func TestIsValidFunctionIdentifier(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Value string
+		Error string
+	}{
+		{
+			Name:  "correct form with arguments",
+			Value: `"a"."b"."c"(NUMBER, VARCHAR)`,
+		},
+		{
+			Name:  "correct form without arguments",
+			Value: `"a"."b"."c"()`,
+		},
+		{
+			Name:  "validation: missing arguments",
+			Value: `"a"."b"."c"`,
+			Error: "has to contain argument data types",
+		},
+		{
+			Name:  "validation: incorrect form for schema object identifier",
+			Value: `"a"."b"(NUMBER)`,
+			Error: "<database_name>.<schema_name>.<name>, but was <database_name>.<name>",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			diag := isValidFunctionIdentifier()(tt.Value, cty.IndexStringPath("path"))
+			if tt.Error != "" {
+				assert.Len(t, diag, 1)
+				assert.Contains(t, diag[0].Detail, tt.Error)
+			} else {
+				assert.Len(t, diag, 0)
+			}
+		})
+	}
+}
+
 func TestGetExpectedIdentifierFormGeneric(t *testing.T) {
 	testCases := []struct {
 		Name     string
@@ -2,20 +2,26 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var space = regexp.MustCompile(`\s+`)
 
+var recursiveViewPattern = regexp.MustCompile(`(?i)^\s*create\s+(?:or\s+replace\s+)?(?:secure\s+)?(?:(?:local\s+|global\s+)?(?:temp|temporary|volatile)\s+)?recursive\s+view\b`)
+
 var viewSchema = map[string]*schema.Schema{
 	"name": {
 		Type:        schema.TypeString,
@@ -57,6 +63,19 @@ var viewSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies that the view is secure. By design, the Snowflake's `SHOW VIEWS` command does not provide information about secure views (consult [view usage notes](https://docs.snowflake.com/en/sql-reference/sql/create-view#usage-notes)) which is essential to manage/import view with Terraform. Use the role owning the view while managing secure views.",
 	},
+	"is_recursive": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies that the view can refer to itself using recursive syntax without necessarily using a CTE (common table expression).",
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether change tracking is enabled on the view.",
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -68,6 +87,94 @@ var viewSchema = map[string]*schema.Schema{
 		Description:      "Specifies the query used to create the view.",
 		DiffSuppressFunc: DiffSuppressStatement,
 	},
+	"column": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		Description: "The columns of the view. When not specified, the columns are read from the query. Changing the names or comments of the columns recreates the view with `CREATE OR REPLACE`, while masking policies are changed in place.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the name of the column (case-sensitive).",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a comment for the column.",
+				},
+				"masking_policy": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Specifies the masking policy to set on the column.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"policy_name": {
+								Type:             schema.TypeString,
+								Required:         true,
+								DiffSuppressFunc: suppressIdentifierQuoting,
+								Description:      "Fully qualified name of the masking policy (e.g. `\"db\".\"schema\".\"policy\"`).",
+							},
+							"using": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "The columns (case-sensitive) passed to a conditional masking policy; the first one has to be the masked column.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"row_access_policy": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the row access policy to set on the view.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      "Fully qualified name of the row access policy (e.g. `\"db\".\"schema\".\"policy\"`).",
+				},
+				"on": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The columns (case-sensitive) of the view passed to the row access policy.",
+				},
+			},
+		},
+	},
+	"data_metric_schedule": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the schedule to run the data metric functions associated with the view. The schedule is not read from Snowflake, so external changes are not detected.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntInSlice([]int{5, 15, 30, 60, 720, 1440}),
+					ExactlyOneOf: []string{"data_metric_schedule.0.minutes", "data_metric_schedule.0.using_cron"},
+					Description:  "Specifies an interval (in minutes) of wait time inserted between runs of the data metric functions. Valid values are 5, 15, 30, 60, 720 and 1440.",
+				},
+				"using_cron": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{"data_metric_schedule.0.minutes", "data_metric_schedule.0.using_cron"},
+					Description:  "Specifies a cron expression and time zone for periodically running the data metric functions (e.g. `0 8 * * * UTC`).",
+				},
+			},
+		},
+	},
 	"created_on": {
 		Type:        schema.TypeString,
 		Computed:    true,
@@ -79,10 +186,10 @@ var viewSchema = map[string]*schema.Schema{
 // View returns a pointer to the resource representing a view.
 func View() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateView,
-		Read:   ReadView,
-		Update: UpdateView,
-		Delete: DeleteView,
+		CreateContext: CreateView,
+		ReadContext:   ReadView,
+		UpdateContext: UpdateView,
+		DeleteContext: DeleteView,
 
 		Schema: viewSchema,
 		Importer: &schema.ResourceImporter{
@@ -91,58 +198,183 @@ func View() *schema.Resource {
 	}
 }
 
-// CreateView implements schema.CreateFunc.
-func CreateView(d *schema.ResourceData, meta interface{}) error {
+// viewColumnsConfigured reports whether the columns are specified in the configuration; otherwise they are only read from Snowflake.
+func viewColumnsConfigured(d *schema.ResourceData) bool {
+	columns := d.GetRawConfig().GetAttr("column")
+	return !columns.IsNull() && columns.LengthInt() > 0
+}
+
+func quoteViewColumns(columns []string) []string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = fmt.Sprintf(`"%s"`, strings.Trim(column, `"`))
+	}
+	return quoted
+}
+
+// parseViewPolicyColumns parses the REF_ARG_COLUMN_NAMES column of POLICY_REFERENCES, e.g. [ "A", "B" ].
+func parseViewPolicyColumns(value *string) []string {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return []string{}
+	}
+	var columns []string
+	if err := json.Unmarshal([]byte(*value), &columns); err == nil {
+		return columns
+	}
+	columns = make([]string, 0)
+	for _, column := range strings.Split(strings.Trim(strings.TrimSpace(*value), "[]"), ",") {
+		if column = strings.Trim(strings.TrimSpace(column), `"`); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+type viewColumnMaskingPolicy struct {
+	policy sdk.SchemaObjectIdentifier
+	using  []string
+}
+
+func viewColumnMaskingPolicies(columns []any) map[string]viewColumnMaskingPolicy {
+	policies := make(map[string]viewColumnMaskingPolicy)
+	for _, c := range columns {
+		column := c.(map[string]any)
+		maskingPolicy, ok := column["masking_policy"].([]any)
+		if !ok || len(maskingPolicy) == 0 || maskingPolicy[0] == nil {
+			continue
+		}
+		policy := maskingPolicy[0].(map[string]any)
+		policies[column["column_name"].(string)] = viewColumnMaskingPolicy{
+			policy: sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(policy["policy_name"].(string)),
+			using:  expandStringList(policy["using"].([]any)),
+		}
+	}
+	return policies
+}
+
+func (p viewColumnMaskingPolicy) equals(other viewColumnMaskingPolicy) bool {
+	if p.policy.FullyQualifiedName() != other.policy.FullyQualifiedName() || len(p.using) != len(other.using) {
+		return false
+	}
+	for i := range p.using {
+		if strings.Trim(p.using[i], `"`) != strings.Trim(other.using[i], `"`) {
+			return false
+		}
+	}
+	return true
+}
+
+func viewDataMetricSchedule(d *schema.ResourceData) *string {
+	schedule, ok := d.GetOk("data_metric_schedule")
+	if !ok || len(schedule.([]any)) == 0 || schedule.([]any)[0] == nil {
+		return nil
+	}
+	v := schedule.([]any)[0].(map[string]any)
+	if minutes := v["minutes"].(int); minutes > 0 {
+		return sdk.String(fmt.Sprintf("%d MINUTE", minutes))
+	}
+	if cron := v["using_cron"].(string); cron != "" {
+		return sdk.String(fmt.Sprintf("USING CRON %s", cron))
+	}
+	return nil
+}
+
+func createViewRequest(d *schema.ResourceData, id sdk.SchemaObjectIdentifier) *sdk.CreateViewRequest {
+	request := sdk.NewCreateViewRequest(id, d.Get("statement").(string))
+
+	if d.Get("is_secure").(bool) {
+		request.WithSecure(sdk.Bool(true))
+	}
+	if d.Get("is_recursive").(bool) {
+		request.WithRecursive(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if viewColumnsConfigured(d) {
+		columns := d.Get("column").([]any)
+		columnRequests := make([]sdk.ViewColumnRequest, len(columns))
+		for i, c := range columns {
+			column := c.(map[string]any)
+			columnRequests[i] = *sdk.NewViewColumnRequest(column["column_name"].(string))
+			if comment := column["comment"].(string); comment != "" {
+				columnRequests[i].WithComment(sdk.String(comment))
+			}
+		}
+		request.WithColumns(columnRequests)
+	}
+	if v, ok := d.GetOk("row_access_policy"); ok {
+		policy := v.([]any)[0].(map[string]any)
+		request.WithRowAccessPolicy(sdk.NewViewRowAccessPolicyRequest(
+			sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(policy["policy_name"].(string)),
+			quoteViewColumns(expandStringList(policy["on"].([]any))),
+		))
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+	return request
+}
+
+// applyViewProperties sets the properties which cannot be specified in CREATE VIEW and are lost when the view is replaced.
+func applyViewProperties(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) error {
+	if viewColumnsConfigured(d) {
+		for name, policy := range viewColumnMaskingPolicies(d.Get("column").([]any)) {
+			request := sdk.NewViewSetColumnMaskingPolicyRequest(fmt.Sprintf(`"%s"`, name), policy.policy)
+			if len(policy.using) > 0 {
+				request.WithUsing(quoteViewColumns(policy.using))
+			}
+			if err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetMaskingPolicyOnColumn(request)); err != nil {
+				return fmt.Errorf("error setting masking policy on column %s of view %v, err = %w", name, id.FullyQualifiedName(), err)
+			}
+		}
+	}
+	if d.Get("change_tracking").(bool) {
+		if err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetChangeTracking(sdk.Bool(true))); err != nil {
+			return fmt.Errorf("error setting change tracking on view %v, err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+	if schedule := viewDataMetricSchedule(d); schedule != nil {
+		if err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetDataMetricSchedule(schedule)); err != nil {
+			return fmt.Errorf("error setting data metric schedule on view %v, err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+// CreateView implements schema.CreateContextFunc.
+func CreateView(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 	name := d.Get("name").(string)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	s := d.Get("statement").(string)
-	createRequest := sdk.NewCreateViewRequest(id, s)
-
+	createRequest := createViewRequest(d, id)
 	if v, ok := d.GetOk("or_replace"); ok && v.(bool) {
 		createRequest.WithOrReplace(sdk.Bool(true))
 	}
-
-	if v, ok := d.GetOk("is_secure"); ok && v.(bool) {
-		createRequest.WithSecure(sdk.Bool(true))
-	}
-
 	if v, ok := d.GetOk("copy_grants"); ok && v.(bool) {
 		createRequest.WithCopyGrants(sdk.Bool(true))
 	}
 
-	if v, ok := d.GetOk("comment"); ok {
-		createRequest.WithComment(sdk.String(v.(string)))
+	if err := client.Views.Create(ctx, createRequest); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating view %v err = %w", name, err))
 	}
 
-	err := client.Views.Create(ctx, createRequest)
-	if err != nil {
-		return fmt.Errorf("error creating view %v err = %w", name, err)
-	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	// TODO [SNOW-867235]: we have to set tags after creation because existing view extractor is not aware of TAG during CREATE
-	// Will be discussed with parser topic during resources redesign.
-	if _, ok := d.GetOk("tag"); ok {
-		err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetTags(getPropertyTags(d, "tag")))
-		if err != nil {
-			return fmt.Errorf("error setting tags on view %v, err = %w", id, err)
-		}
+	if err := applyViewProperties(ctx, client, d, id); err != nil {
+		return diag.FromErr(err)
 	}
 
-	d.SetId(helpers.EncodeSnowflakeID(id))
-
-	return ReadView(d, meta)
+	return ReadView(ctx, d, meta)
 }
 
-// ReadView implements schema.ReadFunc.
-func ReadView(d *schema.ResourceData, meta interface{}) error {
+// ReadView implements schema.ReadContextFunc.
+func ReadView(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	view, err := client.Views.ShowByID(ctx, id)
@@ -153,97 +385,156 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err = d.Set("name", view.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("is_secure", view.IsSecure); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("copy_grants", view.HasCopyGrants()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("comment", view.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("schema", view.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("database", view.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("created_on", view.CreatedOn); err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err = d.Set("change_tracking", view.ChangeTracking == "ON"); err != nil {
+		return diag.FromErr(err)
 	}
 
-	if view.Text != "" {
-		// TODO [SNOW-867235]: what do we do with these extractors (added as discussion topic)?
-		// Want to only capture the SELECT part of the query because before that is the CREATE part of the view.
-		extractor := snowflake.NewViewSelectStatementExtractor(view.Text)
-		substringOfQuery, err := extractor.Extract()
-		if err != nil {
-			return err
+	if view.Text == "" {
+		return diag.FromErr(fmt.Errorf("error reading view %v, `text` is missing; if the view is secure then the role used by the provider must own the view (consult https://docs.snowflake.com/en/sql-reference/sql/create-view#usage-notes)", d.Id()))
+	}
+	statement, err := extractQueryFromCreateStatement(view.Text)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("statement", statement); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_recursive", recursiveViewPattern.MatchString(view.Text)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	details, err := client.Views.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(id, sdk.PolicyEntityDomainView))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	maskingPolicies := make(map[string]map[string]any)
+	rowAccessPolicies := make([]map[string]any, 0)
+	for _, reference := range policyReferences {
+		if reference.PolicyDb == nil || reference.PolicySchema == nil {
+			continue
 		}
-		if err = d.Set("statement", substringOfQuery); err != nil {
-			return err
+		policyName := sdk.NewSchemaObjectIdentifier(*reference.PolicyDb, *reference.PolicySchema, reference.PolicyName).FullyQualifiedName()
+		switch reference.PolicyKind {
+		case "MASKING_POLICY":
+			if reference.RefColumnName == nil {
+				continue
+			}
+			using := make([]string, 0)
+			if arguments := parseViewPolicyColumns(reference.RefArgColumnNames); len(arguments) > 0 {
+				using = append([]string{*reference.RefColumnName}, arguments...)
+			}
+			maskingPolicies[*reference.RefColumnName] = map[string]any{
+				"policy_name": policyName,
+				"using":       using,
+			}
+		case "ROW_ACCESS_POLICY":
+			rowAccessPolicies = append(rowAccessPolicies, map[string]any{
+				"policy_name": policyName,
+				"on":          parseViewPolicyColumns(reference.RefArgColumnNames),
+			})
 		}
-	} else {
-		return fmt.Errorf("error reading view %v, err = %w, `text` is missing; if the view is secure then the role used by the provider must own the view (consult https://docs.snowflake.com/en/sql-reference/sql/create-view#usage-notes)", d.Id(), err)
+	}
+
+	columns := make([]map[string]any, len(details))
+	for i, detail := range details {
+		columns[i] = map[string]any{
+			"column_name": detail.Name,
+		}
+		if detail.Comment != nil {
+			columns[i]["comment"] = *detail.Comment
+		}
+		if policy, ok := maskingPolicies[detail.Name]; ok {
+			columns[i]["masking_policy"] = []any{policy}
+		}
+	}
+	if err = d.Set("column", columns); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("row_access_policy", rowAccessPolicies); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdateView implements schema.UpdateFunc.
-func UpdateView(d *schema.ResourceData, meta interface{}) error {
+// UpdateView implements schema.UpdateContextFunc.
+func UpdateView(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
-	// The only way to update the statement field in a view is to perform create or replace with the new statement.
-	// In case of any statement change, create or replace will be performed with all the old parameters, except statement
-	// and copy grants (which is always set to true to keep the permissions from the previous state).
-	if d.HasChange("statement") {
-		oldIsSecure, _ := d.GetChange("is_secure")
-		oldComment, _ := d.GetChange("comment")
-		oldTags, _ := d.GetChange("tag")
-
-		createRequest := sdk.NewCreateViewRequest(id, d.Get("statement").(string)).
-			WithOrReplace(sdk.Bool(true)).
-			WithCopyGrants(sdk.Bool(true)).
-			WithComment(sdk.String(oldComment.(string))).
-			WithTag(getTagsFromList(oldTags.([]any)))
-
-		if oldIsSecure.(bool) {
-			createRequest.WithSecure(sdk.Bool(true))
-		}
-
-		err := client.Views.Create(ctx, createRequest)
-		if err != nil {
-			return fmt.Errorf("error when changing property on %v and performing create or replace to update view statements, err = %w", d.Id(), err)
-		}
-	}
-
 	if d.HasChange("name") {
 		newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
 
 		err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithRenameTo(&newId))
 		if err != nil {
-			return fmt.Errorf("error renaming view %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error renaming view %v err = %w", d.Id(), err))
 		}
 
 		d.SetId(helpers.EncodeSnowflakeID(newId))
 		id = newId
 	}
 
+	// The only way to update the statement or the columns of a view is to perform create or replace with the new definition.
+	// Copy grants is always set to keep the permissions from the previous state. Properties which cannot be set in CREATE VIEW
+	// are applied again afterward, as they are lost with the replaced view.
+	columnsChanged := false
+	if d.HasChange("column") && viewColumnsConfigured(d) {
+		oldColumns, newColumns := d.GetChange("column")
+		columnsChanged = len(oldColumns.([]any)) != len(newColumns.([]any))
+		for i := 0; !columnsChanged && i < len(newColumns.([]any)); i++ {
+			oldColumn, newColumn := oldColumns.([]any)[i].(map[string]any), newColumns.([]any)[i].(map[string]any)
+			columnsChanged = oldColumn["column_name"] != newColumn["column_name"] || oldColumn["comment"] != newColumn["comment"]
+		}
+	}
+	if d.HasChange("statement") || columnsChanged {
+		createRequest := createViewRequest(d, id).
+			WithOrReplace(sdk.Bool(true)).
+			WithCopyGrants(sdk.Bool(true))
+
+		if err := client.Views.Create(ctx, createRequest); err != nil {
+			return diag.FromErr(fmt.Errorf("error when changing property on %v and performing create or replace to update view statements, err = %w", d.Id(), err))
+		}
+		if err := applyViewProperties(ctx, client, d, id); err != nil {
+			return diag.FromErr(err)
+		}
+		return ReadView(ctx, d, meta)
+	}
+
 	if d.HasChange("comment") {
 		if comment := d.Get("comment").(string); comment == "" {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetComment(sdk.Bool(true)))
 			if err != nil {
-				return fmt.Errorf("error unsetting comment for view %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error unsetting comment for view %v", d.Id()))
 			}
 		} else {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetComment(sdk.String(comment)))
 			if err != nil {
-				return fmt.Errorf("error updating comment for view %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error updating comment for view %v", d.Id()))
 			}
 		}
 	}
@@ -252,46 +543,143 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 		if d.Get("is_secure").(bool) {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetSecure(sdk.Bool(true)))
 			if err != nil {
-				return fmt.Errorf("error setting secure for view %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error setting secure for view %v", d.Id()))
 			}
 		} else {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetSecure(sdk.Bool(true)))
 			if err != nil {
-				return fmt.Errorf("error unsetting secure for view %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error unsetting secure for view %v", d.Id()))
 			}
 		}
 	}
 
+	if d.HasChange("change_tracking") {
+		err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetChangeTracking(sdk.Bool(d.Get("change_tracking").(bool))))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error setting change tracking for view %v, err = %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("data_metric_schedule") {
+		var err error
+		if schedule := viewDataMetricSchedule(d); schedule != nil {
+			err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetDataMetricSchedule(schedule))
+		} else {
+			err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetDataMetricSchedule(sdk.Bool(true)))
+		}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error changing data metric schedule for view %v, err = %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("row_access_policy") {
+		if err := updateViewRowAccessPolicy(ctx, client, d, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("column") && viewColumnsConfigured(d) {
+		if err := updateViewMaskingPolicies(ctx, client, d, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("tag") {
 		unsetTags, setTags := GetTagsDiff(d, "tag")
 
 		if len(unsetTags) > 0 {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetTags(unsetTags))
 			if err != nil {
-				return fmt.Errorf("error unsetting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error unsetting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 
 		if len(setTags) > 0 {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetTags(setTags))
 			if err != nil {
-				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 	}
 
-	return ReadView(d, meta)
+	return ReadView(ctx, d, meta)
+}
+
+func updateViewRowAccessPolicy(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) error {
+	oldPolicy, newPolicy := d.GetChange("row_access_policy")
+
+	var dropRequest *sdk.ViewDropRowAccessPolicyRequest
+	if len(oldPolicy.([]any)) > 0 {
+		policy := oldPolicy.([]any)[0].(map[string]any)
+		dropRequest = sdk.NewViewDropRowAccessPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(policy["policy_name"].(string)))
+	}
+	var addRequest *sdk.ViewAddRowAccessPolicyRequest
+	if len(newPolicy.([]any)) > 0 {
+		policy := newPolicy.([]any)[0].(map[string]any)
+		addRequest = sdk.NewViewAddRowAccessPolicyRequest(
+			sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(policy["policy_name"].(string)),
+			quoteViewColumns(expandStringList(policy["on"].([]any))),
+		)
+	}
+
+	request := sdk.NewAlterViewRequest(id)
+	switch {
+	case dropRequest != nil && addRequest != nil:
+		request.WithDropAndAddRowAccessPolicy(sdk.NewViewDropAndAddRowAccessPolicyRequest(*dropRequest, *addRequest))
+	case dropRequest != nil:
+		request.WithDropRowAccessPolicy(dropRequest)
+	case addRequest != nil:
+		request.WithAddRowAccessPolicy(addRequest)
+	default:
+		return nil
+	}
+	if err := client.Views.Alter(ctx, request); err != nil {
+		return fmt.Errorf("error changing row access policy for view %v, err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func updateViewMaskingPolicies(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) error {
+	oldColumns, newColumns := d.GetChange("column")
+	oldPolicies, newPolicies := viewColumnMaskingPolicies(oldColumns.([]any)), viewColumnMaskingPolicies(newColumns.([]any))
+
+	var errs []error
+	for name := range oldPolicies {
+		if _, ok := newPolicies[name]; !ok {
+			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetMaskingPolicyOnColumn(sdk.NewViewUnsetColumnMaskingPolicyRequest(fmt.Sprintf(`"%s"`, name))))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error unsetting masking policy on column %s of view %v, err = %w", name, id.FullyQualifiedName(), err))
+			}
+		}
+	}
+	for name, policy := range newPolicies {
+		oldPolicy, ok := oldPolicies[name]
+		if ok && oldPolicy.equals(policy) {
+			continue
+		}
+		request := sdk.NewViewSetColumnMaskingPolicyRequest(fmt.Sprintf(`"%s"`, name), policy.policy)
+		if ok {
+			// FORCE replaces the masking policy currently set on the column
+			request.WithForce(sdk.Bool(true))
+		}
+		if len(policy.using) > 0 {
+			request.WithUsing(quoteViewColumns(policy.using))
+		}
+		if err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetMaskingPolicyOnColumn(request)); err != nil {
+			errs = append(errs, fmt.Errorf("error setting masking policy on column %s of view %v, err = %w", name, id.FullyQualifiedName(), err))
+		}
+	}
+	return errors.Join(errs...)
 }
 
-// DeleteView implements schema.DeleteFunc.
-func DeleteView(d *schema.ResourceData, meta interface{}) error {
+// DeleteView implements schema.DeleteContextFunc.
+func DeleteView(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.Views.Drop(ctx, sdk.NewDropViewRequest(id))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
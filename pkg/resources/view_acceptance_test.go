@@ -438,7 +438,7 @@ func TestAcc_View_Issue2640(t *testing.T) {
 				Config: viewConfigWithMultilineUnionStatement(acc.TestDatabaseName, acc.TestSchemaName, viewName, part1, part2),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_view.test", "name", viewName),
-					resource.TestCheckResourceAttr("snowflake_view.test", "statement", fmt.Sprintf("%s\n\tunion\n%s\n", part1, part2)),
+					resource.TestCheckResourceAttr("snowflake_view.test", "statement", fmt.Sprintf("%s\n\tunion\n%s", part1, part2)),
 					resource.TestCheckResourceAttr("snowflake_view.test", "database", acc.TestDatabaseName),
 					resource.TestCheckResourceAttr("snowflake_view.test", "schema", acc.TestSchemaName),
 				),
@@ -467,6 +467,138 @@ func TestAcc_View_Issue2640(t *testing.T) {
 	})
 }
 
+func TestAcc_View_columnsAndPolicies(t *testing.T) {
+	viewName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableName := viewName + "_TABLE"
+	policyName := viewName + "_POLICY"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.View),
+		Steps: []resource.TestStep{
+			{
+				Config: viewConfigWithColumnsAndPolicies(acc.TestDatabaseName, acc.TestSchemaName, tableName, viewName, policyName, "name comment", false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_view.test", "name", viewName),
+					resource.TestCheckResourceAttr("snowflake_view.test", "change_tracking", "true"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.0.column_name", "ID"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.1.column_name", "NAME"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.1.comment", "name comment"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.1.masking_policy.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "row_access_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "row_access_policy.0.on.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "row_access_policy.0.on.0", "ID"),
+				),
+			},
+			// set masking policy in place
+			{
+				Config: viewConfigWithColumnsAndPolicies(acc.TestDatabaseName, acc.TestSchemaName, tableName, viewName, policyName, "name comment", true, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectResourceAction("snowflake_view.test", plancheck.ResourceActionUpdate)},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.1.masking_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.1.masking_policy.0.policy_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, policyName).FullyQualifiedName()),
+				),
+			},
+			// change column comment (create or replace) and disable change tracking; the masking policy is kept
+			{
+				Config: viewConfigWithColumnsAndPolicies(acc.TestDatabaseName, acc.TestSchemaName, tableName, viewName, policyName, "changed comment", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_view.test", "change_tracking", "false"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.1.comment", "changed comment"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.1.masking_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "row_access_policy.#", "1"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_view.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"or_replace"},
+			},
+		},
+	})
+}
+
+func viewConfigWithColumnsAndPolicies(databaseName string, schemaName string, tableName string, viewName string, policyName string, comment string, withMaskingPolicy bool, changeTracking bool) string {
+	maskingPolicy := ""
+	if withMaskingPolicy {
+		maskingPolicy = `
+    masking_policy {
+      policy_name = snowflake_masking_policy.test.qualified_name
+    }`
+	}
+	return fmt.Sprintf(`
+resource "snowflake_table" "table" {
+  database        = "%[1]s"
+  schema          = "%[2]s"
+  name            = "%[3]s"
+  change_tracking = true
+
+  column {
+    name = "ID"
+    type = "NUMBER(38,0)"
+  }
+  column {
+    name = "NAME"
+    type = "VARCHAR"
+  }
+}
+
+resource "snowflake_masking_policy" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[5]s"
+  signature {
+    column {
+      name = "val"
+      type = "VARCHAR"
+    }
+  }
+  masking_expression = "'***'"
+  return_data_type   = "VARCHAR"
+}
+
+resource "snowflake_row_access_policy" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[5]s_ROWS"
+  signature = {
+    ID = "NUMBER"
+  }
+  row_access_expression = "true"
+}
+
+resource "snowflake_view" "test" {
+  database        = "%[1]s"
+  schema          = "%[2]s"
+  name            = "%[4]s"
+  statement       = "select ID, NAME from \"%[1]s\".\"%[2]s\".\"${snowflake_table.table.name}\""
+  change_tracking = %[8]t
+
+  column {
+    column_name = "ID"
+  }
+  column {
+    column_name = "NAME"
+    comment     = "%[6]s"
+    %[7]s
+  }
+
+  row_access_policy {
+    policy_name = "\"%[1]s\".\"%[2]s\".\"${snowflake_row_access_policy.test.name}\""
+    on          = ["ID"]
+  }
+}
+`, databaseName, schemaName, tableName, viewName, policyName, comment, maskingPolicy, changeTracking)
+}
+
 func viewConfigWithGrants(databaseName string, schemaName string, tableName string, viewName string, selectStatement string) string {
 	return fmt.Sprintf(`
 resource "snowflake_table" "table" {
@@ -0,0 +1,30 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseViewPolicyColumns(t *testing.T) {
+	assert.Empty(t, parseViewPolicyColumns(nil))
+	assert.Empty(t, parseViewPolicyColumns(sdk.String("")))
+	assert.Equal(t, []string{"ID", "name"}, parseViewPolicyColumns(sdk.String(`[ "ID", "name" ]`)))
+	assert.Equal(t, []string{"ID", "NAME"}, parseViewPolicyColumns(sdk.String(`[ID, NAME]`)))
+}
+
+func Test_recursiveViewPattern(t *testing.T) {
+	assert.True(t, recursiveViewPattern.MatchString(`CREATE OR REPLACE RECURSIVE VIEW "db"."schema"."v" (a) AS select 1`))
+	assert.True(t, recursiveViewPattern.MatchString(`create secure recursive view v as select 1`))
+	assert.False(t, recursiveViewPattern.MatchString(`CREATE VIEW v AS select 'recursive view' as a`))
+	assert.False(t, recursiveViewPattern.MatchString(`CREATE VIEW v (a COMMENT 'recursive view') AS select 1`))
+}
+
+func Test_viewColumnMaskingPolicy_equals(t *testing.T) {
+	policy := viewColumnMaskingPolicy{policy: sdk.NewSchemaObjectIdentifier("db", "schema", "policy"), using: []string{`"A"`, "B"}}
+
+	assert.True(t, policy.equals(viewColumnMaskingPolicy{policy: sdk.NewSchemaObjectIdentifier("db", "schema", "policy"), using: []string{"A", `"B"`}}))
+	assert.False(t, policy.equals(viewColumnMaskingPolicy{policy: sdk.NewSchemaObjectIdentifier("db", "schema", "other"), using: []string{"A", "B"}}))
+	assert.False(t, policy.equals(viewColumnMaskingPolicy{policy: sdk.NewSchemaObjectIdentifier("db", "schema", "policy"), using: []string{"A"}}))
+}
@@ -21,6 +21,10 @@ type Accounts interface {
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Account, error)
 	Drop(ctx context.Context, id AccountObjectIdentifier, gracePeriodInDays int, opts *DropAccountOptions) error
 	Undrop(ctx context.Context, id AccountObjectIdentifier) error
+	// ShowDroppedByID returns the account with the given name that was dropped and is still within its grace period.
+	ShowDroppedByID(ctx context.Context, id AccountObjectIdentifier) (*Account, error)
+	// SetGlobalParameter sets an organization-level parameter of the account with SYSTEM$GLOBAL_ACCOUNT_SET_PARAMETER.
+	SetGlobalParameter(ctx context.Context, id AccountIdentifier, parameter GlobalAccountParameter, value string) error
 }
 
 var _ Accounts = (*accounts)(nil)
@@ -96,6 +100,7 @@ type AlterAccountOptions struct {
 	UnsetTag []ObjectIdentifier `ddl:"keyword" sql:"UNSET TAG"`
 	Rename   *AccountRename     `ddl:"-"`
 	Drop     *AccountDrop       `ddl:"-"`
+	OrgAdmin *AccountOrgAdmin   `ddl:"-"`
 }
 
 func (opts *AlterAccountOptions) validate() error {
@@ -103,8 +108,8 @@ func (opts *AlterAccountOptions) validate() error {
 		return errors.Join(ErrNilOptions)
 	}
 	var errs []error
-	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag, opts.Drop, opts.Rename) {
-		errs = append(errs, errExactlyOneOf("CreateAccountOptions", "Set", "Unset", "SetTag", "UnsetTag", "Drop", "Rename"))
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag, opts.Drop, opts.Rename, opts.OrgAdmin) {
+		errs = append(errs, errExactlyOneOf("CreateAccountOptions", "Set", "Unset", "SetTag", "UnsetTag", "Drop", "Rename", "OrgAdmin"))
 	}
 	if valueSet(opts.Set) {
 		if err := opts.Set.validate(); err != nil {
@@ -126,6 +131,11 @@ func (opts *AlterAccountOptions) validate() error {
 			errs = append(errs, err)
 		}
 	}
+	if valueSet(opts.OrgAdmin) {
+		if err := opts.OrgAdmin.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	return errors.Join(errs...)
 }
 
@@ -252,6 +262,22 @@ func (opts *AccountDrop) validate() error {
 	return errors.Join(errs...)
 }
 
+type AccountOrgAdmin struct {
+	Name       AccountObjectIdentifier `ddl:"identifier"`
+	IsOrgAdmin *bool                   `ddl:"parameter" sql:"SET IS_ORG_ADMIN"`
+}
+
+func (opts *AccountOrgAdmin) validate() error {
+	var errs []error
+	if !ValidObjectIdentifier(opts.Name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.IsOrgAdmin) {
+		errs = append(errs, errNotSet("AccountOrgAdmin", "IsOrgAdmin"))
+	}
+	return errors.Join(errs...)
+}
+
 func (c *accounts) Alter(ctx context.Context, opts *AlterAccountOptions) error {
 	if opts == nil {
 		opts = &AlterAccountOptions{}
@@ -263,6 +289,7 @@ func (c *accounts) Alter(ctx context.Context, opts *AlterAccountOptions) error {
 type ShowAccountOptions struct {
 	show     bool  `ddl:"static" sql:"SHOW"`
 	accounts bool  `ddl:"static" sql:"ORGANIZATION ACCOUNTS"`
+	History  *bool `ddl:"keyword" sql:"HISTORY"`
 	Like     *Like `ddl:"keyword" sql:"LIKE"`
 }
 
@@ -290,6 +317,9 @@ type Account struct {
 	MarketplaceProviderBillingEntityName string
 	OldAccountURL                        string
 	IsOrgAdmin                           bool
+	// DroppedOn and ScheduledDeletionTime are returned only with SHOW ORGANIZATION ACCOUNTS HISTORY.
+	DroppedOn             *time.Time
+	ScheduledDeletionTime *time.Time
 }
 
 func (v *Account) ID() AccountObjectIdentifier {
@@ -318,6 +348,9 @@ type accountDBRow struct {
 	MarketplaceProviderBillingEntityName sql.NullString `db:"marketplace_provider_billing_entity_name"`
 	OldAccountURL                        string         `db:"old_account_url"`
 	IsOrgAdmin                           bool           `db:"is_org_admin"`
+	DroppedOn                            sql.NullTime   `db:"dropped_on"`
+	ScheduledDeletionTime                sql.NullTime   `db:"scheduled_deletion_time"`
+	RestoredOn                           sql.NullTime   `db:"restored_on"`
 }
 
 func (row accountDBRow) convert() *Account {
@@ -348,6 +381,12 @@ func (row accountDBRow) convert() *Account {
 	if row.RegionGroup.Valid {
 		acc.SnowflakeRegion = row.RegionGroup.String
 	}
+	if row.DroppedOn.Valid {
+		acc.DroppedOn = &row.DroppedOn.Time
+	}
+	if row.ScheduledDeletionTime.Valid {
+		acc.ScheduledDeletionTime = &row.ScheduledDeletionTime.Time
+	}
 	return acc
 }
 
@@ -378,6 +417,24 @@ func (c *accounts) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*A
 	return nil, ErrObjectNotExistOrAuthorized
 }
 
+func (c *accounts) ShowDroppedByID(ctx context.Context, id AccountObjectIdentifier) (*Account, error) {
+	accounts, err := c.Show(ctx, &ShowAccountOptions{
+		History: Bool(true),
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if account.AccountName == id.Name() && account.DroppedOn != nil {
+			return &account, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
 // DropAccountOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-account.
 type DropAccountOptions struct {
 	drop              bool                    `ddl:"static" sql:"DROP"`
@@ -428,3 +485,28 @@ func (c *accounts) Undrop(ctx context.Context, id AccountObjectIdentifier) error
 	_, err = c.client.exec(ctx, sql)
 	return err
 }
+
+type GlobalAccountParameter string
+
+const (
+	GlobalAccountParameterEnableAccountDatabaseReplication GlobalAccountParameter = "ENABLE_ACCOUNT_DATABASE_REPLICATION"
+)
+
+var AllGlobalAccountParameters = []GlobalAccountParameter{
+	GlobalAccountParameterEnableAccountDatabaseReplication,
+}
+
+// SetGlobalParameter is based on https://docs.snowflake.com/en/sql-reference/functions/system_global_account_set_parameter.
+func (c *accounts) SetGlobalParameter(ctx context.Context, id AccountIdentifier, parameter GlobalAccountParameter, value string) error {
+	_, err := c.client.exec(ctx, setGlobalParameterSQL(id, parameter, value))
+	return err
+}
+
+// setGlobalParameterSQL returns the SYSTEM$GLOBAL_ACCOUNT_SET_PARAMETER call with its arguments quoted and escaped as string literals.
+func setGlobalParameterSQL(id AccountIdentifier, parameter GlobalAccountParameter, value string) string {
+	return fmt.Sprintf(`SELECT SYSTEM$GLOBAL_ACCOUNT_SET_PARAMETER(%s, %s, %s)`,
+		SingleQuotes.Modify(id.organizationName+"."+id.accountName),
+		SingleQuotes.Modify(parameter),
+		SingleQuotes.Modify(value),
+	)
+}
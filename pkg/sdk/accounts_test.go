@@ -2,6 +2,8 @@ package sdk
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccountCreate(t *testing.T) {
@@ -186,6 +188,25 @@ func TestAccountAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT "oldname" DROP OLD URL`)
 	})
+
+	t.Run("set is org admin", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			OrgAdmin: &AccountOrgAdmin{
+				Name:       NewAccountObjectIdentifier("myaccount"),
+				IsOrgAdmin: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT "myaccount" SET IS_ORG_ADMIN = true`)
+	})
+
+	t.Run("validation: is org admin not set", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			OrgAdmin: &AccountOrgAdmin{
+				Name: NewAccountObjectIdentifier("myaccount"),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("AccountOrgAdmin", "IsOrgAdmin"))
+	})
 }
 
 func TestAccountShow(t *testing.T) {
@@ -202,4 +223,21 @@ func TestAccountShow(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `SHOW ORGANIZATION ACCOUNTS LIKE 'myaccount'`)
 	})
+
+	t.Run("with history", func(t *testing.T) {
+		opts := &ShowAccountOptions{
+			History: Bool(true),
+			Like: &Like{
+				Pattern: String("myaccount"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW ORGANIZATION ACCOUNTS HISTORY LIKE 'myaccount'`)
+	})
+}
+
+func TestAccountSetGlobalParameter(t *testing.T) {
+	t.Run("quotes and escapes the arguments", func(t *testing.T) {
+		sql := setGlobalParameterSQL(NewAccountIdentifier("org", "acc"), GlobalAccountParameterEnableAccountDatabaseReplication, `true'); DROP DATABASE x; --`)
+		assert.Equal(t, `SELECT SYSTEM$GLOBAL_ACCOUNT_SET_PARAMETER('org.acc', 'ENABLE_ACCOUNT_DATABASE_REPLICATION', 'true\'); DROP DATABASE x; --')`, sql)
+	})
 }
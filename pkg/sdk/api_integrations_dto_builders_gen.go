@@ -41,6 +41,11 @@ func (s *CreateApiIntegrationRequest) WithGoogleApiProviderParams(GoogleApiProvi
 	return s
 }
 
+func (s *CreateApiIntegrationRequest) WithGitHttpsApiProviderParams(GitHttpsApiProviderParams *GitHttpsApiParamsRequest) *CreateApiIntegrationRequest {
+	s.GitHttpsApiProviderParams = GitHttpsApiProviderParams
+	return s
+}
+
 func (s *CreateApiIntegrationRequest) WithApiBlockedPrefixes(ApiBlockedPrefixes []ApiIntegrationEndpointPrefix) *CreateApiIntegrationRequest {
 	s.ApiBlockedPrefixes = ApiBlockedPrefixes
 	return s
@@ -89,6 +94,15 @@ func NewGoogleApiParamsRequest(
 	return &s
 }
 
+func NewGitHttpsApiParamsRequest() *GitHttpsApiParamsRequest {
+	return &GitHttpsApiParamsRequest{}
+}
+
+func (s *GitHttpsApiParamsRequest) WithAllowedAuthenticationSecrets(AllowedAuthenticationSecrets []SchemaObjectIdentifier) *GitHttpsApiParamsRequest {
+	s.AllowedAuthenticationSecrets = AllowedAuthenticationSecrets
+	return s
+}
+
 func NewAlterApiIntegrationRequest(
 	name AccountObjectIdentifier,
 ) *AlterApiIntegrationRequest {
@@ -141,6 +155,11 @@ func (s *ApiIntegrationSetRequest) WithGoogleParams(GoogleParams *SetGoogleApiPa
 	return s
 }
 
+func (s *ApiIntegrationSetRequest) WithGitHttpsParams(GitHttpsParams *SetGitHttpsApiParamsRequest) *ApiIntegrationSetRequest {
+	s.GitHttpsParams = GitHttpsParams
+	return s
+}
+
 func (s *ApiIntegrationSetRequest) WithEnabled(Enabled *bool) *ApiIntegrationSetRequest {
 	s.Enabled = Enabled
 	return s
@@ -202,6 +221,14 @@ func NewSetGoogleApiParamsRequest(
 	return &s
 }
 
+func NewSetGitHttpsApiParamsRequest(
+	AllowedAuthenticationSecrets []SchemaObjectIdentifier,
+) *SetGitHttpsApiParamsRequest {
+	s := SetGitHttpsApiParamsRequest{}
+	s.AllowedAuthenticationSecrets = AllowedAuthenticationSecrets
+	return &s
+}
+
 func NewApiIntegrationUnsetRequest() *ApiIntegrationUnsetRequest {
 	return &ApiIntegrationUnsetRequest{}
 }
@@ -11,16 +11,17 @@ var (
 )
 
 type CreateApiIntegrationRequest struct {
-	OrReplace               *bool
-	IfNotExists             *bool
-	name                    AccountObjectIdentifier // required
-	AwsApiProviderParams    *AwsApiParamsRequest
-	AzureApiProviderParams  *AzureApiParamsRequest
-	GoogleApiProviderParams *GoogleApiParamsRequest
-	ApiAllowedPrefixes      []ApiIntegrationEndpointPrefix // required
-	ApiBlockedPrefixes      []ApiIntegrationEndpointPrefix
-	Enabled                 bool // required
-	Comment                 *string
+	OrReplace                 *bool
+	IfNotExists               *bool
+	name                      AccountObjectIdentifier // required
+	AwsApiProviderParams      *AwsApiParamsRequest
+	AzureApiProviderParams    *AzureApiParamsRequest
+	GoogleApiProviderParams   *GoogleApiParamsRequest
+	GitHttpsApiProviderParams *GitHttpsApiParamsRequest
+	ApiAllowedPrefixes        []ApiIntegrationEndpointPrefix // required
+	ApiBlockedPrefixes        []ApiIntegrationEndpointPrefix
+	Enabled                   bool // required
+	Comment                   *string
 }
 
 func (r *CreateApiIntegrationRequest) GetName() AccountObjectIdentifier {
@@ -43,6 +44,10 @@ type GoogleApiParamsRequest struct {
 	GoogleAudience string // required
 }
 
+type GitHttpsApiParamsRequest struct {
+	AllowedAuthenticationSecrets []SchemaObjectIdentifier
+}
+
 type AlterApiIntegrationRequest struct {
 	IfExists  *bool
 	name      AccountObjectIdentifier // required
@@ -56,6 +61,7 @@ type ApiIntegrationSetRequest struct {
 	AwsParams          *SetAwsApiParamsRequest
 	AzureParams        *SetAzureApiParamsRequest
 	GoogleParams       *SetGoogleApiParamsRequest
+	GitHttpsParams     *SetGitHttpsApiParamsRequest
 	Enabled            *bool
 	ApiAllowedPrefixes []ApiIntegrationEndpointPrefix
 	ApiBlockedPrefixes []ApiIntegrationEndpointPrefix
@@ -77,6 +83,10 @@ type SetGoogleApiParamsRequest struct {
 	GoogleAudience string // required
 }
 
+type SetGitHttpsApiParamsRequest struct {
+	AllowedAuthenticationSecrets []SchemaObjectIdentifier // required
+}
+
 type ApiIntegrationUnsetRequest struct {
 	ApiKey             *bool
 	Enabled            *bool
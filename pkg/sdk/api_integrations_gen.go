@@ -17,18 +17,19 @@ type ApiIntegrations interface {
 
 // CreateApiIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-api-integration.
 type CreateApiIntegrationOptions struct {
-	create                  bool                           `ddl:"static" sql:"CREATE"`
-	OrReplace               *bool                          `ddl:"keyword" sql:"OR REPLACE"`
-	apiIntegration          bool                           `ddl:"static" sql:"API INTEGRATION"`
-	IfNotExists             *bool                          `ddl:"keyword" sql:"IF NOT EXISTS"`
-	name                    AccountObjectIdentifier        `ddl:"identifier"`
-	AwsApiProviderParams    *AwsApiParams                  `ddl:"keyword"`
-	AzureApiProviderParams  *AzureApiParams                `ddl:"keyword"`
-	GoogleApiProviderParams *GoogleApiParams               `ddl:"keyword"`
-	ApiAllowedPrefixes      []ApiIntegrationEndpointPrefix `ddl:"parameter,parentheses" sql:"API_ALLOWED_PREFIXES"`
-	ApiBlockedPrefixes      []ApiIntegrationEndpointPrefix `ddl:"parameter,parentheses" sql:"API_BLOCKED_PREFIXES"`
-	Enabled                 bool                           `ddl:"parameter" sql:"ENABLED"`
-	Comment                 *string                        `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	create                    bool                           `ddl:"static" sql:"CREATE"`
+	OrReplace                 *bool                          `ddl:"keyword" sql:"OR REPLACE"`
+	apiIntegration            bool                           `ddl:"static" sql:"API INTEGRATION"`
+	IfNotExists               *bool                          `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                      AccountObjectIdentifier        `ddl:"identifier"`
+	AwsApiProviderParams      *AwsApiParams                  `ddl:"keyword"`
+	AzureApiProviderParams    *AzureApiParams                `ddl:"keyword"`
+	GoogleApiProviderParams   *GoogleApiParams               `ddl:"keyword"`
+	GitHttpsApiProviderParams *GitHttpsApiParams             `ddl:"keyword"`
+	ApiAllowedPrefixes        []ApiIntegrationEndpointPrefix `ddl:"parameter,parentheses" sql:"API_ALLOWED_PREFIXES"`
+	ApiBlockedPrefixes        []ApiIntegrationEndpointPrefix `ddl:"parameter,parentheses" sql:"API_BLOCKED_PREFIXES"`
+	Enabled                   bool                           `ddl:"parameter" sql:"ENABLED"`
+	Comment                   *string                        `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 type ApiIntegrationEndpointPrefix struct {
@@ -53,6 +54,11 @@ type GoogleApiParams struct {
 	GoogleAudience string `ddl:"parameter,single_quotes" sql:"GOOGLE_AUDIENCE"`
 }
 
+type GitHttpsApiParams struct {
+	apiProvider                  string                   `ddl:"static" sql:"API_PROVIDER = git_https_api"`
+	AllowedAuthenticationSecrets []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"ALLOWED_AUTHENTICATION_SECRETS"`
+}
+
 // AlterApiIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-api-integration.
 type AlterApiIntegrationOptions struct {
 	alter          bool                    `ddl:"static" sql:"ALTER"`
@@ -69,6 +75,7 @@ type ApiIntegrationSet struct {
 	AwsParams          *SetAwsApiParams               `ddl:"keyword"`
 	AzureParams        *SetAzureApiParams             `ddl:"keyword"`
 	GoogleParams       *SetGoogleApiParams            `ddl:"keyword"`
+	GitHttpsParams     *SetGitHttpsApiParams          `ddl:"keyword"`
 	Enabled            *bool                          `ddl:"parameter" sql:"ENABLED"`
 	ApiAllowedPrefixes []ApiIntegrationEndpointPrefix `ddl:"parameter,parentheses" sql:"API_ALLOWED_PREFIXES"`
 	ApiBlockedPrefixes []ApiIntegrationEndpointPrefix `ddl:"parameter,parentheses" sql:"API_BLOCKED_PREFIXES"`
@@ -90,6 +97,10 @@ type SetGoogleApiParams struct {
 	GoogleAudience string `ddl:"parameter,single_quotes" sql:"GOOGLE_AUDIENCE"`
 }
 
+type SetGitHttpsApiParams struct {
+	AllowedAuthenticationSecrets []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"ALLOWED_AUTHENTICATION_SECRETS"`
+}
+
 type ApiIntegrationUnset struct {
 	ApiKey             *bool `ddl:"keyword" sql:"API_KEY"`
 	Enabled            *bool `ddl:"keyword" sql:"ENABLED"`
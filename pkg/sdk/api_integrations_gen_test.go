@@ -6,6 +6,7 @@ const (
 	awsAllowedPrefix    = "https://123456.execute-api.us-west-2.amazonaws.com/prod/"
 	azureAllowedPrefix  = "https://apim-hello-world.azure-api.net/"
 	googleAllowedPrefix = "https://gateway-id-123456.uc.gateway.dev/"
+	gitAllowedPrefix    = "https://github.com/my-account/"
 
 	apiAwsRoleArn        = "arn:aws:iam::000000000001:/role/test"
 	azureTenantId        = "00000000-0000-0000-0000-000000000000"
@@ -54,6 +55,16 @@ func TestApiIntegrations_Create(t *testing.T) {
 		}
 	}
 
+	// Minimal valid CreateApiIntegrationOptions for Git HTTPS
+	defaultOptsGit := func() *CreateApiIntegrationOptions {
+		return &CreateApiIntegrationOptions{
+			name:                      id,
+			GitHttpsApiProviderParams: &GitHttpsApiParams{},
+			ApiAllowedPrefixes:        []ApiIntegrationEndpointPrefix{{Path: gitAllowedPrefix}},
+			Enabled:                   true,
+		}
+	}
+
 	defaultOpts := defaultOptsAws
 
 	t.Run("validation: nil options", func(t *testing.T) {
@@ -74,16 +85,16 @@ func TestApiIntegrations_Create(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateApiIntegrationOptions", "IfNotExists", "OrReplace"))
 	})
 
-	t.Run("validation: exactly one field from [opts.AwsApiProviderParams opts.AzureApiProviderParams opts.GoogleApiProviderParams] should be present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.AwsApiProviderParams opts.AzureApiProviderParams opts.GoogleApiProviderParams opts.GitHttpsApiProviderParams] should be present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.AwsApiProviderParams = nil
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateApiIntegrationOptions", "AwsApiProviderParams", "AzureApiProviderParams", "GoogleApiProviderParams"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateApiIntegrationOptions", "AwsApiProviderParams", "AzureApiProviderParams", "GoogleApiProviderParams", "GitHttpsApiProviderParams"))
 	})
 
-	t.Run("validation: exactly one field from [opts.AwsApiProviderParams opts.AzureApiProviderParams opts.GoogleApiProviderParams] should be present - more present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.AwsApiProviderParams opts.AzureApiProviderParams opts.GoogleApiProviderParams opts.GitHttpsApiProviderParams] should be present - more present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.AzureApiProviderParams = new(AzureApiParams)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateApiIntegrationOptions", "AwsApiProviderParams", "AzureApiProviderParams", "GoogleApiProviderParams"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateApiIntegrationOptions", "AwsApiProviderParams", "AzureApiProviderParams", "GoogleApiProviderParams", "GitHttpsApiProviderParams"))
 	})
 
 	t.Run("basic", func(t *testing.T) {
@@ -120,6 +131,23 @@ func TestApiIntegrations_Create(t *testing.T) {
 		opts.Comment = String("some comment")
 		assertOptsValidAndSQLEquals(t, opts, `CREATE API INTEGRATION IF NOT EXISTS %s API_PROVIDER = google_api_gateway GOOGLE_AUDIENCE = '%s' API_ALLOWED_PREFIXES = ('%s') API_BLOCKED_PREFIXES = ('%s', '%s') ENABLED = false COMMENT = 'some comment'`, id.FullyQualifiedName(), googleAudience, googleAllowedPrefix, awsAllowedPrefix, azureAllowedPrefix)
 	})
+
+	t.Run("basic - git https", func(t *testing.T) {
+		opts := defaultOptsGit()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE API INTEGRATION %s API_PROVIDER = git_https_api API_ALLOWED_PREFIXES = ('%s') ENABLED = true`, id.FullyQualifiedName(), gitAllowedPrefix)
+	})
+
+	t.Run("all options - git https", func(t *testing.T) {
+		secretId := RandomSchemaObjectIdentifier()
+		secretId2 := RandomSchemaObjectIdentifier()
+		opts := defaultOptsGit()
+		opts.OrReplace = Bool(true)
+		opts.GitHttpsApiProviderParams.AllowedAuthenticationSecrets = []SchemaObjectIdentifier{secretId, secretId2}
+		opts.ApiBlockedPrefixes = []ApiIntegrationEndpointPrefix{{Path: awsAllowedPrefix}}
+		opts.Enabled = false
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE API INTEGRATION %s API_PROVIDER = git_https_api ALLOWED_AUTHENTICATION_SECRETS = (%s, %s) API_ALLOWED_PREFIXES = ('%s') API_BLOCKED_PREFIXES = ('%s') ENABLED = false COMMENT = 'some comment'`, id.FullyQualifiedName(), secretId.FullyQualifiedName(), secretId2.FullyQualifiedName(), gitAllowedPrefix, awsAllowedPrefix)
+	})
 }
 
 func TestApiIntegrations_Alter(t *testing.T) {
@@ -180,19 +208,19 @@ func TestApiIntegrations_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApiIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
 	})
 
-	t.Run("validation: conflicting fields for [opts.Set.AwsParams opts.Set.AzureParams opts.Set.GoogleParams]", func(t *testing.T) {
+	t.Run("validation: conflicting fields for [opts.Set.AwsParams opts.Set.AzureParams opts.Set.GoogleParams opts.Set.GitHttpsParams]", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &ApiIntegrationSet{
 			AwsParams:   &SetAwsApiParams{ApiKey: String("key")},
 			AzureParams: &SetAzureApiParams{ApiKey: String("key")},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errMoreThanOneOf("AlterApiIntegrationOptions.Set", "AwsParams", "AzureParams", "GoogleParams"))
+		assertOptsInvalidJoinedErrors(t, opts, errMoreThanOneOf("AlterApiIntegrationOptions.Set", "AwsParams", "AzureParams", "GoogleParams", "GitHttpsParams"))
 	})
 
-	t.Run("validation: at least one of the fields [opts.Set.AwsParams opts.Set.AzureParams opts.Set.GoogleParams opts.Set.Enabled opts.Set.ApiAllowedPrefixes opts.Set.ApiBlockedPrefixes opts.Set.Comment] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set.AwsParams opts.Set.AzureParams opts.Set.GoogleParams opts.Set.GitHttpsParams opts.Set.Enabled opts.Set.ApiAllowedPrefixes opts.Set.ApiBlockedPrefixes opts.Set.Comment] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &ApiIntegrationSet{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApiIntegrationOptions.Set", "AwsParams", "AzureParams", "GoogleParams", "Enabled", "ApiAllowedPrefixes", "ApiBlockedPrefixes", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApiIntegrationOptions.Set", "AwsParams", "AzureParams", "GoogleParams", "GitHttpsParams", "Enabled", "ApiAllowedPrefixes", "ApiBlockedPrefixes", "Comment"))
 	})
 
 	t.Run("validation: at least one of the fields [opts.Set.AwsParams.ApiAwsRoleArn opts.Set.AwsParams.ApiKey] should be set", func(t *testing.T) {
@@ -258,6 +286,16 @@ func TestApiIntegrations_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER API INTEGRATION %s SET ENABLED = true API_ALLOWED_PREFIXES = ('%s') API_BLOCKED_PREFIXES = ('%s', '%s') COMMENT = 'comment'", id.FullyQualifiedName(), googleAllowedPrefix, awsAllowedPrefix, azureAllowedPrefix)
 	})
 
+	t.Run("set - git https", func(t *testing.T) {
+		secretId := RandomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = &ApiIntegrationSet{
+			GitHttpsParams:     &SetGitHttpsApiParams{AllowedAuthenticationSecrets: []SchemaObjectIdentifier{secretId}},
+			ApiAllowedPrefixes: []ApiIntegrationEndpointPrefix{{Path: gitAllowedPrefix}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER API INTEGRATION %s SET ALLOWED_AUTHENTICATION_SECRETS = (%s) API_ALLOWED_PREFIXES = ('%s')", id.FullyQualifiedName(), secretId.FullyQualifiedName(), gitAllowedPrefix)
+	})
+
 	t.Run("unset single", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Unset = &ApiIntegrationUnset{
@@ -88,6 +88,11 @@ func (r *CreateApiIntegrationRequest) toOpts() *CreateApiIntegrationOptions {
 			GoogleAudience: r.GoogleApiProviderParams.GoogleAudience,
 		}
 	}
+	if r.GitHttpsApiProviderParams != nil {
+		opts.GitHttpsApiProviderParams = &GitHttpsApiParams{
+			AllowedAuthenticationSecrets: r.GitHttpsApiProviderParams.AllowedAuthenticationSecrets,
+		}
+	}
 	return opts
 }
 
@@ -124,6 +129,11 @@ func (r *AlterApiIntegrationRequest) toOpts() *AlterApiIntegrationOptions {
 				GoogleAudience: r.Set.GoogleParams.GoogleAudience,
 			}
 		}
+		if r.Set.GitHttpsParams != nil {
+			opts.Set.GitHttpsParams = &SetGitHttpsApiParams{
+				AllowedAuthenticationSecrets: r.Set.GitHttpsParams.AllowedAuthenticationSecrets,
+			}
+		}
 	}
 	if r.Unset != nil {
 		opts.Unset = &ApiIntegrationUnset{
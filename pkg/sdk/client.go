@@ -55,6 +55,7 @@ type Client struct {
 	FailoverGroups           FailoverGroups
 	FileFormats              FileFormats
 	Functions                Functions
+	GitRepositories          GitRepositories
 	Grants                   Grants
 	ManagedAccounts          ManagedAccounts
 	MaskingPolicies          MaskingPolicies
@@ -75,11 +76,13 @@ type Client struct {
 	SessionPolicies          SessionPolicies
 	Sessions                 Sessions
 	Shares                   Shares
+	StageFiles               StageFiles
 	Stages                   Stages
 	StorageIntegrations      StorageIntegrations
 	Streamlits               Streamlits
 	Streams                  Streams
 	Tables                   Tables
+	TagReferences            TagReferences
 	Tags                     Tags
 	Tasks                    Tasks
 	Users                    Users
@@ -209,6 +212,7 @@ func (c *Client) initialize() {
 	c.FailoverGroups = &failoverGroups{client: c}
 	c.FileFormats = &fileFormats{client: c}
 	c.Functions = &functions{client: c}
+	c.GitRepositories = &gitRepositories{client: c}
 	c.Grants = &grants{client: c}
 	c.ManagedAccounts = &managedAccounts{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
@@ -230,12 +234,14 @@ func (c *Client) initialize() {
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
+	c.StageFiles = &stageFiles{client: c}
 	c.Stages = &stages{client: c}
 	c.StorageIntegrations = &storageIntegrations{client: c}
 	c.Streamlits = &streamlits{client: c}
 	c.Streams = &streams{client: c}
 	c.SystemFunctions = &systemFunctions{client: c}
 	c.Tables = &tables{client: c}
+	c.TagReferences = &tagReference{client: c}
 	c.Tags = &tags{client: c}
 	c.Tasks = &tasks{client: c}
 	c.Users = &users{client: c}
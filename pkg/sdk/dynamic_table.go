@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 )
 
@@ -19,13 +20,16 @@ type DynamicTables interface {
 type createDynamicTableOptions struct {
 	create       bool                     `ddl:"static" sql:"CREATE"`
 	OrReplace    *bool                    `ddl:"keyword" sql:"OR REPLACE"`
+	Transient    *bool                    `ddl:"keyword" sql:"TRANSIENT"`
 	dynamicTable bool                     `ddl:"static" sql:"DYNAMIC TABLE"`
 	name         SchemaObjectIdentifier   `ddl:"identifier"`
 	targetLag    TargetLag                `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
 	Initialize   *DynamicTableInitialize  `ddl:"parameter,no_quotes" sql:"INITIALIZE"`
 	RefreshMode  *DynamicTableRefreshMode `ddl:"parameter,no_quotes" sql:"REFRESH_MODE"`
 	warehouse    AccountObjectIdentifier  `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	ClusterBy    []string                 `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
 	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	CopyGrants   *bool                    `ddl:"keyword" sql:"COPY GRANTS"`
 	query        string                   `ddl:"parameter,no_equals,no_quotes" sql:"AS"`
 }
 
@@ -45,10 +49,12 @@ type alterDynamicTableOptions struct {
 	dynamicTable bool                   `ddl:"static" sql:"DYNAMIC TABLE"`
 	name         SchemaObjectIdentifier `ddl:"identifier"`
 
-	Suspend *bool            `ddl:"keyword" sql:"SUSPEND"`
-	Resume  *bool            `ddl:"keyword" sql:"RESUME"`
-	Refresh *bool            `ddl:"keyword" sql:"REFRESH"`
-	Set     *DynamicTableSet `ddl:"keyword" sql:"SET"`
+	Suspend           *bool            `ddl:"keyword" sql:"SUSPEND"`
+	Resume            *bool            `ddl:"keyword" sql:"RESUME"`
+	Refresh           *bool            `ddl:"keyword" sql:"REFRESH"`
+	Set               *DynamicTableSet `ddl:"keyword" sql:"SET"`
+	ClusterBy         []string         `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	DropClusteringKey *bool            `ddl:"keyword" sql:"DROP CLUSTERING KEY"`
 }
 
 // dropDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-dynamic-table
@@ -130,6 +136,14 @@ func (dt *DynamicTable) ID() SchemaObjectIdentifier {
 	return NewSchemaObjectIdentifier(dt.DatabaseName, dt.SchemaName, dt.Name)
 }
 
+// GetClusterByKeys converts the SHOW DYNAMIC TABLES result for ClusterBy (e.g. LINEAR(a, b)) to a list of keys.
+func (dt *DynamicTable) GetClusterByKeys() []string {
+	if dt.ClusterBy == "" {
+		return nil
+	}
+	return splitClusterBy(strings.TrimSuffix(strings.Replace(dt.ClusterBy, "LINEAR(", "", 1), ")"))
+}
+
 type dynamicTableRow struct {
 	CreatedOn           time.Time      `db:"created_on"`
 	Name                string         `db:"name"`
@@ -11,6 +11,7 @@ var (
 
 type CreateDynamicTableRequest struct {
 	orReplace bool
+	transient bool
 
 	name      SchemaObjectIdentifier  // required
 	warehouse AccountObjectIdentifier // required
@@ -20,16 +21,20 @@ type CreateDynamicTableRequest struct {
 	comment     *string
 	refreshMode *DynamicTableRefreshMode
 	initialize  *DynamicTableInitialize
+	clusterBy   []string
+	copyGrants  bool
 }
 
 type AlterDynamicTableRequest struct {
 	name SchemaObjectIdentifier // required
 
 	// One of
-	suspend *bool
-	resume  *bool
-	refresh *bool
-	set     *DynamicTableSetRequest
+	suspend           *bool
+	resume            *bool
+	refresh           *bool
+	set               *DynamicTableSetRequest
+	clusterBy         []string
+	dropClusteringKey *bool
 }
 
 type DynamicTableSetRequest struct {
@@ -19,6 +19,11 @@ func (s *CreateDynamicTableRequest) WithOrReplace(orReplace bool) *CreateDynamic
 	return s
 }
 
+func (s *CreateDynamicTableRequest) WithTransient(transient bool) *CreateDynamicTableRequest {
+	s.transient = transient
+	return s
+}
+
 func (s *CreateDynamicTableRequest) WithComment(comment *string) *CreateDynamicTableRequest {
 	s.comment = comment
 	return s
@@ -34,6 +39,16 @@ func (s *CreateDynamicTableRequest) WithInitialize(initialize DynamicTableInitia
 	return s
 }
 
+func (s *CreateDynamicTableRequest) WithClusterBy(clusterBy []string) *CreateDynamicTableRequest {
+	s.clusterBy = clusterBy
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithCopyGrants(copyGrants bool) *CreateDynamicTableRequest {
+	s.copyGrants = copyGrants
+	return s
+}
+
 func NewAlterDynamicTableRequest(
 	name SchemaObjectIdentifier,
 ) *AlterDynamicTableRequest {
@@ -62,6 +77,16 @@ func (s *AlterDynamicTableRequest) WithSet(set *DynamicTableSetRequest) *AlterDy
 	return s
 }
 
+func (s *AlterDynamicTableRequest) WithClusterBy(clusterBy []string) *AlterDynamicTableRequest {
+	s.clusterBy = clusterBy
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithDropClusteringKey(dropClusteringKey *bool) *AlterDynamicTableRequest {
+	s.dropClusteringKey = dropClusteringKey
+	return s
+}
+
 func NewDynamicTableSetRequest() *DynamicTableSetRequest {
 	return &DynamicTableSetRequest{}
 }
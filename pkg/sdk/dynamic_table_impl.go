@@ -58,6 +58,7 @@ func (v *dynamicTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier)
 func (s *CreateDynamicTableRequest) toOpts() *createDynamicTableOptions {
 	return &createDynamicTableOptions{
 		OrReplace:   Bool(s.orReplace),
+		Transient:   Bool(s.transient),
 		name:        s.name,
 		warehouse:   s.warehouse,
 		targetLag:   s.targetLag,
@@ -65,6 +66,8 @@ func (s *CreateDynamicTableRequest) toOpts() *createDynamicTableOptions {
 		Comment:     s.comment,
 		RefreshMode: s.refreshMode,
 		Initialize:  s.initialize,
+		ClusterBy:   s.clusterBy,
+		CopyGrants:  Bool(s.copyGrants),
 	}
 }
 
@@ -84,6 +87,12 @@ func (s *AlterDynamicTableRequest) toOpts() *alterDynamicTableOptions {
 	if s.set != nil {
 		opts.Set = &DynamicTableSet{s.set.targetLag, s.set.warehourse}
 	}
+	if len(s.clusterBy) > 0 {
+		opts.ClusterBy = s.clusterBy
+	}
+	if s.dropClusteringKey != nil {
+		opts.DropClusteringKey = s.dropClusteringKey
+	}
 	return &opts
 }
 
@@ -41,7 +41,18 @@ func TestDynamicTableCreate(t *testing.T) {
 		opts.Comment = String("comment")
 		opts.RefreshMode = DynamicTableRefreshModeFull.ToPointer()
 		opts.Initialize = DynamicTableInitializeOnSchedule.ToPointer()
-		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE DYNAMIC TABLE %s TARGET_LAG = '1 minutes' INITIALIZE = ON_SCHEDULE REFRESH_MODE = FULL WAREHOUSE = "warehouse_name" COMMENT = 'comment' AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
+		opts.Transient = Bool(true)
+		opts.ClusterBy = []string{"product_id", "LOWER(product_name)"}
+		opts.CopyGrants = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TRANSIENT DYNAMIC TABLE %s TARGET_LAG = '1 minutes' INITIALIZE = ON_SCHEDULE REFRESH_MODE = FULL WAREHOUSE = "warehouse_name" CLUSTER BY (product_id, LOWER(product_name)) COMMENT = 'comment' COPY GRANTS AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
+	})
+
+	t.Run("downstream target lag", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.targetLag = TargetLag{
+			Downstream: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE DYNAMIC TABLE %s TARGET_LAG = DOWNSTREAM WAREHOUSE = "warehouse_name" AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
 	})
 }
 
@@ -66,19 +77,19 @@ func TestDynamicTableAlter(t *testing.T) {
 
 	t.Run("validation: no alter action", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "ClusterBy", "DropClusteringKey"))
 	})
 
 	t.Run("validation: multiple alter actions", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Resume = Bool(true)
 		opts.Suspend = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "ClusterBy", "DropClusteringKey"))
 	})
 
 	t.Run("validation: no property to unset", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "ClusterBy", "DropClusteringKey"))
 	})
 
 	t.Run("suspend", func(t *testing.T) {
@@ -93,6 +104,24 @@ func TestDynamicTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s RESUME`, id.FullyQualifiedName())
 	})
 
+	t.Run("refresh", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s REFRESH`, id.FullyQualifiedName())
+	})
+
+	t.Run("cluster by", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ClusterBy = []string{"a", "b"}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s CLUSTER BY (a, b)`, id.FullyQualifiedName())
+	})
+
+	t.Run("drop clustering key", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropClusteringKey = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s DROP CLUSTERING KEY`, id.FullyQualifiedName())
+	})
+
 	t.Run("set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &DynamicTableSet{
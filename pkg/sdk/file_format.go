@@ -160,7 +160,7 @@ func (row FileFormatRow) convert() *FileFormat {
 		ff.Options.JSONTimestampFormat = &inputOptions.TimestampFormat
 		ff.Options.JSONBinaryFormat = (*BinaryFormat)(&inputOptions.BinaryFormat)
 		ff.Options.JSONTrimSpace = &inputOptions.TrimSpace
-		ff.Options.JSONNullIf = newNullIf
+		ff.Options.JSONNullIf = &newNullIf
 		ff.Options.JSONFileExtension = &inputOptions.FileExtension
 		ff.Options.JSONEnableOctal = &inputOptions.EnableOctal
 		ff.Options.JSONAllowDuplicate = &inputOptions.AllowDuplicate
@@ -217,6 +217,12 @@ var (
 	BinaryFormatUTF8   BinaryFormat = "UTF8"
 )
 
+var AllBinaryFormats = []BinaryFormat{
+	BinaryFormatHex,
+	BinaryFormatBase64,
+	BinaryFormatUTF8,
+}
+
 type CSVCompression string
 
 var (
@@ -230,6 +236,17 @@ var (
 	CSVCompressionNone       CSVCompression = "NONE"
 )
 
+var AllCSVCompressions = []CSVCompression{
+	CSVCompressionAuto,
+	CSVCompressionGzip,
+	CSVCompressionBz2,
+	CSVCompressionBrotli,
+	CSVCompressionZstd,
+	CSVCompressionDeflate,
+	CSVCompressionRawDeflate,
+	CSVCompressionNone,
+}
+
 type CSVEncoding string
 
 var (
@@ -268,6 +285,42 @@ var (
 	CSVEncodingWINDOWS1256 CSVEncoding = "WINDOWS1256"
 )
 
+var AllCSVEncodings = []CSVEncoding{
+	CSVEncodingBIG5,
+	CSVEncodingEUCJP,
+	CSVEncodingEUCKR,
+	CSVEncodingGB18030,
+	CSVEncodingIBM420,
+	CSVEncodingIBM424,
+	CSVEncodingISO2022CN,
+	CSVEncodingISO2022JP,
+	CSVEncodingISO2022KR,
+	CSVEncodingISO88591,
+	CSVEncodingISO88592,
+	CSVEncodingISO88595,
+	CSVEncodingISO88596,
+	CSVEncodingISO88597,
+	CSVEncodingISO88598,
+	CSVEncodingISO88599,
+	CSVEncodingISO885915,
+	CSVEncodingKOI8R,
+	CSVEncodingSHIFTJIS,
+	CSVEncodingUTF8,
+	CSVEncodingUTF16,
+	CSVEncodingUTF16BE,
+	CSVEncodingUTF16LE,
+	CSVEncodingUTF32,
+	CSVEncodingUTF32BE,
+	CSVEncodingUTF32LE,
+	CSVEncodingWINDOWS1250,
+	CSVEncodingWINDOWS1251,
+	CSVEncodingWINDOWS1252,
+	CSVEncodingWINDOWS1253,
+	CSVEncodingWINDOWS1254,
+	CSVEncodingWINDOWS1255,
+	CSVEncodingWINDOWS1256,
+}
+
 type JSONCompression string
 
 var (
@@ -281,6 +334,17 @@ var (
 	JSONCompressionNone       JSONCompression = "NONE"
 )
 
+var AllJSONCompressions = []JSONCompression{
+	JSONCompressionAuto,
+	JSONCompressionGzip,
+	JSONCompressionBz2,
+	JSONCompressionBrotli,
+	JSONCompressionZstd,
+	JSONCompressionDeflate,
+	JSONCompressionRawDeflate,
+	JSONCompressionNone,
+}
+
 type AvroCompression string
 
 var (
@@ -293,6 +357,16 @@ var (
 	AvroCompressionNone       AvroCompression = "NONE"
 )
 
+var AllAvroCompressions = []AvroCompression{
+	AvroCompressionAuto,
+	AvroCompressionGzip,
+	AvroCompressionBrotli,
+	AvroCompressionZstd,
+	AvroCompressionDeflate,
+	AvroCompressionRawDeflate,
+	AvroCompressionNone,
+}
+
 type ParquetCompression string
 
 var (
@@ -302,6 +376,13 @@ var (
 	ParquetCompressionNone   ParquetCompression = "NONE"
 )
 
+var AllParquetCompressions = []ParquetCompression{
+	ParquetCompressionAuto,
+	ParquetCompressionLzo,
+	ParquetCompressionSnappy,
+	ParquetCompressionNone,
+}
+
 type XMLCompression string
 
 var (
@@ -315,6 +396,17 @@ var (
 	XMLCompressionNone       XMLCompression = "NONE"
 )
 
+var AllXMLCompressions = []XMLCompression{
+	XMLCompressionAuto,
+	XMLCompressionGzip,
+	XMLCompressionBz2,
+	XMLCompressionBrotli,
+	XMLCompressionZstd,
+	XMLCompressionDeflate,
+	XMLCompressionRawDeflate,
+	XMLCompressionNone,
+}
+
 type NullString struct {
 	S string `ddl:"parameter,no_equals,single_quotes"`
 }
@@ -415,7 +507,7 @@ type FileFormatTypeOptions struct {
 	CSVEscapeUnenclosedField      *string         `ddl:"parameter,single_quotes" sql:"ESCAPE_UNENCLOSED_FIELD"`
 	CSVTrimSpace                  *bool           `ddl:"parameter" sql:"TRIM_SPACE"`
 	CSVFieldOptionallyEnclosedBy  *string         `ddl:"parameter,single_quotes" sql:"FIELD_OPTIONALLY_ENCLOSED_BY"`
-	CSVNullIf                     *[]NullString   `ddl:"parameter,parentheses" sql:"NULL_IF"`
+	CSVNullIf                     *[]NullString   `ddl:"parameter,must_parentheses" sql:"NULL_IF"`
 	CSVErrorOnColumnCountMismatch *bool           `ddl:"parameter" sql:"ERROR_ON_COLUMN_COUNT_MISMATCH"`
 	CSVReplaceInvalidCharacters   *bool           `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
 	CSVEmptyFieldAsNull           *bool           `ddl:"parameter" sql:"EMPTY_FIELD_AS_NULL"`
@@ -429,7 +521,7 @@ type FileFormatTypeOptions struct {
 	JSONTimestampFormat          *string          `ddl:"parameter,single_quotes" sql:"TIMESTAMP_FORMAT"`
 	JSONBinaryFormat             *BinaryFormat    `ddl:"parameter" sql:"BINARY_FORMAT"`
 	JSONTrimSpace                *bool            `ddl:"parameter" sql:"TRIM_SPACE"`
-	JSONNullIf                   []NullString     `ddl:"parameter,parentheses" sql:"NULL_IF"`
+	JSONNullIf                   *[]NullString    `ddl:"parameter,must_parentheses" sql:"NULL_IF"`
 	JSONFileExtension            *string          `ddl:"parameter,single_quotes" sql:"FILE_EXTENSION"`
 	JSONEnableOctal              *bool            `ddl:"parameter" sql:"ENABLE_OCTAL"`
 	JSONAllowDuplicate           *bool            `ddl:"parameter" sql:"ALLOW_DUPLICATE"`
@@ -443,12 +535,12 @@ type FileFormatTypeOptions struct {
 	AvroCompression              *AvroCompression `ddl:"parameter" sql:"COMPRESSION"`
 	AvroTrimSpace                *bool            `ddl:"parameter" sql:"TRIM_SPACE"`
 	AvroReplaceInvalidCharacters *bool            `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
-	AvroNullIf                   *[]NullString    `ddl:"parameter,parentheses" sql:"NULL_IF"`
+	AvroNullIf                   *[]NullString    `ddl:"parameter,must_parentheses" sql:"NULL_IF"`
 
 	// ORC type options
 	ORCTrimSpace                *bool         `ddl:"parameter" sql:"TRIM_SPACE"`
 	ORCReplaceInvalidCharacters *bool         `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
-	ORCNullIf                   *[]NullString `ddl:"parameter,parentheses" sql:"NULL_IF"`
+	ORCNullIf                   *[]NullString `ddl:"parameter,must_parentheses" sql:"NULL_IF"`
 
 	// PARQUET type options
 	ParquetCompression              *ParquetCompression `ddl:"parameter" sql:"COMPRESSION"`
@@ -456,7 +548,7 @@ type FileFormatTypeOptions struct {
 	ParquetBinaryAsText             *bool               `ddl:"parameter" sql:"BINARY_AS_TEXT"`
 	ParquetTrimSpace                *bool               `ddl:"parameter" sql:"TRIM_SPACE"`
 	ParquetReplaceInvalidCharacters *bool               `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
-	ParquetNullIf                   *[]NullString       `ddl:"parameter,parentheses" sql:"NULL_IF"`
+	ParquetNullIf                   *[]NullString       `ddl:"parameter,must_parentheses" sql:"NULL_IF"`
 
 	// XML type options
 	XMLCompression              *XMLCompression `ddl:"parameter" sql:"COMPRESSION"`
@@ -668,6 +760,8 @@ func (v *fileFormats) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (
 type FileFormatDetails struct {
 	Type    FileFormatType
 	Options FileFormatTypeOptions
+	// Defaults holds the default values of the options for the format type, as reported by DESCRIBE FILE FORMAT.
+	Defaults FileFormatTypeOptions
 }
 
 type FileFormatDetailsRow struct {
@@ -712,7 +806,24 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 		}
 	}
 
-	switch details.Type {
+	if details.Options, err = parseFileFormatTypeOptions(details.Type, rows); err != nil {
+		return nil, err
+	}
+	defaultRows := make([]FileFormatDetailsRow, len(rows))
+	for i, row := range rows {
+		row.Property_Value = row.Property_Default
+		defaultRows[i] = row
+	}
+	if details.Defaults, err = parseFileFormatTypeOptions(details.Type, defaultRows); err != nil {
+		return nil, err
+	}
+	return &details, nil
+}
+
+// parseFileFormatTypeOptions parses the format type options from the Property_Value of the given DESCRIBE FILE FORMAT rows.
+func parseFileFormatTypeOptions(formatType FileFormatType, rows []FileFormatDetailsRow) (FileFormatTypeOptions, error) {
+	options := FileFormatTypeOptions{}
+	switch formatType {
 	case FileFormatTypeCSV:
 		for _, row := range rows {
 			if row.Property_Value == "" {
@@ -721,89 +832,89 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 			v := row.Property_Value
 			switch row.Property {
 			case "RECORD_DELIMITER":
-				details.Options.CSVRecordDelimiter = &v
+				options.CSVRecordDelimiter = &v
 			case "FIELD_DELIMITER":
-				details.Options.CSVFieldDelimiter = &v
+				options.CSVFieldDelimiter = &v
 			case "FILE_EXTENSION":
-				details.Options.CSVFileExtension = &v
+				options.CSVFileExtension = &v
 			case "SKIP_HEADER":
 				i, err := strconv.ParseInt(v, 10, 0)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast SKIP_HEADER value "%s" to int: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast SKIP_HEADER value "%s" to int: %w`, v, err)
 				}
 				i0 := int(i)
-				details.Options.CSVSkipHeader = &i0
+				options.CSVSkipHeader = &i0
 			case "PARSE_HEADER":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast PARSE_HEADER value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast PARSE_HEADER value "%s" to bool: %w`, v, err)
 				}
-				details.Options.CSVParseHeader = &b
+				options.CSVParseHeader = &b
 			case "DATE_FORMAT":
-				details.Options.CSVDateFormat = &v
+				options.CSVDateFormat = &v
 			case "TIME_FORMAT":
-				details.Options.CSVTimeFormat = &v
+				options.CSVTimeFormat = &v
 			case "TIMESTAMP_FORMAT":
-				details.Options.CSVTimestampFormat = &v
+				options.CSVTimestampFormat = &v
 			case "BINARY_FORMAT":
 				bf := BinaryFormat(v)
-				details.Options.CSVBinaryFormat = &bf
+				options.CSVBinaryFormat = &bf
 			case "ESCAPE":
-				details.Options.CSVEscape = &v
+				options.CSVEscape = &v
 			case "ESCAPE_UNENCLOSED_FIELD":
-				details.Options.CSVEscapeUnenclosedField = &v
+				options.CSVEscapeUnenclosedField = &v
 			case "TRIM_SPACE":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
 				}
-				details.Options.CSVTrimSpace = &b
+				options.CSVTrimSpace = &b
 			case "FIELD_OPTIONALLY_ENCLOSED_BY":
-				details.Options.CSVFieldOptionallyEnclosedBy = &v
+				options.CSVFieldOptionallyEnclosedBy = &v
 			case "NULL_IF":
 				newNullIf := []NullString{}
 				for _, s := range strings.Split(strings.Trim(v, "[]"), ", ") {
 					newNullIf = append(newNullIf, NullString{s})
 				}
-				details.Options.CSVNullIf = &newNullIf
+				options.CSVNullIf = &newNullIf
 			case "COMPRESSION":
 				comp := CSVCompression(v)
-				details.Options.CSVCompression = &comp
+				options.CSVCompression = &comp
 			case "ERROR_ON_COLUMN_COUNT_MISMATCH":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast ERROR_ON_COLUMN_COUNT_MISMATCH value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast ERROR_ON_COLUMN_COUNT_MISMATCH value "%s" to bool: %w`, v, err)
 				}
-				details.Options.CSVErrorOnColumnCountMismatch = &b
+				options.CSVErrorOnColumnCountMismatch = &b
 			// case "VALIDATE_UTF8":
-			// 	details.Options.C = &v
+			// 	options.C = &v
 			case "SKIP_BLANK_LINES":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast SKIP_BLANK_LINES value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast SKIP_BLANK_LINES value "%s" to bool: %w`, v, err)
 				}
-				details.Options.CSVSkipBlankLines = &b
+				options.CSVSkipBlankLines = &b
 			case "REPLACE_INVALID_CHARACTERS":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
 				}
-				details.Options.CSVReplaceInvalidCharacters = &b
+				options.CSVReplaceInvalidCharacters = &b
 			case "EMPTY_FIELD_AS_NULL":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast EMPTY_FIELD_AS_NULL value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast EMPTY_FIELD_AS_NULL value "%s" to bool: %w`, v, err)
 				}
-				details.Options.CSVEmptyFieldAsNull = &b
+				options.CSVEmptyFieldAsNull = &b
 			case "SKIP_BYTE_ORDER_MARK":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast SKIP_BYTE_ORDER_MARK value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast SKIP_BYTE_ORDER_MARK value "%s" to bool: %w`, v, err)
 				}
-				details.Options.CSVSkipByteOrderMark = &b
+				options.CSVSkipByteOrderMark = &b
 			case "ENCODING":
 				enc := CSVEncoding(v)
-				details.Options.CSVEncoding = &enc
+				options.CSVEncoding = &enc
 			}
 		}
 	case FileFormatTypeJSON:
@@ -814,73 +925,73 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 			v := row.Property_Value
 			switch row.Property {
 			case "FILE_EXTENSION":
-				details.Options.JSONFileExtension = &v
+				options.JSONFileExtension = &v
 			case "DATE_FORMAT":
-				details.Options.JSONDateFormat = &v
+				options.JSONDateFormat = &v
 			case "TIME_FORMAT":
-				details.Options.JSONTimeFormat = &v
+				options.JSONTimeFormat = &v
 			case "TIMESTAMP_FORMAT":
-				details.Options.JSONTimestampFormat = &v
+				options.JSONTimestampFormat = &v
 			case "BINARY_FORMAT":
 				bf := BinaryFormat(v)
-				details.Options.JSONBinaryFormat = &bf
+				options.JSONBinaryFormat = &bf
 			case "TRIM_SPACE":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
 				}
-				details.Options.JSONTrimSpace = &b
+				options.JSONTrimSpace = &b
 			case "NULL_IF":
 				newNullIf := []NullString{}
 				for _, s := range strings.Split(strings.Trim(v, "[]"), ", ") {
 					newNullIf = append(newNullIf, NullString{s})
 				}
-				details.Options.JSONNullIf = newNullIf
+				options.JSONNullIf = &newNullIf
 			case "COMPRESSION":
 				comp := JSONCompression(v)
-				details.Options.JSONCompression = &comp
+				options.JSONCompression = &comp
 			case "ENABLE_OCTAL":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast ENABLE_OCTAL value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast ENABLE_OCTAL value "%s" to bool: %w`, v, err)
 				}
-				details.Options.JSONEnableOctal = &b
+				options.JSONEnableOctal = &b
 			case "ALLOW_DUPLICATE":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast ALLOW_DUPLICATE value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast ALLOW_DUPLICATE value "%s" to bool: %w`, v, err)
 				}
-				details.Options.JSONAllowDuplicate = &b
+				options.JSONAllowDuplicate = &b
 			case "STRIP_OUTER_ARRAY":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast STRIP_OUTER_ARRAY value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast STRIP_OUTER_ARRAY value "%s" to bool: %w`, v, err)
 				}
-				details.Options.JSONStripOuterArray = &b
+				options.JSONStripOuterArray = &b
 			case "STRIP_NULL_VALUES":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast STRIP_NULL_VALUES value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast STRIP_NULL_VALUES value "%s" to bool: %w`, v, err)
 				}
-				details.Options.JSONStripNullValues = &b
+				options.JSONStripNullValues = &b
 			case "IGNORE_UTF8_ERRORS":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast IGNORE_UTF8_ERRORS value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast IGNORE_UTF8_ERRORS value "%s" to bool: %w`, v, err)
 				}
-				details.Options.JSONIgnoreUTF8Errors = &b
+				options.JSONIgnoreUTF8Errors = &b
 			case "REPLACE_INVALID_CHARACTERS":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
 				}
-				details.Options.JSONReplaceInvalidCharacters = &b
+				options.JSONReplaceInvalidCharacters = &b
 			case "SKIP_BYTE_ORDER_MARK":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast SKIP_BYTE_ORDER_MARK value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast SKIP_BYTE_ORDER_MARK value "%s" to bool: %w`, v, err)
 				}
-				details.Options.JSONSkipByteOrderMark = &b
+				options.JSONSkipByteOrderMark = &b
 			}
 		}
 	case FileFormatTypeAvro:
@@ -893,24 +1004,24 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 			case "TRIM_SPACE":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
 				}
-				details.Options.AvroTrimSpace = &b
+				options.AvroTrimSpace = &b
 			case "NULL_IF":
 				newNullIf := []NullString{}
 				for _, s := range strings.Split(strings.Trim(v, "[]"), ", ") {
 					newNullIf = append(newNullIf, NullString{s})
 				}
-				details.Options.AvroNullIf = &newNullIf
+				options.AvroNullIf = &newNullIf
 			case "COMPRESSION":
 				comp := AvroCompression(v)
-				details.Options.AvroCompression = &comp
+				options.AvroCompression = &comp
 			case "REPLACE_INVALID_CHARACTERS":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
 				}
-				details.Options.AvroReplaceInvalidCharacters = &b
+				options.AvroReplaceInvalidCharacters = &b
 			}
 		}
 	case FileFormatTypeORC:
@@ -923,21 +1034,21 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 			case "TRIM_SPACE":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
 				}
-				details.Options.ORCTrimSpace = &b
+				options.ORCTrimSpace = &b
 			case "NULL_IF":
 				newNullIf := []NullString{}
 				for _, s := range strings.Split(strings.Trim(v, "[]"), ", ") {
 					newNullIf = append(newNullIf, NullString{s})
 				}
-				details.Options.ORCNullIf = &newNullIf
+				options.ORCNullIf = &newNullIf
 			case "REPLACE_INVALID_CHARACTERS":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
 				}
-				details.Options.ORCReplaceInvalidCharacters = &b
+				options.ORCReplaceInvalidCharacters = &b
 			}
 		}
 	case FileFormatTypeParquet:
@@ -950,30 +1061,30 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 			case "TRIM_SPACE":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast TRIM_SPACE value "%s" to bool: %w`, v, err)
 				}
-				details.Options.ParquetTrimSpace = &b
+				options.ParquetTrimSpace = &b
 			case "NULL_IF":
 				newNullIf := []NullString{}
 				for _, s := range strings.Split(strings.Trim(v, "[]"), ", ") {
 					newNullIf = append(newNullIf, NullString{s})
 				}
-				details.Options.ParquetNullIf = &newNullIf
+				options.ParquetNullIf = &newNullIf
 			case "COMPRESSION":
 				comp := ParquetCompression(v)
-				details.Options.ParquetCompression = &comp
+				options.ParquetCompression = &comp
 			case "BINARY_AS_TEXT":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast BINARY_AS_TEXT value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast BINARY_AS_TEXT value "%s" to bool: %w`, v, err)
 				}
-				details.Options.ParquetBinaryAsText = &b
+				options.ParquetBinaryAsText = &b
 			case "REPLACE_INVALID_CHARACTERS":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
 				}
-				details.Options.ParquetReplaceInvalidCharacters = &b
+				options.ParquetReplaceInvalidCharacters = &b
 			}
 		}
 	case FileFormatTypeXML:
@@ -985,54 +1096,54 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 			switch row.Property {
 			case "COMPRESSION":
 				comp := XMLCompression(v)
-				details.Options.XMLCompression = &comp
+				options.XMLCompression = &comp
 			case "IGNORE_UTF8_ERRORS":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast IGNORE_UTF8_ERRORS value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast IGNORE_UTF8_ERRORS value "%s" to bool: %w`, v, err)
 				}
-				details.Options.XMLIgnoreUTF8Errors = &b
+				options.XMLIgnoreUTF8Errors = &b
 			case "PRESERVE_SPACE":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast PRESERVE_SPACE value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast PRESERVE_SPACE value "%s" to bool: %w`, v, err)
 				}
-				details.Options.XMLPreserveSpace = &b
+				options.XMLPreserveSpace = &b
 			case "STRIP_OUTER_ELEMENT":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast STRIP_OUTER_ELEMENT value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast STRIP_OUTER_ELEMENT value "%s" to bool: %w`, v, err)
 				}
-				details.Options.XMLStripOuterElement = &b
+				options.XMLStripOuterElement = &b
 			case "DISABLE_SNOWFLAKE_DATA":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast DISABLE_SNOWFLAKE_DATA value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast DISABLE_SNOWFLAKE_DATA value "%s" to bool: %w`, v, err)
 				}
-				details.Options.XMLDisableSnowflakeData = &b
+				options.XMLDisableSnowflakeData = &b
 			case "DISABLE_AUTO_CONVERT":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast DISABLE_AUTO_CONVERT value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast DISABLE_AUTO_CONVERT value "%s" to bool: %w`, v, err)
 				}
-				details.Options.XMLDisableAutoConvert = &b
+				options.XMLDisableAutoConvert = &b
 			case "REPLACE_INVALID_CHARACTERS":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
 				}
-				details.Options.XMLReplaceInvalidCharacters = &b
+				options.XMLReplaceInvalidCharacters = &b
 			case "SKIP_BYTE_ORDER_MARK":
 				b, err := strconv.ParseBool(v)
 				if err != nil {
-					return nil, fmt.Errorf(`cannot cast SKIP_BYTE_ORDER_MARK value "%s" to bool: %w`, v, err)
+					return options, fmt.Errorf(`cannot cast SKIP_BYTE_ORDER_MARK value "%s" to bool: %w`, v, err)
 				}
-				details.Options.XMLSkipByteOrderMark = &b
+				options.XMLSkipByteOrderMark = &b
 			}
 		}
 	default:
-		return nil, fmt.Errorf("Describe did not return format type")
+		return options, fmt.Errorf("Describe did not return format type")
 	}
 
-	return &details, nil
+	return options, nil
 }
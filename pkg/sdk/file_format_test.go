@@ -2,6 +2,9 @@ package sdk
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFileFormatsCreate(t *testing.T) {
@@ -65,7 +68,7 @@ func TestFileFormatsCreate(t *testing.T) {
 				JSONTimestampFormat: String("aze"),
 				JSONBinaryFormat:    &BinaryFormatHex,
 				JSONTrimSpace:       Bool(true),
-				JSONNullIf: []NullString{
+				JSONNullIf: &[]NullString{
 					{"c1"},
 					{"c2"},
 				},
@@ -215,6 +218,16 @@ func TestFileFormatsAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER FILE FORMAT IF EXISTS "db"."schema"."fileformat" SET COMMENT = 'some comment' COMPRESSION = BROTLI TRIM_SPACE = true REPLACE_INVALID_CHARACTERS = true NULL_IF = ('nil')`)
 	})
+
+	t.Run("set empty null_if", func(t *testing.T) {
+		opts := &AlterFileFormatOptions{
+			name: NewSchemaObjectIdentifier("db", "schema", "fileformat"),
+			Set: &FileFormatTypeOptions{
+				JSONNullIf: &[]NullString{},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FILE FORMAT "db"."schema"."fileformat" SET NULL_IF = ()`)
+	})
 }
 
 func TestFileFormatsDrop(t *testing.T) {
@@ -274,3 +287,20 @@ func TestFileFormatsDescribe(t *testing.T) {
 	}
 	assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FILE FORMAT "db"."schema"."ff"`)
 }
+
+func TestFileFormatsParseTypeOptions(t *testing.T) {
+	rows := []FileFormatDetailsRow{
+		{Property: "COMPRESSION", Property_Value: "GZIP", Property_Default: "AUTO"},
+		{Property: "TRIM_SPACE", Property_Value: "true", Property_Default: "false"},
+		{Property: "NULL_IF", Property_Value: "[NULL, null]", Property_Default: "[]"},
+	}
+
+	options, err := parseFileFormatTypeOptions(FileFormatTypeJSON, rows)
+	require.NoError(t, err)
+	assert.Equal(t, JSONCompressionGzip, *options.JSONCompression)
+	assert.True(t, *options.JSONTrimSpace)
+	assert.Equal(t, []NullString{{"NULL"}, {"null"}}, *options.JSONNullIf)
+
+	_, err = parseFileFormatTypeOptions(FileFormatTypeJSON, []FileFormatDetailsRow{{Property: "TRIM_SPACE", Property_Value: "yes"}})
+	require.ErrorContains(t, err, `cannot cast TRIM_SPACE value "yes" to bool`)
+}
@@ -0,0 +1,140 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var gitRepositoryDbRow = g.DbStruct("gitRepositoriesRow").
+	Time("created_on").
+	Text("name").
+	Text("database_name").
+	Text("schema_name").
+	Text("origin").
+	Text("api_integration").
+	OptionalText("git_credentials").
+	Text("owner").
+	Text("owner_role_type").
+	OptionalText("comment").
+	Field("last_fetched_at", "sql.NullTime")
+
+var gitRepository = g.PlainStruct("GitRepository").
+	Time("CreatedOn").
+	Text("Name").
+	Text("DatabaseName").
+	Text("SchemaName").
+	Text("Origin").
+	Text("ApiIntegration").
+	OptionalText("GitCredentials").
+	Text("Owner").
+	Text("OwnerRoleType").
+	OptionalText("Comment").
+	Field("LastFetchedAt", "*time.Time")
+
+var GitRepositoriesDef = g.NewInterface(
+	"GitRepositories",
+	"GitRepository",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-git-repository",
+		g.NewQueryStruct("CreateGitRepository").
+			Create().
+			OrReplace().
+			SQL("GIT REPOSITORY").
+			IfNotExists().
+			Name().
+			TextAssignment("ORIGIN", g.ParameterOptions().SingleQuotes().Required()).
+			Identifier("ApiIntegration", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("API_INTEGRATION").Required()).
+			OptionalIdentifier("GitCredentials", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("GIT_CREDENTIALS")).
+			OptionalComment().
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifier, "ApiIntegration").
+			WithValidation(g.ValidIdentifierIfSet, "GitCredentials").
+			WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-git-repository",
+		g.NewQueryStruct("AlterGitRepository").
+			Alter().
+			SQL("GIT REPOSITORY").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("GitRepositorySet").
+					OptionalIdentifier("ApiIntegration", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("API_INTEGRATION")).
+					OptionalIdentifier("GitCredentials", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("GIT_CREDENTIALS")).
+					OptionalComment().
+					WithValidation(g.ValidIdentifierIfSet, "ApiIntegration").
+					WithValidation(g.ValidIdentifierIfSet, "GitCredentials").
+					WithValidation(g.AtLeastOneValueSet, "ApiIntegration", "GitCredentials", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("GitRepositoryUnset").
+					OptionalSQL("GIT_CREDENTIALS").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "GitCredentials", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalSQL("FETCH").
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "Fetch", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-git-repository",
+		g.NewQueryStruct("DropGitRepository").
+			Drop().
+			SQL("GIT REPOSITORY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-git-repositories",
+		gitRepositoryDbRow,
+		gitRepository,
+		g.NewQueryStruct("ShowGitRepositories").
+			Show().
+			SQL("GIT REPOSITORIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-git-repository",
+		gitRepositoryDbRow,
+		gitRepository,
+		g.NewQueryStruct("DescribeGitRepository").
+			Describe().
+			SQL("GIT REPOSITORY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	CustomOperation(
+		"ShowGitBranches",
+		"https://docs.snowflake.com/en/sql-reference/sql/show-git-branches",
+		g.NewQueryStruct("ShowGitBranches").
+			Show().
+			SQL("GIT BRANCHES").
+			OptionalLike().
+			SQL("IN GIT REPOSITORY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	CustomOperation(
+		"ShowGitTags",
+		"https://docs.snowflake.com/en/sql-reference/sql/show-git-tags",
+		g.NewQueryStruct("ShowGitTags").
+			Show().
+			SQL("GIT TAGS").
+			OptionalLike().
+			SQL("IN GIT REPOSITORY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,337 @@
+package sdk
+
+import "testing"
+
+func TestGitRepositories_Create(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+	apiIntegrationId := RandomAccountObjectIdentifier()
+	gitCredentialsId := RandomSchemaObjectIdentifier()
+
+	// Minimal valid CreateGitRepositoryOptions
+	defaultOpts := func() *CreateGitRepositoryOptions {
+		return &CreateGitRepositoryOptions{
+			name:           id,
+			Origin:         "https://github.com/my-account/my-repository.git",
+			ApiIntegration: apiIntegrationId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.ApiIntegration]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ApiIntegration = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.GitCredentials] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.GitCredentials = Pointer(NewSchemaObjectIdentifier("", "", ""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfNotExists opts.OrReplace]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.OrReplace = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateGitRepositoryOptions", "IfNotExists", "OrReplace"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE GIT REPOSITORY %s ORIGIN = 'https://github.com/my-account/my-repository.git' API_INTEGRATION = %s`, id.FullyQualifiedName(), apiIntegrationId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.GitCredentials = &gitCredentialsId
+		opts.Comment = String("some comment")
+		opts.Tag = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag-name"),
+				Value: "tag-value",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE GIT REPOSITORY %s ORIGIN = 'https://github.com/my-account/my-repository.git' API_INTEGRATION = %s GIT_CREDENTIALS = %s COMMENT = 'some comment' TAG ("tag-name" = 'tag-value')`, id.FullyQualifiedName(), apiIntegrationId.FullyQualifiedName(), gitCredentialsId.FullyQualifiedName())
+	})
+}
+
+func TestGitRepositories_Alter(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+	apiIntegrationId := RandomAccountObjectIdentifier()
+	gitCredentialsId := RandomSchemaObjectIdentifier()
+
+	// Minimal valid AlterGitRepositoryOptions
+	defaultOpts := func() *AlterGitRepositoryOptions {
+		return &AlterGitRepositoryOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Fetch = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.Fetch opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterGitRepositoryOptions", "Set", "Unset", "Fetch", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.Fetch opts.SetTags opts.UnsetTags] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Fetch = Bool(true)
+		opts.Unset = &GitRepositoryUnset{
+			Comment: Bool(true),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterGitRepositoryOptions", "Set", "Unset", "Fetch", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: valid identifier for [opts.Set.ApiIntegration] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &GitRepositorySet{
+			ApiIntegration: Pointer(NewAccountObjectIdentifier("")),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.Set.GitCredentials] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &GitRepositorySet{
+			GitCredentials: Pointer(NewSchemaObjectIdentifier("", "", "")),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.ApiIntegration opts.Set.GitCredentials opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &GitRepositorySet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterGitRepositoryOptions.Set", "ApiIntegration", "GitCredentials", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.GitCredentials opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &GitRepositoryUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterGitRepositoryOptions.Unset", "GitCredentials", "Comment"))
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &GitRepositorySet{
+			ApiIntegration: &apiIntegrationId,
+			GitCredentials: &gitCredentialsId,
+			Comment:        String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER GIT REPOSITORY %s SET API_INTEGRATION = %s GIT_CREDENTIALS = %s COMMENT = 'some comment'`, id.FullyQualifiedName(), apiIntegrationId.FullyQualifiedName(), gitCredentialsId.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Unset = &GitRepositoryUnset{
+			GitCredentials: Bool(true),
+			Comment:        Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER GIT REPOSITORY IF EXISTS %s UNSET GIT_CREDENTIALS, COMMENT`, id.FullyQualifiedName())
+	})
+
+	t.Run("fetch", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Fetch = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER GIT REPOSITORY %s FETCH`, id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag-name"),
+				Value: "tag-value",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER GIT REPOSITORY %s SET TAG "tag-name" = 'tag-value'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag-name"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER GIT REPOSITORY %s UNSET TAG "tag-name"`, id.FullyQualifiedName())
+	})
+}
+
+func TestGitRepositories_Drop(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid DropGitRepositoryOptions
+	defaultOpts := func() *DropGitRepositoryOptions {
+		return &DropGitRepositoryOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DROP GIT REPOSITORY %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP GIT REPOSITORY IF EXISTS %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestGitRepositories_Show(t *testing.T) {
+	// Minimal valid ShowGitRepositoryOptions
+	defaultOpts := func() *ShowGitRepositoryOptions {
+		return &ShowGitRepositoryOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW GIT REPOSITORIES`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some pattern"),
+		}
+		opts.In = &In{
+			Schema: NewDatabaseObjectIdentifier("db", "schema"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW GIT REPOSITORIES LIKE 'some pattern' IN SCHEMA "db"."schema"`)
+	})
+}
+
+func TestGitRepositories_Describe(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeGitRepositoryOptions
+	defaultOpts := func() *DescribeGitRepositoryOptions {
+		return &DescribeGitRepositoryOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE GIT REPOSITORY %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestGitRepositories_ShowGitBranches(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid ShowGitBranchesGitRepositoryOptions
+	defaultOpts := func() *ShowGitBranchesGitRepositoryOptions {
+		return &ShowGitBranchesGitRepositoryOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowGitBranchesGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW GIT BRANCHES IN GIT REPOSITORY %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("main%"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW GIT BRANCHES LIKE 'main%%' IN GIT REPOSITORY %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestGitRepositories_ShowGitTags(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid ShowGitTagsGitRepositoryOptions
+	defaultOpts := func() *ShowGitTagsGitRepositoryOptions {
+		return &ShowGitTagsGitRepositoryOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowGitTagsGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW GIT TAGS IN GIT REPOSITORY %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("v1%"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW GIT TAGS LIKE 'v1%%' IN GIT REPOSITORY %s`, id.FullyQualifiedName())
+	})
+}
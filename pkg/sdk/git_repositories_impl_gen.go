@@ -0,0 +1,209 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ GitRepositories = (*gitRepositories)(nil)
+
+type gitRepositories struct {
+	client *Client
+}
+
+func (v *gitRepositories) Create(ctx context.Context, request *CreateGitRepositoryRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *gitRepositories) Alter(ctx context.Context, request *AlterGitRepositoryRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *gitRepositories) Drop(ctx context.Context, request *DropGitRepositoryRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *gitRepositories) Show(ctx context.Context, request *ShowGitRepositoryRequest) ([]GitRepository, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[gitRepositoriesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[gitRepositoriesRow, GitRepository](dbRows)
+	return resultList, nil
+}
+
+func (v *gitRepositories) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*GitRepository, error) {
+	gitRepositories, err := v.Show(ctx, NewShowGitRepositoryRequest().WithIn(&In{
+		Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+	}).WithLike(&Like{
+		Pattern: String(id.Name()),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(gitRepositories, func(r GitRepository) bool { return r.Name == id.Name() })
+}
+
+func (v *gitRepositories) Describe(ctx context.Context, id SchemaObjectIdentifier) (*GitRepository, error) {
+	opts := &DescribeGitRepositoryOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[gitRepositoriesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (v *gitRepositories) ShowGitBranches(ctx context.Context, request *ShowGitBranchesGitRepositoryRequest) ([]GitBranch, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[gitBranchesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[gitBranchesRow, GitBranch](dbRows)
+	return resultList, nil
+}
+
+func (v *gitRepositories) ShowGitTags(ctx context.Context, request *ShowGitTagsGitRepositoryRequest) ([]GitTag, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[gitTagsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[gitTagsRow, GitTag](dbRows)
+	return resultList, nil
+}
+
+func (r *CreateGitRepositoryRequest) toOpts() *CreateGitRepositoryOptions {
+	opts := &CreateGitRepositoryOptions{
+		OrReplace:      r.OrReplace,
+		IfNotExists:    r.IfNotExists,
+		name:           r.name,
+		Origin:         r.Origin,
+		ApiIntegration: r.ApiIntegration,
+		GitCredentials: r.GitCredentials,
+		Comment:        r.Comment,
+		Tag:            r.Tag,
+	}
+	return opts
+}
+
+func (r *AlterGitRepositoryRequest) toOpts() *AlterGitRepositoryOptions {
+	opts := &AlterGitRepositoryOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+
+		Fetch:     r.Fetch,
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &GitRepositorySet{
+			ApiIntegration: r.Set.ApiIntegration,
+			GitCredentials: r.Set.GitCredentials,
+			Comment:        r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &GitRepositoryUnset{
+			GitCredentials: r.Unset.GitCredentials,
+			Comment:        r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropGitRepositoryRequest) toOpts() *DropGitRepositoryOptions {
+	opts := &DropGitRepositoryOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowGitRepositoryRequest) toOpts() *ShowGitRepositoryOptions {
+	opts := &ShowGitRepositoryOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r gitRepositoriesRow) convert() *GitRepository {
+	gitRepository := &GitRepository{
+		CreatedOn:      r.CreatedOn,
+		Name:           r.Name,
+		DatabaseName:   r.DatabaseName,
+		SchemaName:     r.SchemaName,
+		Origin:         r.Origin,
+		ApiIntegration: r.ApiIntegration,
+		Owner:          r.Owner,
+		OwnerRoleType:  r.OwnerRoleType,
+	}
+	if r.GitCredentials.Valid && r.GitCredentials.String != "" {
+		gitRepository.GitCredentials = &r.GitCredentials.String
+	}
+	if r.Comment.Valid {
+		gitRepository.Comment = &r.Comment.String
+	}
+	if r.LastFetchedAt.Valid {
+		gitRepository.LastFetchedAt = &r.LastFetchedAt.Time
+	}
+	return gitRepository
+}
+
+func (r *DescribeGitRepositoryRequest) toOpts() *DescribeGitRepositoryOptions {
+	opts := &DescribeGitRepositoryOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r *ShowGitBranchesGitRepositoryRequest) toOpts() *ShowGitBranchesGitRepositoryOptions {
+	opts := &ShowGitBranchesGitRepositoryOptions{
+		Like: r.Like,
+		name: r.name,
+	}
+	return opts
+}
+
+func (r *ShowGitTagsGitRepositoryRequest) toOpts() *ShowGitTagsGitRepositoryOptions {
+	opts := &ShowGitTagsGitRepositoryOptions{
+		Like: r.Like,
+		name: r.name,
+	}
+	return opts
+}
+
+func (r gitBranchesRow) convert() *GitBranch {
+	gitBranch := &GitBranch{
+		Name:       r.Name,
+		Path:       r.Path,
+		CommitHash: r.CommitHash,
+	}
+	if r.Checkouts.Valid && r.Checkouts.String != "" {
+		gitBranch.Checkouts = &r.Checkouts.String
+	}
+	return gitBranch
+}
+
+func (r gitTagsRow) convert() *GitTag {
+	gitTag := &GitTag{
+		Name:       r.Name,
+		Path:       r.Path,
+		CommitHash: r.CommitHash,
+	}
+	if r.Author.Valid {
+		gitTag.Author = &r.Author.String
+	}
+	if r.Message.Valid {
+		gitTag.Message = &r.Message.String
+	}
+	return gitTag
+}
@@ -16,6 +16,12 @@ type Grants interface {
 	RevokePrivilegesFromDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromDatabaseRoleOptions) error
 	GrantPrivilegeToShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, to AccountObjectIdentifier) error
 	RevokePrivilegeFromShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, from AccountObjectIdentifier) error
+	GrantPrivilegesToApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToApplicationRoleOptions) error
+	RevokePrivilegesFromApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error
+	GrantPrivilegesToApplication(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, application AccountObjectIdentifier) error
+	RevokePrivilegesFromApplication(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, application AccountObjectIdentifier) error
+	GrantApplicationRole(ctx context.Context, role DatabaseObjectIdentifier, to ApplicationRoleGrantee) error
+	RevokeApplicationRole(ctx context.Context, role DatabaseObjectIdentifier, from ApplicationRoleGrantee) error
 	GrantOwnership(ctx context.Context, on OwnershipGrantOn, to OwnershipGrantTo, opts *GrantOwnershipOptions) error
 
 	Show(ctx context.Context, opts *ShowGrantOptions) ([]Grant, error)
@@ -119,6 +125,63 @@ type RevokePrivilegesFromDatabaseRoleOptions struct {
 	Cascade        *bool                        `ddl:"keyword" sql:"CASCADE"`
 }
 
+// GrantPrivilegesToApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege-application-role.
+type GrantPrivilegesToApplicationRoleOptions struct {
+	grant           bool                        `ddl:"static" sql:"GRANT"`
+	privileges      *AccountRoleGrantPrivileges `ddl:"-"`
+	on              *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	applicationRole DatabaseObjectIdentifier    `ddl:"identifier" sql:"TO APPLICATION ROLE"`
+	WithGrantOption *bool                       `ddl:"keyword" sql:"WITH GRANT OPTION"`
+}
+
+// RevokePrivilegesFromApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-privilege-application-role.
+type RevokePrivilegesFromApplicationRoleOptions struct {
+	revoke          bool                        `ddl:"static" sql:"REVOKE"`
+	GrantOptionFor  *bool                       `ddl:"keyword" sql:"GRANT OPTION FOR"`
+	privileges      *AccountRoleGrantPrivileges `ddl:"-"`
+	on              *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	applicationRole DatabaseObjectIdentifier    `ddl:"identifier" sql:"FROM APPLICATION ROLE"`
+	Restrict        *bool                       `ddl:"keyword" sql:"RESTRICT"`
+	Cascade         *bool                       `ddl:"keyword" sql:"CASCADE"`
+}
+
+// grantPrivilegesToApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege-application.
+type grantPrivilegesToApplicationOptions struct {
+	grant       bool                        `ddl:"static" sql:"GRANT"`
+	privileges  *AccountRoleGrantPrivileges `ddl:"-"`
+	on          *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	application AccountObjectIdentifier     `ddl:"identifier" sql:"TO APPLICATION"`
+}
+
+// revokePrivilegesFromApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-privilege-application.
+type revokePrivilegesFromApplicationOptions struct {
+	revoke      bool                        `ddl:"static" sql:"REVOKE"`
+	privileges  *AccountRoleGrantPrivileges `ddl:"-"`
+	on          *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	application AccountObjectIdentifier     `ddl:"identifier" sql:"FROM APPLICATION"`
+}
+
+// grantApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-application-role.
+type grantApplicationRoleOptions struct {
+	grantApplicationRole bool                     `ddl:"static" sql:"GRANT APPLICATION ROLE"`
+	name                 DatabaseObjectIdentifier `ddl:"identifier"`
+	To                   ApplicationRoleGrantee   `ddl:"keyword" sql:"TO"`
+}
+
+// revokeApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-application-role.
+type revokeApplicationRoleOptions struct {
+	revokeApplicationRole bool                     `ddl:"static" sql:"REVOKE APPLICATION ROLE"`
+	name                  DatabaseObjectIdentifier `ddl:"identifier"`
+	From                  ApplicationRoleGrantee   `ddl:"keyword" sql:"FROM"`
+}
+
+type ApplicationRoleGrantee struct {
+	// One of
+	AccountRole     *AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	ApplicationRole *DatabaseObjectIdentifier `ddl:"identifier" sql:"APPLICATION ROLE"`
+	Application     *AccountObjectIdentifier  `ddl:"identifier" sql:"APPLICATION"`
+}
+
 // grantPrivilegeToShareOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege-share.
 type grantPrivilegeToShareOptions struct {
 	grant      bool                    `ddl:"static" sql:"GRANT"`
@@ -128,12 +191,16 @@ type grantPrivilegeToShareOptions struct {
 }
 
 type ShareGrantOn struct {
-	Database AccountObjectIdentifier  `ddl:"identifier" sql:"DATABASE"`
-	Schema   DatabaseObjectIdentifier `ddl:"identifier" sql:"SCHEMA"`
-	Function SchemaObjectIdentifier   `ddl:"identifier" sql:"FUNCTION"`
-	Table    *OnTable                 `ddl:"-"`
-	Tag      SchemaObjectIdentifier   `ddl:"identifier" sql:"TAG"`
-	View     SchemaObjectIdentifier   `ddl:"identifier" sql:"VIEW"`
+	Database         AccountObjectIdentifier  `ddl:"identifier" sql:"DATABASE"`
+	Schema           DatabaseObjectIdentifier `ddl:"identifier" sql:"SCHEMA"`
+	Function         SchemaObjectIdentifier   `ddl:"identifier" sql:"FUNCTION"`
+	Table            *OnTable                 `ddl:"-"`
+	ExternalTable    *OnExternalTable         `ddl:"-"`
+	IcebergTable     *OnIcebergTable          `ddl:"-"`
+	DynamicTable     *OnDynamicTable          `ddl:"-"`
+	Tag              SchemaObjectIdentifier   `ddl:"identifier" sql:"TAG"`
+	View             SchemaObjectIdentifier   `ddl:"identifier" sql:"VIEW"`
+	MaterializedView SchemaObjectIdentifier   `ddl:"identifier" sql:"MATERIALIZED VIEW"`
 }
 
 type OnTable struct {
@@ -141,6 +208,21 @@ type OnTable struct {
 	AllInSchema DatabaseObjectIdentifier `ddl:"identifier" sql:"ALL TABLES IN SCHEMA"`
 }
 
+type OnExternalTable struct {
+	Name        SchemaObjectIdentifier   `ddl:"identifier" sql:"EXTERNAL TABLE"`
+	AllInSchema DatabaseObjectIdentifier `ddl:"identifier" sql:"ALL EXTERNAL TABLES IN SCHEMA"`
+}
+
+type OnIcebergTable struct {
+	Name        SchemaObjectIdentifier   `ddl:"identifier" sql:"ICEBERG TABLE"`
+	AllInSchema DatabaseObjectIdentifier `ddl:"identifier" sql:"ALL ICEBERG TABLES IN SCHEMA"`
+}
+
+type OnDynamicTable struct {
+	Name        SchemaObjectIdentifier   `ddl:"identifier" sql:"DYNAMIC TABLE"`
+	AllInSchema DatabaseObjectIdentifier `ddl:"identifier" sql:"ALL DYNAMIC TABLES IN SCHEMA"`
+}
+
 // revokePrivilegeFromShareOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-privilege-share.
 type revokePrivilegeFromShareOptions struct {
 	revoke     bool                    `ddl:"static" sql:"REVOKE"`
@@ -204,6 +204,60 @@ func (v *grants) RevokePrivilegeFromShare(ctx context.Context, privileges []Obje
 	return validateAndExec(v.client, ctx, opts)
 }
 
+func (v *grants) GrantPrivilegesToApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToApplicationRoleOptions) error {
+	if opts == nil {
+		opts = &GrantPrivilegesToApplicationRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.applicationRole = role
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) RevokePrivilegesFromApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error {
+	if opts == nil {
+		opts = &RevokePrivilegesFromApplicationRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.applicationRole = role
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) GrantPrivilegesToApplication(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, application AccountObjectIdentifier) error {
+	opts := &grantPrivilegesToApplicationOptions{
+		privileges:  privileges,
+		on:          on,
+		application: application,
+	}
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) RevokePrivilegesFromApplication(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, application AccountObjectIdentifier) error {
+	opts := &revokePrivilegesFromApplicationOptions{
+		privileges:  privileges,
+		on:          on,
+		application: application,
+	}
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) GrantApplicationRole(ctx context.Context, role DatabaseObjectIdentifier, to ApplicationRoleGrantee) error {
+	opts := &grantApplicationRoleOptions{
+		name: role,
+		To:   to,
+	}
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) RevokeApplicationRole(ctx context.Context, role DatabaseObjectIdentifier, from ApplicationRoleGrantee) error {
+	opts := &revokeApplicationRoleOptions{
+		name: role,
+		From: from,
+	}
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *grants) GrantOwnership(ctx context.Context, on OwnershipGrantOn, to OwnershipGrantTo, opts *GrantOwnershipOptions) error {
 	if opts == nil {
 		opts = &GrantOwnershipOptions{}
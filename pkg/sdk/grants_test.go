@@ -723,6 +723,209 @@ func TestGrants_RevokePrivilegesFromDatabaseRoleRole(t *testing.T) {
 	})
 }
 
+func TestGrants_GrantPrivilegesToApplicationRole(t *testing.T) {
+	applicationRoleId := NewDatabaseObjectIdentifier("app1", "role1")
+
+	defaultOpts := func() *GrantPrivilegesToApplicationRoleOptions {
+		return &GrantPrivilegesToApplicationRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				AccountObjectPrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeUsage},
+			},
+			on: &AccountRoleGrantOn{
+				AccountObject: &GrantOnAccountObject{
+					Warehouse: Pointer(NewAccountObjectIdentifier("wh1")),
+				},
+			},
+			applicationRole: applicationRoleId,
+		}
+	}
+
+	t.Run("validation: nil privileges set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("GrantPrivilegesToApplicationRoleOptions", "privileges"))
+	})
+
+	t.Run("validation: nil on set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("GrantPrivilegesToApplicationRoleOptions", "on"))
+	})
+
+	t.Run("validation: invalid application role", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.applicationRole = NewDatabaseObjectIdentifier("", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("on account object", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.WithGrantOption = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `GRANT USAGE ON WAREHOUSE "wh1" TO APPLICATION ROLE "app1"."role1" WITH GRANT OPTION`)
+	})
+
+	t.Run("on schema object", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &AccountRoleGrantPrivileges{
+			SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect},
+		}
+		opts.on = &AccountRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				SchemaObject: &Object{
+					ObjectType: ObjectTypeTable,
+					Name:       NewSchemaObjectIdentifier("db1", "schema1", "table1"),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT SELECT ON TABLE "db1"."schema1"."table1" TO APPLICATION ROLE "app1"."role1"`)
+	})
+}
+
+func TestGrants_RevokePrivilegesFromApplicationRole(t *testing.T) {
+	applicationRoleId := NewDatabaseObjectIdentifier("app1", "role1")
+
+	defaultOpts := func() *RevokePrivilegesFromApplicationRoleOptions {
+		return &RevokePrivilegesFromApplicationRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage},
+			},
+			on: &AccountRoleGrantOn{
+				Schema: &GrantOnSchema{
+					Schema: Pointer(NewDatabaseObjectIdentifier("db1", "schema1")),
+				},
+			},
+			applicationRole: applicationRoleId,
+		}
+	}
+
+	t.Run("validation: restrict and cascade", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Restrict = Bool(true)
+		opts.Cascade = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("RevokePrivilegesFromApplicationRoleOptions", "Restrict", "Cascade"))
+	})
+
+	t.Run("on schema", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE USAGE ON SCHEMA "db1"."schema1" FROM APPLICATION ROLE "app1"."role1"`)
+	})
+
+	t.Run("grant option for + cascade", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.GrantOptionFor = Bool(true)
+		opts.Cascade = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE GRANT OPTION FOR USAGE ON SCHEMA "db1"."schema1" FROM APPLICATION ROLE "app1"."role1" CASCADE`)
+	})
+}
+
+func TestGrants_GrantPrivilegesToApplication(t *testing.T) {
+	applicationId := NewAccountObjectIdentifier("app1")
+
+	defaultOpts := func() *grantPrivilegesToApplicationOptions {
+		return &grantPrivilegesToApplicationOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeExecuteTask, GlobalPrivilegeCreateDatabase},
+			},
+			on: &AccountRoleGrantOn{
+				Account: Bool(true),
+			},
+			application: applicationId,
+		}
+	}
+
+	t.Run("validation: nil privileges set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("grantPrivilegesToApplicationOptions", "privileges"))
+	})
+
+	t.Run("validation: invalid application", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.application = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("on account", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `GRANT EXECUTE TASK, CREATE DATABASE ON ACCOUNT TO APPLICATION "app1"`)
+	})
+
+	t.Run("on account object", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &AccountRoleGrantPrivileges{
+			AccountObjectPrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeUsage},
+		}
+		opts.on = &AccountRoleGrantOn{
+			AccountObject: &GrantOnAccountObject{
+				Warehouse: Pointer(NewAccountObjectIdentifier("wh1")),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT USAGE ON WAREHOUSE "wh1" TO APPLICATION "app1"`)
+	})
+}
+
+func TestGrants_RevokePrivilegesFromApplication(t *testing.T) {
+	opts := &revokePrivilegesFromApplicationOptions{
+		privileges: &AccountRoleGrantPrivileges{
+			GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeExecuteTask},
+		},
+		on: &AccountRoleGrantOn{
+			Account: Bool(true),
+		},
+		application: NewAccountObjectIdentifier("app1"),
+	}
+	assertOptsValidAndSQLEquals(t, opts, `REVOKE EXECUTE TASK ON ACCOUNT FROM APPLICATION "app1"`)
+}
+
+func TestGrants_GrantApplicationRole(t *testing.T) {
+	applicationRoleId := NewDatabaseObjectIdentifier("app1", "role1")
+
+	t.Run("validation: no grantee", func(t *testing.T) {
+		opts := &grantApplicationRoleOptions{name: applicationRoleId}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ApplicationRoleGrantee", "AccountRole", "ApplicationRole", "Application"))
+	})
+
+	t.Run("validation: invalid name", func(t *testing.T) {
+		opts := &grantApplicationRoleOptions{
+			name: NewDatabaseObjectIdentifier("", ""),
+			To:   ApplicationRoleGrantee{AccountRole: Pointer(NewAccountObjectIdentifier("role2"))},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("to account role", func(t *testing.T) {
+		opts := &grantApplicationRoleOptions{
+			name: applicationRoleId,
+			To:   ApplicationRoleGrantee{AccountRole: Pointer(NewAccountObjectIdentifier("role2"))},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT APPLICATION ROLE "app1"."role1" TO ROLE "role2"`)
+	})
+
+	t.Run("to application", func(t *testing.T) {
+		opts := &grantApplicationRoleOptions{
+			name: applicationRoleId,
+			To:   ApplicationRoleGrantee{Application: Pointer(NewAccountObjectIdentifier("app2"))},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT APPLICATION ROLE "app1"."role1" TO APPLICATION "app2"`)
+	})
+
+	t.Run("to application role", func(t *testing.T) {
+		opts := &grantApplicationRoleOptions{
+			name: applicationRoleId,
+			To:   ApplicationRoleGrantee{ApplicationRole: Pointer(NewDatabaseObjectIdentifier("app1", "role2"))},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT APPLICATION ROLE "app1"."role1" TO APPLICATION ROLE "app1"."role2"`)
+	})
+}
+
+func TestGrants_RevokeApplicationRole(t *testing.T) {
+	opts := &revokeApplicationRoleOptions{
+		name: NewDatabaseObjectIdentifier("app1", "role1"),
+		From: ApplicationRoleGrantee{AccountRole: Pointer(NewAccountObjectIdentifier("role2"))},
+	}
+	assertOptsValidAndSQLEquals(t, opts, `REVOKE APPLICATION ROLE "app1"."role1" FROM ROLE "role2"`)
+}
+
 func TestGrantPrivilegeToShare(t *testing.T) {
 	id := RandomAccountObjectIdentifier()
 	t.Run("on database", func(t *testing.T) {
@@ -788,6 +991,109 @@ func TestGrantPrivilegeToShare(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, "GRANT USAGE ON VIEW %s TO SHARE %s", otherID.FullyQualifiedName(), id.FullyQualifiedName())
 	})
+
+	t.Run("on function with arguments", func(t *testing.T) {
+		otherID := NewSchemaObjectIdentifierWithArguments("db", "schema", "func", []DataType{DataTypeNumber, DataTypeVARCHAR})
+		opts := &grantPrivilegeToShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeUsage},
+			On: &ShareGrantOn{
+				Function: otherID,
+			},
+			to: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT USAGE ON FUNCTION "db"."schema"."func"(NUMBER, VARCHAR) TO SHARE %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("on external table", func(t *testing.T) {
+		otherID := RandomSchemaObjectIdentifier()
+		opts := &grantPrivilegeToShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			On: &ShareGrantOn{
+				ExternalTable: &OnExternalTable{
+					Name: otherID,
+				},
+			},
+			to: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "GRANT SELECT ON EXTERNAL TABLE %s TO SHARE %s", otherID.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("on all iceberg tables", func(t *testing.T) {
+		otherID := RandomDatabaseObjectIdentifier()
+		opts := &grantPrivilegeToShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			On: &ShareGrantOn{
+				IcebergTable: &OnIcebergTable{
+					AllInSchema: otherID,
+				},
+			},
+			to: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "GRANT SELECT ON ALL ICEBERG TABLES IN SCHEMA %s TO SHARE %s", otherID.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("on dynamic table", func(t *testing.T) {
+		otherID := RandomSchemaObjectIdentifier()
+		opts := &grantPrivilegeToShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			On: &ShareGrantOn{
+				DynamicTable: &OnDynamicTable{
+					Name: otherID,
+				},
+			},
+			to: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "GRANT SELECT ON DYNAMIC TABLE %s TO SHARE %s", otherID.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("on all dynamic tables", func(t *testing.T) {
+		otherID := RandomDatabaseObjectIdentifier()
+		opts := &grantPrivilegeToShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			On: &ShareGrantOn{
+				DynamicTable: &OnDynamicTable{
+					AllInSchema: otherID,
+				},
+			},
+			to: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "GRANT SELECT ON ALL DYNAMIC TABLES IN SCHEMA %s TO SHARE %s", otherID.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("on materialized view", func(t *testing.T) {
+		otherID := RandomSchemaObjectIdentifier()
+		opts := &grantPrivilegeToShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			On: &ShareGrantOn{
+				MaterializedView: otherID,
+			},
+			to: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "GRANT SELECT ON MATERIALIZED VIEW %s TO SHARE %s", otherID.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("validation: exactly one object set", func(t *testing.T) {
+		opts := &grantPrivilegeToShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			On: &ShareGrantOn{
+				View:             RandomSchemaObjectIdentifier(),
+				MaterializedView: RandomSchemaObjectIdentifier(),
+			},
+			to: id,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ShareGrantOn", "Database", "Schema", "Function", "Table", "ExternalTable", "IcebergTable", "DynamicTable", "Tag", "View", "MaterializedView"))
+	})
+
+	t.Run("validation: exactly one of name and all in schema", func(t *testing.T) {
+		opts := &grantPrivilegeToShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			On: &ShareGrantOn{
+				DynamicTable: &OnDynamicTable{},
+			},
+			to: id,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("OnDynamicTable", "Name", "AllInSchema"))
+	})
 }
 
 func TestRevokePrivilegeFromShare(t *testing.T) {
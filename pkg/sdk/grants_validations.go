@@ -11,6 +11,12 @@ var (
 	_ validatable = new(RevokePrivilegesFromAccountRoleOptions)
 	_ validatable = new(GrantPrivilegesToDatabaseRoleOptions)
 	_ validatable = new(RevokePrivilegesFromDatabaseRoleOptions)
+	_ validatable = new(GrantPrivilegesToApplicationRoleOptions)
+	_ validatable = new(RevokePrivilegesFromApplicationRoleOptions)
+	_ validatable = new(grantPrivilegesToApplicationOptions)
+	_ validatable = new(revokePrivilegesFromApplicationOptions)
+	_ validatable = new(grantApplicationRoleOptions)
+	_ validatable = new(revokeApplicationRoleOptions)
 	_ validatable = new(grantPrivilegeToShareOptions)
 	_ validatable = new(revokePrivilegeFromShareOptions)
 	_ validatable = new(GrantOwnershipOptions)
@@ -347,6 +353,144 @@ func (opts *RevokePrivilegesFromDatabaseRoleOptions) validate() error {
 	return errors.Join(errs...)
 }
 
+func (opts *GrantPrivilegesToApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("GrantPrivilegesToApplicationRoleOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("GrantPrivilegesToApplicationRoleOptions", "on"))
+	} else {
+		if err := opts.on.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.applicationRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *RevokePrivilegesFromApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "on"))
+	} else {
+		if err := opts.on.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.applicationRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.Restrict, opts.Cascade) {
+		errs = append(errs, errOneOf("RevokePrivilegesFromApplicationRoleOptions", "Restrict", "Cascade"))
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *grantPrivilegesToApplicationOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("grantPrivilegesToApplicationOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("grantPrivilegesToApplicationOptions", "on"))
+	} else {
+		if err := opts.on.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.application) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *revokePrivilegesFromApplicationOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("revokePrivilegesFromApplicationOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("revokePrivilegesFromApplicationOptions", "on"))
+	} else {
+		if err := opts.on.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.application) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *grantApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if err := opts.To.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *revokeApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if err := opts.From.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (v *ApplicationRoleGrantee) validate() error {
+	if !exactlyOneValueSet(v.AccountRole, v.ApplicationRole, v.Application) {
+		return errExactlyOneOf("ApplicationRoleGrantee", "AccountRole", "ApplicationRole", "Application")
+	}
+	return nil
+}
+
 func (opts *grantPrivilegeToShareOptions) validate() error {
 	if opts == nil {
 		return errors.Join(ErrNilOptions)
@@ -368,14 +512,29 @@ func (opts *grantPrivilegeToShareOptions) validate() error {
 
 func (v *ShareGrantOn) validate() error {
 	var errs []error
-	if !exactlyOneValueSet(v.Database, v.Schema, v.Function, v.Table, v.Tag, v.View) {
-		errs = append(errs, errExactlyOneOf("ShareGrantOn", "Database", "Schema", "Function", "Table", "Tag", "View"))
+	if !exactlyOneValueSet(v.Database, v.Schema, v.Function, v.Table, v.ExternalTable, v.IcebergTable, v.DynamicTable, v.Tag, v.View, v.MaterializedView) {
+		errs = append(errs, errExactlyOneOf("ShareGrantOn", "Database", "Schema", "Function", "Table", "ExternalTable", "IcebergTable", "DynamicTable", "Tag", "View", "MaterializedView"))
 	}
 	if valueSet(v.Table) {
 		if err := v.Table.validate(); err != nil {
 			errs = append(errs, err)
 		}
 	}
+	if valueSet(v.ExternalTable) {
+		if err := v.ExternalTable.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(v.IcebergTable) {
+		if err := v.IcebergTable.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(v.DynamicTable) {
+		if err := v.DynamicTable.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	return errors.Join(errs...)
 }
 
@@ -386,6 +545,27 @@ func (v *OnTable) validate() error {
 	return nil
 }
 
+func (v *OnExternalTable) validate() error {
+	if !exactlyOneValueSet(v.Name, v.AllInSchema) {
+		return errExactlyOneOf("OnExternalTable", "Name", "AllInSchema")
+	}
+	return nil
+}
+
+func (v *OnIcebergTable) validate() error {
+	if !exactlyOneValueSet(v.Name, v.AllInSchema) {
+		return errExactlyOneOf("OnIcebergTable", "Name", "AllInSchema")
+	}
+	return nil
+}
+
+func (v *OnDynamicTable) validate() error {
+	if !exactlyOneValueSet(v.Name, v.AllInSchema) {
+		return errExactlyOneOf("OnDynamicTable", "Name", "AllInSchema")
+	}
+	return nil
+}
+
 func (opts *revokePrivilegeFromShareOptions) validate() error {
 	if opts == nil {
 		return errors.Join(ErrNilOptions)
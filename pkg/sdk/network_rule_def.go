@@ -13,6 +13,13 @@ const (
 	NetworkRuleTypeHostPort         NetworkRuleType = "HOST_PORT"
 )
 
+var AllNetworkRuleTypes = []NetworkRuleType{
+	NetworkRuleTypeIpv4,
+	NetworkRuleTypeAwsVpcEndpointId,
+	NetworkRuleTypeAzureLinkId,
+	NetworkRuleTypeHostPort,
+}
+
 type NetworkRuleMode string
 
 const (
@@ -21,6 +28,12 @@ const (
 	NetworkRuleModeEgress        NetworkRuleMode = "EGRESS"
 )
 
+var AllNetworkRuleModes = []NetworkRuleMode{
+	NetworkRuleModeIngress,
+	NetworkRuleModeInternalStage,
+	NetworkRuleModeEgress,
+}
+
 var NetworkRuleDef = g.NewInterface(
 	"NetworkRules",
 	"NetworkRule",
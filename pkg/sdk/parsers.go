@@ -1,6 +1,8 @@
 package sdk
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -15,3 +17,21 @@ func ParseTimestampWithOffset(s string, dateTimeFormat string) (string, error) {
 	adjustedTimeFormat := adjustedTime.Format(dateTimeFormat)
 	return adjustedTimeFormat, nil
 }
+
+// NetworkRulesSnowflakeDTO is a single entry of the ALLOWED_NETWORK_RULE_LIST and BLOCKED_NETWORK_RULE_LIST properties
+// returned by DESCRIBE NETWORK POLICY.
+type NetworkRulesSnowflakeDTO struct {
+	FullyQualifiedRuleName string `json:"fullyQualifiedRuleName"`
+}
+
+// ParseNetworkRulesSnowflakeDto parses the network rule lists of DESCRIBE NETWORK POLICY, which are returned as JSON arrays.
+func ParseNetworkRulesSnowflakeDto(value string) ([]NetworkRulesSnowflakeDTO, error) {
+	result := make([]NetworkRulesSnowflakeDTO, 0)
+	if value == "" {
+		return result, nil
+	}
+	if err := json.Unmarshal([]byte(value), &result); err != nil {
+		return nil, fmt.Errorf("unable to parse network rules %s: %w", value, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNetworkRulesSnowflakeDto(t *testing.T) {
+	t.Run("empty value", func(t *testing.T) {
+		rules, err := ParseNetworkRulesSnowflakeDto("")
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+	})
+
+	t.Run("rules", func(t *testing.T) {
+		rules, err := ParseNetworkRulesSnowflakeDto(`[{"fullyQualifiedRuleName":"\"DB\".\"SCHEMA\".\"RULE_1\""},{"fullyQualifiedRuleName":"DB.SCHEMA.RULE_2"}]`)
+		require.NoError(t, err)
+		assert.Equal(t, []NetworkRulesSnowflakeDTO{
+			{FullyQualifiedRuleName: `"DB"."SCHEMA"."RULE_1"`},
+			{FullyQualifiedRuleName: "DB.SCHEMA.RULE_2"},
+		}, rules)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := ParseNetworkRulesSnowflakeDto("DB.SCHEMA.RULE")
+		require.ErrorContains(t, err, "unable to parse network rules DB.SCHEMA.RULE")
+	})
+}
@@ -1,9 +1,17 @@
 package sdk
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
 type GlobalPrivilege string
 
 const (
 	GlobalPrivilegeCreateAccount             GlobalPrivilege = "CREATE ACCOUNT"
+	GlobalPrivilegeCreateApplication         GlobalPrivilege = "CREATE APPLICATION"
+	GlobalPrivilegeCreateApplicationPackage  GlobalPrivilege = "CREATE APPLICATION PACKAGE"
 	GlobalPrivilegeCreateComputePool         GlobalPrivilege = "CREATE COMPUTE POOL"
 	GlobalPrivilegeCreateDataExchangeListing GlobalPrivilege = "CREATE DATA EXCHANGE LISTING"
 	GlobalPrivilegeCreateDatabase            GlobalPrivilege = "CREATE DATABASE"
@@ -31,8 +39,9 @@ const (
 	GlobalPrivilegeAudit               GlobalPrivilege = "AUDIT"
 	GlobalPrivilegeBindServiceEndpoint GlobalPrivilege = "BIND SERVICE ENDPOINT"
 
-	GlobalPrivilegeExecuteAlert GlobalPrivilege = "EXECUTE ALERT"
-	GlobalPrivilegeExecuteTask  GlobalPrivilege = "EXECUTE TASK"
+	GlobalPrivilegeExecuteAlert       GlobalPrivilege = "EXECUTE ALERT"
+	GlobalPrivilegeExecuteManagedTask GlobalPrivilege = "EXECUTE MANAGED TASK"
+	GlobalPrivilegeExecuteTask        GlobalPrivilege = "EXECUTE TASK"
 
 	GlobalPrivilegeImportShare GlobalPrivilege = "IMPORT SHARE"
 
@@ -226,6 +235,7 @@ const (
 	// SchemaObjectPrivilegeSelect 		SchemaObjectPrivilege = "SELECT" (duplicate)
 	// SchemaObjectPrivilegeInsert 		SchemaObjectPrivilege = "INSERT" (duplicate)
 	SchemaObjectPrivilegeEvolveSchema SchemaObjectPrivilege = "EVOLVE SCHEMA"
+	SchemaObjectPrivilegeRebuild      SchemaObjectPrivilege = "REBUILD"
 	SchemaObjectPrivilegeUpdate       SchemaObjectPrivilege = "UPDATE"
 	SchemaObjectPrivilegeDelete       SchemaObjectPrivilege = "DELETE"
 	SchemaObjectPrivilegeTruncate     SchemaObjectPrivilege = "TRUNCATE"
@@ -265,3 +275,145 @@ const (
 func (p ObjectPrivilege) String() string {
 	return string(p)
 }
+
+func privilegeNames[T ~string](privileges ...T) []string {
+	names := make([]string, len(privileges))
+	for i, privilege := range privileges {
+		names[i] = string(privilege)
+	}
+	return names
+}
+
+func allGlobalPrivileges() []GlobalPrivilege {
+	return []GlobalPrivilege{
+		GlobalPrivilegeCreateAccount, GlobalPrivilegeCreateApplication, GlobalPrivilegeCreateApplicationPackage, GlobalPrivilegeCreateComputePool, GlobalPrivilegeCreateDataExchangeListing, GlobalPrivilegeCreateDatabase,
+		GlobalPrivilegeCreateFailoverGroup, GlobalPrivilegeCreateIntegration, GlobalPrivilegeCreateNetworkPolicy, GlobalPrivilegeCreateExternalVolume,
+		GlobalPrivilegeCreateReplicationGroup, GlobalPrivilegeCreateRole, GlobalPrivilegeCreateShare, GlobalPrivilegeCreateUser, GlobalPrivilegeCreateWarehouse,
+		GlobalPrivilegeApplyAggregationPolicy, GlobalPrivilegeApplyAuthenticationPolicy, GlobalPrivilegeApplyMaskingPolicy, GlobalPrivilegeApplyPackagesPolicy,
+		GlobalPrivilegeApplyPasswordPolicy, GlobalPrivilegeApplyProjectionPolicy, GlobalPrivilegeApplyRowAccessPolicy, GlobalPrivilegeApplySessionPolicy, GlobalPrivilegeApplyTag,
+		GlobalPrivilegeAttachPolicy, GlobalPrivilegeAudit, GlobalPrivilegeBindServiceEndpoint,
+		GlobalPrivilegeExecuteAlert, GlobalPrivilegeExecuteManagedTask, GlobalPrivilegeExecuteTask,
+		GlobalPrivilegeImportShare,
+		GlobalPrivilegeManageGrants, GlobalPrivilegeManageListingAutoFulfillment, GlobalPrivilegeManageWarehouses,
+		GlobalPrivilegeModifyLogLevel, GlobalPrivilegeModifyTraceLevel, GlobalPrivilegeModifySessionLogLevel, GlobalPrivilegeModifySessionTraceLevel,
+		GlobalPrivilegeMonitorExecution, GlobalPrivilegeMonitorSecurity, GlobalPrivilegeMonitorUsage,
+		GlobalPrivilegeOverrideShareRestrictions, GlobalPrivilegePurchaseDataExchangeListing, GlobalPrivilegeResolveAll,
+	}
+}
+
+func allSchemaPrivileges() []SchemaPrivilege {
+	return []SchemaPrivilege{
+		SchemaPrivilegeAddSearchOptimization, SchemaPrivilegeApplyBudget, SchemaPrivilegeCreateAlert, SchemaPrivilegeCreateDynamicTable,
+		SchemaPrivilegeCreateExternalTable, SchemaPrivilegeCreateFileFormat, SchemaPrivilegeCreateFunction, SchemaPrivilegeCreateHybridTable,
+		SchemaPrivilegeCreateImageRepository, SchemaPrivilegeCreateIcebergTable, SchemaPrivilegeCreateMaterializedView, SchemaPrivilegeCreateModel,
+		SchemaPrivilegeCreateNetworkRule, SchemaPrivilegeCreatePipe, SchemaPrivilegeCreateProcedure, SchemaPrivilegeCreateAggregationPolicy,
+		SchemaPrivilegeCreateAuthenticationPolicy, SchemaPrivilegeCreateMaskingPolicy, SchemaPrivilegeCreatePackagesPolicy, SchemaPrivilegeCreatePasswordPolicy,
+		SchemaPrivilegeCreateProjectionPolicy, SchemaPrivilegeCreateRowAccessPolicy, SchemaPrivilegeCreateSessionPolicy, SchemaPrivilegeCreateService,
+		SchemaPrivilegeCreateSecret, SchemaPrivilegeCreateSequence, SchemaPrivilegeCreateStage, SchemaPrivilegeCreateStream, SchemaPrivilegeCreateStreamlit,
+		SchemaPrivilegeCreateSnowflakeCoreBudget, SchemaPrivilegeCreateSnowflakeMlAnomalyDetection, SchemaPrivilegeCreateSnowflakeMlForecast,
+		SchemaPrivilegeCreateTag, SchemaPrivilegeCreateTable, SchemaPrivilegeCreateTask, SchemaPrivilegeCreateView,
+		SchemaPrivilegeModify, SchemaPrivilegeMonitor, SchemaPrivilegeUsage,
+	}
+}
+
+// privilegesByObjectType is the catalog of privileges (without OWNERSHIP and ALL PRIVILEGES) which can be granted on the object types,
+// based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege. The privileges on the account are listed under ObjectTypeAccount.
+// Stages are listed with the privileges of both internal and external stages, because the kind is not known from the object type.
+var privilegesByObjectType = map[ObjectType][]string{
+	ObjectTypeAccount: privilegeNames(allGlobalPrivileges()...),
+
+	ObjectTypeComputePool:      privilegeNames(AccountObjectPrivilegeModify, AccountObjectPrivilegeMonitor, AccountObjectPrivilegeOperate, AccountObjectPrivilegeUsage),
+	ObjectTypeDatabase:         privilegeNames(AccountObjectPrivilegeApplyBudget, AccountObjectPrivilegeCreateDatabaseRole, AccountObjectPrivilegeCreateSchema, AccountObjectPrivilegeImportedPrivileges, AccountObjectPrivilegeModify, AccountObjectPrivilegeMonitor, AccountObjectPrivilegeUsage),
+	ObjectTypeExternalVolume:   privilegeNames(AccountObjectPrivilegeUsage),
+	ObjectTypeFailoverGroup:    privilegeNames(AccountObjectPrivilegeFailover, AccountObjectPrivilegeModify, AccountObjectPrivilegeMonitor, AccountObjectPrivilegeReplicate),
+	ObjectTypeIntegration:      privilegeNames(AccountObjectPrivilegeUsage, AccountObjectPrivilegeUseAnyRole),
+	ObjectTypeReplicationGroup: privilegeNames(AccountObjectPrivilegeModify, AccountObjectPrivilegeMonitor, AccountObjectPrivilegeReplicate),
+	ObjectTypeResourceMonitor:  privilegeNames(AccountObjectPrivilegeModify, AccountObjectPrivilegeMonitor),
+	ObjectTypeUser:             privilegeNames(AccountObjectPrivilegeModify, AccountObjectPrivilegeMonitor),
+	ObjectTypeWarehouse:        privilegeNames(AccountObjectPrivilegeApplyBudget, AccountObjectPrivilegeModify, AccountObjectPrivilegeMonitor, AccountObjectPrivilegeOperate, AccountObjectPrivilegeUsage),
+
+	ObjectTypeSchema: privilegeNames(allSchemaPrivileges()...),
+
+	ObjectTypeAlert:                privilegeNames(SchemaObjectPrivilegeMonitor, SchemaObjectPrivilegeOperate),
+	ObjectTypeDynamicTable:         privilegeNames(SchemaObjectPrivilegeMonitor, SchemaObjectPrivilegeOperate, SchemaObjectPrivilegeSelect),
+	ObjectTypeEventTable:           privilegeNames(SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeInsert),
+	ObjectTypeExternalTable:        privilegeNames(SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeReferences),
+	ObjectTypeFileFormat:           privilegeNames(SchemaObjectPrivilegeUsage),
+	ObjectTypeFunction:             privilegeNames(SchemaObjectPrivilegeUsage),
+	ObjectTypeExternalFunction:     privilegeNames(SchemaObjectPrivilegeUsage),
+	ObjectTypeProcedure:            privilegeNames(SchemaObjectPrivilegeUsage),
+	ObjectTypeSecret:               privilegeNames(SchemaObjectPrivilegeUsage),
+	ObjectTypeSequence:             privilegeNames(SchemaObjectPrivilegeUsage),
+	ObjectTypeHybridTable:          privilegeNames(SchemaObjectPrivilegeInsert, SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeUpdate),
+	ObjectTypeImageRepository:      privilegeNames(SchemaObjectPrivilegeRead, SchemaObjectPrivilegeWrite),
+	ObjectTypeIcebergTable:         privilegeNames(SchemaObjectPrivilegeApplyBudget, SchemaObjectPrivilegeDelete, SchemaObjectPrivilegeInsert, SchemaObjectPrivilegeReferences, SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeTruncate, SchemaObjectPrivilegeUpdate),
+	ObjectTypePipe:                 privilegeNames(SchemaObjectPrivilegeApplyBudget, SchemaObjectPrivilegeMonitor, SchemaObjectPrivilegeOperate),
+	ObjectTypeAggregationPolicy:    privilegeNames(SchemaObjectPrivilegeApply),
+	ObjectTypeAuthenticationPolicy: privilegeNames(SchemaObjectPrivilegeApply),
+	ObjectTypeMaskingPolicy:        privilegeNames(SchemaObjectPrivilegeApply),
+	ObjectTypePackagesPolicy:       privilegeNames(SchemaObjectPrivilegeApply),
+	ObjectTypePasswordPolicy:       privilegeNames(SchemaObjectPrivilegeApply),
+	ObjectTypeProjectionPolicy:     privilegeNames(SchemaObjectPrivilegeApply),
+	ObjectTypeRowAccessPolicy:      privilegeNames(SchemaObjectPrivilegeApply),
+	ObjectTypeSessionPolicy:        privilegeNames(SchemaObjectPrivilegeApply),
+	ObjectTypeTag:                  privilegeNames(SchemaObjectPrivilegeApply, SchemaObjectPrivilegeRead),
+	ObjectTypeStage:                privilegeNames(SchemaObjectPrivilegeUsage, SchemaObjectPrivilegeRead, SchemaObjectPrivilegeWrite),
+	ObjectTypeStream:               privilegeNames(SchemaObjectPrivilegeSelect),
+	ObjectTypeStreamlit:            privilegeNames(SchemaObjectPrivilegeUsage),
+	ObjectTypeTable:                privilegeNames(SchemaObjectPrivilegeApplyBudget, SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeInsert, SchemaObjectPrivilegeUpdate, SchemaObjectPrivilegeDelete, SchemaObjectPrivilegeTruncate, SchemaObjectPrivilegeReferences, SchemaObjectPrivilegeEvolveSchema, SchemaObjectPrivilegeRebuild),
+	ObjectTypeTask:                 privilegeNames(SchemaObjectPrivilegeApplyBudget, SchemaObjectPrivilegeMonitor, SchemaObjectPrivilegeOperate),
+	ObjectTypeView:                 privilegeNames(SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeReferences),
+	ObjectTypeMaterializedView:     privilegeNames(SchemaObjectPrivilegeApplyBudget, SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeReferences),
+}
+
+// ObjectTypesWithPrivileges returns the object types present in the privilege catalog, sorted by name.
+func ObjectTypesWithPrivileges() []ObjectType {
+	objectTypes := make([]ObjectType, 0, len(privilegesByObjectType))
+	for objectType := range privilegesByObjectType {
+		objectTypes = append(objectTypes, objectType)
+	}
+	slices.Sort(objectTypes)
+	return objectTypes
+}
+
+// PrivilegesForObjectType returns the privileges which can be granted on the object type, sorted by name.
+// The second return value is false when the object type is not present in the privilege catalog.
+func PrivilegesForObjectType(objectType ObjectType) ([]string, bool) {
+	privileges, ok := privilegesByObjectType[ObjectType(strings.ToUpper(objectType.String()))]
+	if !ok {
+		return nil, false
+	}
+	privileges = slices.Clone(privileges)
+	slices.Sort(privileges)
+	return privileges, true
+}
+
+// ValidatePrivilegesForObjectType returns an error listing the privileges which are known to be invalid for the object type,
+// i.e. the privileges present in the catalog for other object types only. The privileges absent from the whole catalog
+// (e.g. added to Snowflake after the catalog was written) and the object types not present in the catalog are not validated,
+// so that Snowflake stays the source of truth for them.
+func ValidatePrivilegesForObjectType(objectType ObjectType, privileges []string) error {
+	valid, ok := PrivilegesForObjectType(objectType)
+	if !ok {
+		return nil
+	}
+	invalid := make([]string, 0)
+	for _, privilege := range privileges {
+		if privilege = strings.ToUpper(privilege); !slices.Contains(valid, privilege) && isCatalogPrivilege(privilege) {
+			invalid = append(invalid, privilege)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("privileges %s cannot be granted on %s, valid privileges are: %s", strings.Join(invalid, ", "), strings.ToUpper(objectType.String()), strings.Join(valid, ", "))
+	}
+	return nil
+}
+
+func isCatalogPrivilege(privilege string) bool {
+	for _, privileges := range privilegesByObjectType {
+		if slices.Contains(privileges, privilege) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivilegesForObjectType(t *testing.T) {
+	t.Run("known object type", func(t *testing.T) {
+		privileges, ok := PrivilegesForObjectType(ObjectTypeView)
+		require.True(t, ok)
+		assert.Equal(t, []string{"REFERENCES", "SELECT"}, privileges)
+	})
+
+	t.Run("object type in lowercase", func(t *testing.T) {
+		privileges, ok := PrivilegesForObjectType("view")
+		require.True(t, ok)
+		assert.Equal(t, []string{"REFERENCES", "SELECT"}, privileges)
+	})
+
+	t.Run("unknown object type", func(t *testing.T) {
+		_, ok := PrivilegesForObjectType(ObjectTypeNetworkRule)
+		assert.False(t, ok)
+	})
+
+	t.Run("every object type has privileges", func(t *testing.T) {
+		for _, objectType := range ObjectTypesWithPrivileges() {
+			privileges, ok := PrivilegesForObjectType(objectType)
+			require.True(t, ok)
+			assert.NotEmpty(t, privileges, objectType)
+			assert.NotContains(t, privileges, SchemaObjectOwnership.String(), objectType)
+		}
+	})
+}
+
+func TestValidatePrivilegesForObjectType(t *testing.T) {
+	t.Run("valid privileges", func(t *testing.T) {
+		assert.NoError(t, ValidatePrivilegesForObjectType(ObjectTypeTable, []string{"SELECT", "insert"}))
+		assert.NoError(t, ValidatePrivilegesForObjectType(ObjectTypeAccount, []string{"CREATE DATABASE"}))
+		assert.NoError(t, ValidatePrivilegesForObjectType(ObjectTypeAccount, []string{"EXECUTE MANAGED TASK", "CREATE APPLICATION", "CREATE APPLICATION PACKAGE"}))
+		assert.NoError(t, ValidatePrivilegesForObjectType(ObjectTypeTable, []string{"REBUILD"}))
+	})
+
+	t.Run("privileges absent from the catalog are not validated", func(t *testing.T) {
+		assert.NoError(t, ValidatePrivilegesForObjectType(ObjectTypeTable, []string{"SELECT", "SOME NEW PRIVILEGE"}))
+	})
+
+	t.Run("invalid privileges", func(t *testing.T) {
+		err := ValidatePrivilegesForObjectType(ObjectTypeView, []string{"SELECT", "INSERT", "TRUNCATE"})
+		assert.ErrorContains(t, err, "privileges INSERT, TRUNCATE cannot be granted on VIEW, valid privileges are: REFERENCES, SELECT")
+	})
+
+	t.Run("unknown object type is not validated", func(t *testing.T) {
+		assert.NoError(t, ValidatePrivilegesForObjectType(ObjectTypeNetworkRule, []string{"ANYTHING"}))
+	})
+}
@@ -239,6 +239,17 @@ const (
 	ResourceMonitorLevelNull
 )
 
+func (l ResourceMonitorLevel) String() string {
+	switch l {
+	case ResourceMonitorLevelAccount:
+		return "ACCOUNT"
+	case ResourceMonitorLevelWarehouse:
+		return "WAREHOUSE"
+	default:
+		return ""
+	}
+}
+
 type TriggerDefinition struct {
 	Threshold     int           `ddl:"parameter,no_equals" sql:"ON"`
 	TriggerAction TriggerAction `ddl:"parameter,no_equals" sql:"PERCENT DO"`
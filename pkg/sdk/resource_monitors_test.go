@@ -3,6 +3,8 @@ package sdk
 import (
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestResourceMonitorCreate(t *testing.T) {
@@ -148,3 +150,9 @@ func TestResourceMonitorShow(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "SHOW RESOURCE MONITORS LIKE '%s'", id.Name())
 	})
 }
+
+func TestResourceMonitorLevelString(t *testing.T) {
+	assert.Equal(t, "ACCOUNT", ResourceMonitorLevel(ResourceMonitorLevelAccount).String())
+	assert.Equal(t, "WAREHOUSE", ResourceMonitorLevel(ResourceMonitorLevelWarehouse).String())
+	assert.Equal(t, "", ResourceMonitorLevel(ResourceMonitorLevelNull).String())
+}
@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+var (
+	_ convertibleRow[StageFilePutResult] = new(stageFilePutResultDBRow)
+	_ convertibleRow[StageFile]          = new(stageFileDBRow)
+)
+
+// StageFiles manages files stored in stages with PUT, LIST and REMOVE commands.
+type StageFiles interface {
+	Put(ctx context.Context, localPath string, location StageLocation, opts *PutStageFileOptions) ([]StageFilePutResult, error)
+	List(ctx context.Context, location StageLocation, opts *ListStageFilesOptions) ([]StageFile, error)
+	Remove(ctx context.Context, location StageLocation, opts *RemoveStageFilesOptions) error
+}
+
+// StageLocation is a path inside a stage, e.g. @"db"."schema"."stage"/path/file.zip.
+type StageLocation struct {
+	stageId SchemaObjectIdentifier
+	path    string
+}
+
+func NewStageLocation(stageId SchemaObjectIdentifier, path string) StageLocation {
+	return StageLocation{
+		stageId: stageId,
+		path:    strings.Trim(path, "/"),
+	}
+}
+
+func (l StageLocation) StageId() SchemaObjectIdentifier {
+	return l.stageId
+}
+
+func (l StageLocation) Path() string {
+	return l.path
+}
+
+func (l StageLocation) String() string {
+	if l.path == "" {
+		return fmt.Sprintf("@%s", l.stageId.FullyQualifiedName())
+	}
+	return fmt.Sprintf("@%s/%s", l.stageId.FullyQualifiedName(), l.path)
+}
+
+// PutStageFileOptions is based on https://docs.snowflake.com/en/sql-reference/sql/put.
+type PutStageFileOptions struct {
+	put               bool               `ddl:"static" sql:"PUT"`
+	source            string             `ddl:"keyword,single_quotes"`
+	location          string             `ddl:"keyword,no_quotes"`
+	Parallel          *int               `ddl:"parameter" sql:"PARALLEL"`
+	AutoCompress      *bool              `ddl:"parameter" sql:"AUTO_COMPRESS"`
+	SourceCompression *SourceCompression `ddl:"parameter,no_quotes" sql:"SOURCE_COMPRESSION"`
+	Overwrite         *bool              `ddl:"parameter" sql:"OVERWRITE"`
+}
+
+type SourceCompression string
+
+const (
+	SourceCompressionAutoDetect SourceCompression = "AUTO_DETECT"
+	SourceCompressionGzip       SourceCompression = "GZIP"
+	SourceCompressionBz2        SourceCompression = "BZ2"
+	SourceCompressionBrotli     SourceCompression = "BROTLI"
+	SourceCompressionZstd       SourceCompression = "ZSTD"
+	SourceCompressionDeflate    SourceCompression = "DEFLATE"
+	SourceCompressionRawDeflate SourceCompression = "RAW_DEFLATE"
+	SourceCompressionNone       SourceCompression = "NONE"
+)
+
+// ListStageFilesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/list.
+type ListStageFilesOptions struct {
+	list     bool    `ddl:"static" sql:"LIST"`
+	location string  `ddl:"keyword,no_quotes"`
+	Pattern  *string `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+// RemoveStageFilesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/remove.
+type RemoveStageFilesOptions struct {
+	remove   bool    `ddl:"static" sql:"REMOVE"`
+	location string  `ddl:"keyword,no_quotes"`
+	Pattern  *string `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+// stageFilePutResultDBRow is used to decode the result of a PUT command.
+type stageFilePutResultDBRow struct {
+	Source            string         `db:"source"`
+	Target            string         `db:"target"`
+	SourceSize        int64          `db:"source_size"`
+	TargetSize        int64          `db:"target_size"`
+	SourceCompression string         `db:"source_compression"`
+	TargetCompression string         `db:"target_compression"`
+	Status            string         `db:"status"`
+	Message           sql.NullString `db:"message"`
+}
+
+// StageFilePutResult is a user-friendly result for a PUT command.
+type StageFilePutResult struct {
+	Source            string
+	Target            string
+	SourceSize        int64
+	TargetSize        int64
+	SourceCompression string
+	TargetCompression string
+	Status            string
+	Message           string
+}
+
+func (row stageFilePutResultDBRow) convert() *StageFilePutResult {
+	result := &StageFilePutResult{
+		Source:            row.Source,
+		Target:            row.Target,
+		SourceSize:        row.SourceSize,
+		TargetSize:        row.TargetSize,
+		SourceCompression: row.SourceCompression,
+		TargetCompression: row.TargetCompression,
+		Status:            row.Status,
+	}
+	if row.Message.Valid {
+		result.Message = row.Message.String
+	}
+	return result
+}
+
+// stageFileDBRow is used to decode the result of a LIST command.
+type stageFileDBRow struct {
+	Name         string         `db:"name"`
+	Size         int64          `db:"size"`
+	MD5          sql.NullString `db:"md5"`
+	LastModified string         `db:"last_modified"`
+}
+
+// StageFile is a user-friendly result for a LIST command.
+//
+// Based on https://docs.snowflake.com/en/sql-reference/sql/list.
+type StageFile struct {
+	// Name is the path of the file prefixed with the stage name, e.g. my_stage/path/file.zip.
+	Name         string
+	Size         int64
+	MD5          string
+	LastModified string
+}
+
+func (row stageFileDBRow) convert() *StageFile {
+	file := &StageFile{
+		Name:         row.Name,
+		Size:         row.Size,
+		LastModified: row.LastModified,
+	}
+	if row.MD5.Valid {
+		file.MD5 = row.MD5.String
+	}
+	return file
+}
@@ -0,0 +1,39 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+var _ StageFiles = (*stageFiles)(nil)
+
+type stageFiles struct {
+	client *Client
+}
+
+func (v *stageFiles) Put(ctx context.Context, localPath string, location StageLocation, opts *PutStageFileOptions) ([]StageFilePutResult, error) {
+	opts = createIfNil[PutStageFileOptions](opts)
+	opts.source = fmt.Sprintf("file://%s", localPath)
+	opts.location = location.String()
+	dbRows, err := validateAndQuery[stageFilePutResultDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[stageFilePutResultDBRow, StageFilePutResult](dbRows), nil
+}
+
+func (v *stageFiles) List(ctx context.Context, location StageLocation, opts *ListStageFilesOptions) ([]StageFile, error) {
+	opts = createIfNil[ListStageFilesOptions](opts)
+	opts.location = location.String()
+	dbRows, err := validateAndQuery[stageFileDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[stageFileDBRow, StageFile](dbRows), nil
+}
+
+func (v *stageFiles) Remove(ctx context.Context, location StageLocation, opts *RemoveStageFilesOptions) error {
+	opts = createIfNil[RemoveStageFilesOptions](opts)
+	opts.location = location.String()
+	return validateAndExec(v.client, ctx, opts)
+}
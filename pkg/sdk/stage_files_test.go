@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestStageFilesPut(t *testing.T) {
+	location := NewStageLocation(RandomSchemaObjectIdentifier(), "/path/to/")
+
+	defaultOpts := func() *PutStageFileOptions {
+		return &PutStageFileOptions{
+			source:   "file:///tmp/file.zip",
+			location: location.String(),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *PutStageFileOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: source required", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.source = "file://"
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("PutStageFileOptions", "source"))
+	})
+
+	t.Run("validation: location required", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.location = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("PutStageFileOptions", "location"))
+	})
+
+	t.Run("validation: parallel out of range", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Parallel = Int(100)
+		assertOptsInvalidJoinedErrors(t, opts, errIntBetween("PutStageFileOptions", "Parallel", 1, 99))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/file.zip' @%s/path/to`, location.StageId().FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Parallel = Int(4)
+		opts.AutoCompress = Bool(false)
+		opts.SourceCompression = Pointer(SourceCompressionNone)
+		opts.Overwrite = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/file.zip' @%s/path/to PARALLEL = 4 AUTO_COMPRESS = false SOURCE_COMPRESSION = NONE OVERWRITE = true`, location.StageId().FullyQualifiedName())
+	})
+}
+
+func TestStageFilesList(t *testing.T) {
+	location := NewStageLocation(RandomSchemaObjectIdentifier(), "path")
+
+	t.Run("validation: location required", func(t *testing.T) {
+		opts := &ListStageFilesOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("ListStageFilesOptions", "location"))
+	})
+
+	t.Run("with pattern", func(t *testing.T) {
+		opts := &ListStageFilesOptions{
+			location: location.String(),
+			Pattern:  String(".*[.]zip"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `LIST @%s/path PATTERN = '.*[.]zip'`, location.StageId().FullyQualifiedName())
+	})
+}
+
+func TestStageFilesRemove(t *testing.T) {
+	stageId := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: location required", func(t *testing.T) {
+		opts := &RemoveStageFilesOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("RemoveStageFilesOptions", "location"))
+	})
+
+	t.Run("whole stage", func(t *testing.T) {
+		opts := &RemoveStageFilesOptions{
+			location: NewStageLocation(stageId, "").String(),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE @%s`, stageId.FullyQualifiedName())
+	})
+}
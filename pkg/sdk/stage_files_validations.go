@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	_ validatable = new(PutStageFileOptions)
+	_ validatable = new(ListStageFilesOptions)
+	_ validatable = new(RemoveStageFilesOptions)
+)
+
+func (opts *PutStageFileOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if strings.TrimPrefix(opts.source, "file://") == "" {
+		errs = append(errs, errNotSet("PutStageFileOptions", "source"))
+	}
+	if opts.location == "" {
+		errs = append(errs, errNotSet("PutStageFileOptions", "location"))
+	}
+	if opts.Parallel != nil && (*opts.Parallel < 1 || *opts.Parallel > 99) {
+		errs = append(errs, errIntBetween("PutStageFileOptions", "Parallel", 1, 99))
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *ListStageFilesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if opts.location == "" {
+		return errNotSet("ListStageFilesOptions", "location")
+	}
+	return nil
+}
+
+func (opts *RemoveStageFilesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if opts.location == "" {
+		return errNotSet("RemoveStageFilesOptions", "location")
+	}
+	return nil
+}
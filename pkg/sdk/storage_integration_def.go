@@ -25,7 +25,8 @@ var StorageIntegrationDef = g.NewInterface(
 				g.NewQueryStruct("S3StorageParams").
 					PredefinedQueryStructField("storageProvider", "string", g.StaticOptions().SQL("STORAGE_PROVIDER = 'S3'")).
 					TextAssignment("STORAGE_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes().Required()).
-					OptionalTextAssignment("STORAGE_AWS_OBJECT_ACL", g.ParameterOptions().SingleQuotes()),
+					OptionalTextAssignment("STORAGE_AWS_OBJECT_ACL", g.ParameterOptions().SingleQuotes()).
+					OptionalBooleanAssignment("USE_PRIVATELINK_ENDPOINT", g.ParameterOptions()),
 				g.KeywordOptions(),
 			).
 			OptionalQueryStructField(
@@ -38,7 +39,8 @@ var StorageIntegrationDef = g.NewInterface(
 				"AzureStorageProviderParams",
 				g.NewQueryStruct("AzureStorageParams").
 					PredefinedQueryStructField("storageProvider", "string", g.StaticOptions().SQL("STORAGE_PROVIDER = 'AZURE'")).
-					OptionalTextAssignment("AZURE_TENANT_ID", g.ParameterOptions().SingleQuotes().Required()),
+					OptionalTextAssignment("AZURE_TENANT_ID", g.ParameterOptions().SingleQuotes().Required()).
+					OptionalBooleanAssignment("USE_PRIVATELINK_ENDPOINT", g.ParameterOptions()),
 				g.KeywordOptions(),
 			).
 			BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
@@ -64,13 +66,15 @@ var StorageIntegrationDef = g.NewInterface(
 						"S3Params",
 						g.NewQueryStruct("SetS3StorageParams").
 							TextAssignment("STORAGE_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes().Required()).
-							OptionalTextAssignment("STORAGE_AWS_OBJECT_ACL", g.ParameterOptions().SingleQuotes()),
+							OptionalTextAssignment("STORAGE_AWS_OBJECT_ACL", g.ParameterOptions().SingleQuotes()).
+							OptionalBooleanAssignment("USE_PRIVATELINK_ENDPOINT", g.ParameterOptions()),
 						g.KeywordOptions(),
 					).
 					OptionalQueryStructField(
 						"AzureParams",
 						g.NewQueryStruct("SetAzureStorageParams").
-							TextAssignment("AZURE_TENANT_ID", g.ParameterOptions().SingleQuotes().Required()),
+							TextAssignment("AZURE_TENANT_ID", g.ParameterOptions().SingleQuotes().Required()).
+							OptionalBooleanAssignment("USE_PRIVATELINK_ENDPOINT", g.ParameterOptions()),
 						g.KeywordOptions(),
 					).
 					BooleanAssignment("ENABLED", g.ParameterOptions()).
@@ -85,7 +89,8 @@ var StorageIntegrationDef = g.NewInterface(
 					OptionalSQL("STORAGE_AWS_OBJECT_ACL").
 					OptionalSQL("ENABLED").
 					OptionalSQL("STORAGE_BLOCKED_LOCATIONS").
-					OptionalSQL("COMMENT"),
+					OptionalSQL("COMMENT").
+					OptionalSQL("USE_PRIVATELINK_ENDPOINT"),
 				g.ListOptions().SQL("UNSET"),
 			).
 			OptionalSetTags().
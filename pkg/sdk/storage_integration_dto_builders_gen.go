@@ -64,6 +64,11 @@ func (s *S3StorageParamsRequest) WithStorageAwsObjectAcl(StorageAwsObjectAcl *st
 	return s
 }
 
+func (s *S3StorageParamsRequest) WithUsePrivatelinkEndpoint(UsePrivatelinkEndpoint *bool) *S3StorageParamsRequest {
+	s.UsePrivatelinkEndpoint = UsePrivatelinkEndpoint
+	return s
+}
+
 func NewGCSStorageParamsRequest() *GCSStorageParamsRequest {
 	return &GCSStorageParamsRequest{}
 }
@@ -76,6 +81,11 @@ func NewAzureStorageParamsRequest(
 	return &s
 }
 
+func (s *AzureStorageParamsRequest) WithUsePrivatelinkEndpoint(UsePrivatelinkEndpoint *bool) *AzureStorageParamsRequest {
+	s.UsePrivatelinkEndpoint = UsePrivatelinkEndpoint
+	return s
+}
+
 func NewAlterStorageIntegrationRequest(
 	name AccountObjectIdentifier,
 ) *AlterStorageIntegrationRequest {
@@ -156,6 +166,11 @@ func (s *SetS3StorageParamsRequest) WithStorageAwsObjectAcl(StorageAwsObjectAcl
 	return s
 }
 
+func (s *SetS3StorageParamsRequest) WithUsePrivatelinkEndpoint(UsePrivatelinkEndpoint *bool) *SetS3StorageParamsRequest {
+	s.UsePrivatelinkEndpoint = UsePrivatelinkEndpoint
+	return s
+}
+
 func NewSetAzureStorageParamsRequest(
 	AzureTenantId string,
 ) *SetAzureStorageParamsRequest {
@@ -164,6 +179,11 @@ func NewSetAzureStorageParamsRequest(
 	return &s
 }
 
+func (s *SetAzureStorageParamsRequest) WithUsePrivatelinkEndpoint(UsePrivatelinkEndpoint *bool) *SetAzureStorageParamsRequest {
+	s.UsePrivatelinkEndpoint = UsePrivatelinkEndpoint
+	return s
+}
+
 func NewStorageIntegrationUnsetRequest() *StorageIntegrationUnsetRequest {
 	return &StorageIntegrationUnsetRequest{}
 }
@@ -188,6 +208,11 @@ func (s *StorageIntegrationUnsetRequest) WithComment(Comment *bool) *StorageInte
 	return s
 }
 
+func (s *StorageIntegrationUnsetRequest) WithUsePrivatelinkEndpoint(UsePrivatelinkEndpoint *bool) *StorageIntegrationUnsetRequest {
+	s.UsePrivatelinkEndpoint = UsePrivatelinkEndpoint
+	return s
+}
+
 func NewDropStorageIntegrationRequest(
 	name AccountObjectIdentifier,
 ) *DropStorageIntegrationRequest {
@@ -24,14 +24,16 @@ type CreateStorageIntegrationRequest struct {
 }
 
 type S3StorageParamsRequest struct {
-	StorageAwsRoleArn   string // required
-	StorageAwsObjectAcl *string
+	StorageAwsRoleArn      string // required
+	StorageAwsObjectAcl    *string
+	UsePrivatelinkEndpoint *bool
 }
 
 type GCSStorageParamsRequest struct{}
 
 type AzureStorageParamsRequest struct {
-	AzureTenantId *string // required
+	AzureTenantId          *string // required
+	UsePrivatelinkEndpoint *bool
 }
 
 type AlterStorageIntegrationRequest struct {
@@ -53,12 +55,14 @@ type StorageIntegrationSetRequest struct {
 }
 
 type SetS3StorageParamsRequest struct {
-	StorageAwsRoleArn   string // required
-	StorageAwsObjectAcl *string
+	StorageAwsRoleArn      string // required
+	StorageAwsObjectAcl    *string
+	UsePrivatelinkEndpoint *bool
 }
 
 type SetAzureStorageParamsRequest struct {
-	AzureTenantId string // required
+	AzureTenantId          string // required
+	UsePrivatelinkEndpoint *bool
 }
 
 type StorageIntegrationUnsetRequest struct {
@@ -66,6 +70,7 @@ type StorageIntegrationUnsetRequest struct {
 	Enabled                 *bool
 	StorageBlockedLocations *bool
 	Comment                 *bool
+	UsePrivatelinkEndpoint  *bool
 }
 
 type DropStorageIntegrationRequest struct {
@@ -37,9 +37,10 @@ type StorageLocation struct {
 }
 
 type S3StorageParams struct {
-	storageProvider     string  `ddl:"static" sql:"STORAGE_PROVIDER = 'S3'"`
-	StorageAwsRoleArn   string  `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_ROLE_ARN"`
-	StorageAwsObjectAcl *string `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_OBJECT_ACL"`
+	storageProvider        string  `ddl:"static" sql:"STORAGE_PROVIDER = 'S3'"`
+	StorageAwsRoleArn      string  `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_ROLE_ARN"`
+	StorageAwsObjectAcl    *string `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_OBJECT_ACL"`
+	UsePrivatelinkEndpoint *bool   `ddl:"parameter" sql:"USE_PRIVATELINK_ENDPOINT"`
 }
 
 type GCSStorageParams struct {
@@ -47,8 +48,9 @@ type GCSStorageParams struct {
 }
 
 type AzureStorageParams struct {
-	storageProvider string  `ddl:"static" sql:"STORAGE_PROVIDER = 'AZURE'"`
-	AzureTenantId   *string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+	storageProvider        string  `ddl:"static" sql:"STORAGE_PROVIDER = 'AZURE'"`
+	AzureTenantId          *string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+	UsePrivatelinkEndpoint *bool   `ddl:"parameter" sql:"USE_PRIVATELINK_ENDPOINT"`
 }
 
 // AlterStorageIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-storage-integration.
@@ -73,12 +75,14 @@ type StorageIntegrationSet struct {
 }
 
 type SetS3StorageParams struct {
-	StorageAwsRoleArn   string  `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_ROLE_ARN"`
-	StorageAwsObjectAcl *string `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_OBJECT_ACL"`
+	StorageAwsRoleArn      string  `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_ROLE_ARN"`
+	StorageAwsObjectAcl    *string `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_OBJECT_ACL"`
+	UsePrivatelinkEndpoint *bool   `ddl:"parameter" sql:"USE_PRIVATELINK_ENDPOINT"`
 }
 
 type SetAzureStorageParams struct {
-	AzureTenantId string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+	AzureTenantId          string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+	UsePrivatelinkEndpoint *bool  `ddl:"parameter" sql:"USE_PRIVATELINK_ENDPOINT"`
 }
 
 type StorageIntegrationUnset struct {
@@ -86,6 +90,7 @@ type StorageIntegrationUnset struct {
 	Enabled                 *bool `ddl:"keyword" sql:"ENABLED"`
 	StorageBlockedLocations *bool `ddl:"keyword" sql:"STORAGE_BLOCKED_LOCATIONS"`
 	Comment                 *bool `ddl:"keyword" sql:"COMMENT"`
+	UsePrivatelinkEndpoint  *bool `ddl:"keyword" sql:"USE_PRIVATELINK_ENDPOINT"`
 }
 
 // DropStorageIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-integration.
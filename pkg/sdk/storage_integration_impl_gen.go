@@ -71,8 +71,9 @@ func (r *CreateStorageIntegrationRequest) toOpts() *CreateStorageIntegrationOpti
 	}
 	if r.S3StorageProviderParams != nil {
 		opts.S3StorageProviderParams = &S3StorageParams{
-			StorageAwsRoleArn:   r.S3StorageProviderParams.StorageAwsRoleArn,
-			StorageAwsObjectAcl: r.S3StorageProviderParams.StorageAwsObjectAcl,
+			StorageAwsRoleArn:      r.S3StorageProviderParams.StorageAwsRoleArn,
+			StorageAwsObjectAcl:    r.S3StorageProviderParams.StorageAwsObjectAcl,
+			UsePrivatelinkEndpoint: r.S3StorageProviderParams.UsePrivatelinkEndpoint,
 		}
 	}
 	if r.GCSStorageProviderParams != nil {
@@ -80,7 +81,8 @@ func (r *CreateStorageIntegrationRequest) toOpts() *CreateStorageIntegrationOpti
 	}
 	if r.AzureStorageProviderParams != nil {
 		opts.AzureStorageProviderParams = &AzureStorageParams{
-			AzureTenantId: r.AzureStorageProviderParams.AzureTenantId,
+			AzureTenantId:          r.AzureStorageProviderParams.AzureTenantId,
+			UsePrivatelinkEndpoint: r.AzureStorageProviderParams.UsePrivatelinkEndpoint,
 		}
 	}
 	return opts
@@ -103,13 +105,15 @@ func (r *AlterStorageIntegrationRequest) toOpts() *AlterStorageIntegrationOption
 		}
 		if r.Set.S3Params != nil {
 			opts.Set.S3Params = &SetS3StorageParams{
-				StorageAwsRoleArn:   r.Set.S3Params.StorageAwsRoleArn,
-				StorageAwsObjectAcl: r.Set.S3Params.StorageAwsObjectAcl,
+				StorageAwsRoleArn:      r.Set.S3Params.StorageAwsRoleArn,
+				StorageAwsObjectAcl:    r.Set.S3Params.StorageAwsObjectAcl,
+				UsePrivatelinkEndpoint: r.Set.S3Params.UsePrivatelinkEndpoint,
 			}
 		}
 		if r.Set.AzureParams != nil {
 			opts.Set.AzureParams = &SetAzureStorageParams{
-				AzureTenantId: r.Set.AzureParams.AzureTenantId,
+				AzureTenantId:          r.Set.AzureParams.AzureTenantId,
+				UsePrivatelinkEndpoint: r.Set.AzureParams.UsePrivatelinkEndpoint,
 			}
 		}
 	}
@@ -119,6 +123,7 @@ func (r *AlterStorageIntegrationRequest) toOpts() *AlterStorageIntegrationOption
 			Enabled:                 r.Unset.Enabled,
 			StorageBlockedLocations: r.Unset.StorageBlockedLocations,
 			Comment:                 r.Unset.Comment,
+			UsePrivatelinkEndpoint:  r.Unset.UsePrivatelinkEndpoint,
 		}
 	}
 	return opts
@@ -2,232 +2,491 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
 	"strings"
 )
 
-func Sweep(client *Client, prefix string) error {
-	sweepers := []func() error{
-		getAccountPolicyAttachementsSweeper(client),
-		getResourceMonitorSweeper(client, prefix),
-		getFailoverGroupSweeper(client, prefix),
-		getShareSweeper(client, prefix),
-		getDatabaseSweeper(client, prefix),
-		getWarehouseSweeper(client, prefix),
-		getRoleSweeper(client, prefix),
-		// todo: users, integrations, replication groups, network policies
-		// getUserSweeper(client, prefix),
-	}
-	for _, sweeper := range sweepers {
-		if err := sweeper(); err != nil {
-			return err
-		}
-	}
-	return nil
+// SweptObject is an object found by one of the sweepers. Sweep drops it, SweepDryRun only reports it.
+type SweptObject struct {
+	Sweeper    string
+	ObjectType ObjectType
+	Name       string
+	drop       func(ctx context.Context) error
 }
 
-func SweepAll(client *Client) error {
-	return Sweep(client, "")
+// sweeper lists the objects of one kind that should be dropped. The sweepers listed in after are run before this one,
+// e.g. the applications are dropped before the application packages they were installed from. The sweepers with
+// prefixRequired are run only with a non-empty prefix, because their objects are not owned by the tests alone (e.g. the
+// users and integrations set up for the test accounts).
+type sweeper struct {
+	name           string
+	after          []string
+	prefixRequired bool
+	list           func(ctx context.Context, client *Client, prefix string) ([]SweptObject, error)
 }
 
-func getResourceMonitorSweeper(client *Client, prefix string) func() error {
-	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all resource monitors")
-		} else {
-			log.Printf("[DEBUG] Sweeping all resource monitors with prefix %s", prefix)
+var sweepers []sweeper
+
+func registerSweeper(s sweeper) {
+	sweepers = append(sweepers, s)
+}
+
+func init() {
+	registerSweeper(sweeper{name: "account policy attachments", list: listAccountPolicyAttachments})
+	registerSweeper(sweeper{name: "managed accounts", list: listManagedAccounts})
+	registerSweeper(sweeper{name: "resource monitors", list: listResourceMonitors})
+	registerSweeper(sweeper{name: "failover groups", list: listFailoverGroups})
+	registerSweeper(sweeper{name: "shares", after: []string{"failover groups"}, list: listShares})
+	registerSweeper(sweeper{name: "applications", list: listApplications})
+	registerSweeper(sweeper{name: "application packages", after: []string{"applications"}, list: listApplicationPackages})
+	registerSweeper(sweeper{name: "tags", list: listTags})
+	registerSweeper(sweeper{name: "databases", after: []string{"failover groups", "shares", "applications", "application packages", "tags"}, list: listDatabases})
+	registerSweeper(sweeper{name: "warehouses", after: []string{"resource monitors"}, list: listWarehouses})
+	registerSweeper(sweeper{name: "api integrations", prefixRequired: true, list: listApiIntegrations})
+	registerSweeper(sweeper{name: "notification integrations", prefixRequired: true, list: listNotificationIntegrations})
+	registerSweeper(sweeper{name: "storage integrations", prefixRequired: true, list: listStorageIntegrations})
+	registerSweeper(sweeper{name: "network policies", prefixRequired: true, list: listNetworkPolicies})
+	registerSweeper(sweeper{name: "users", prefixRequired: true, list: listUsers})
+	registerSweeper(sweeper{name: "roles", after: []string{"users", "databases", "warehouses"}, list: listRoles})
+}
+
+// orderSweepers sorts the sweepers topologically by their dependencies. Sweepers without dependencies between them
+// keep the registration order.
+func orderSweepers(registered []sweeper) ([]sweeper, error) {
+	byName := make(map[string]sweeper, len(registered))
+	for _, s := range registered {
+		if _, ok := byName[s.name]; ok {
+			return nil, fmt.Errorf("sweeper %s is registered more than once", s.name)
 		}
-		ctx := context.Background()
-		rms, err := client.ResourceMonitors.Show(ctx, nil)
-		if err != nil {
-			return err
+		byName[s.name] = s
+	}
+	for _, s := range registered {
+		for _, dependency := range s.after {
+			if _, ok := byName[dependency]; !ok {
+				return nil, fmt.Errorf("sweeper %s depends on unknown sweeper %s", s.name, dependency)
+			}
+		}
+	}
+
+	ordered := make([]sweeper, 0, len(registered))
+	done := make(map[string]bool, len(registered))
+	for len(ordered) < len(registered) {
+		progressed := false
+		for _, s := range registered {
+			if done[s.name] || slices.ContainsFunc(s.after, func(dependency string) bool { return !done[dependency] }) {
+				continue
+			}
+			ordered = append(ordered, s)
+			done[s.name] = true
+			progressed = true
+			break
 		}
-		for _, rm := range rms {
-			if prefix == "" || strings.HasPrefix(rm.Name, prefix) {
-				log.Printf("[DEBUG] Dropping resource monitor %s", rm.Name)
-				if err := client.ResourceMonitors.Drop(ctx, rm.ID()); err != nil {
-					return err
+		if !progressed {
+			var remaining []string
+			for _, s := range registered {
+				if !done[s.name] {
+					remaining = append(remaining, s.name)
 				}
-			} else {
-				log.Printf("[DEBUG] Skipping resource monitor %s", rm.Name)
 			}
+			return nil, fmt.Errorf("sweepers have cyclic dependencies: %s", strings.Join(remaining, ", "))
 		}
-		return nil
 	}
+	return ordered, nil
 }
 
-func getFailoverGroupSweeper(client *Client, prefix string) func() error {
-	return func() error {
+// sweepersFor returns the sweepers to run for the given prefix in the order they should be run.
+func sweepersFor(prefix string) ([]sweeper, error) {
+	ordered, err := orderSweepers(sweepers)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]sweeper, 0, len(ordered))
+	for _, s := range ordered {
+		if s.prefixRequired && prefix == "" {
+			log.Printf("[DEBUG] Skipping %s, they are swept only with a prefix", s.name)
+			continue
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+// Sweep drops all objects with names starting with the prefix (all objects when the prefix is empty), except the
+// system objects and the objects shared by the tests (e.g. terraform_test_database). Users, integrations and network
+// policies are swept only with a prefix.
+func Sweep(client *Client, prefix string) error {
+	ordered, err := sweepersFor(prefix)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	for _, s := range ordered {
 		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all failover groups")
+			log.Printf("[DEBUG] Sweeping all %s", s.name)
 		} else {
-			log.Printf("[DEBUG] Sweeping all failover groups with prefix %s", prefix)
+			log.Printf("[DEBUG] Sweeping all %s with prefix %s", s.name, prefix)
 		}
-		ctx := context.Background()
-		currentAccount, err := client.ContextFunctions.CurrentAccount(ctx)
+		// the objects are listed right before dropping them, because the previous sweepers could already drop them
+		// (e.g. the tags are dropped with their databases)
+		objects, err := s.list(ctx, client, prefix)
 		if err != nil {
 			return err
 		}
-		opts := &ShowFailoverGroupOptions{
-			InAccount: NewAccountIdentifierFromAccountLocator(currentAccount),
+		for _, object := range objects {
+			log.Printf("[DEBUG] Dropping %s %s", object.ObjectType, object.Name)
+			if err := object.drop(ctx); err != nil {
+				return fmt.Errorf("dropping %s %s: %w", object.ObjectType, object.Name, err)
+			}
 		}
-		fgs, err := client.FailoverGroups.Show(ctx, opts)
+	}
+	return nil
+}
+
+// SweepDryRun returns the objects Sweep would drop, in the order they would be dropped, without dropping them.
+func SweepDryRun(client *Client, prefix string) ([]SweptObject, error) {
+	ordered, err := sweepersFor(prefix)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	result := make([]SweptObject, 0)
+	for _, s := range ordered {
+		objects, err := s.list(ctx, client, prefix)
 		if err != nil {
-			return err
-		}
-		for _, fg := range fgs {
-			if (prefix == "" || strings.HasPrefix(fg.Name, prefix)) && fg.AccountLocator == currentAccount {
-				log.Printf("[DEBUG] Dropping failover group %s", fg.Name)
-				if err := client.FailoverGroups.Drop(ctx, fg.ID(), nil); err != nil {
-					return err
-				}
-			} else {
-				log.Printf("[DEBUG] Skipping failover group %s", fg.Name)
-			}
+			return nil, err
 		}
-		return nil
+		result = append(result, objects...)
 	}
+	return result, nil
 }
 
-func getUserSweeper(client *Client, prefix string) func() error {
-	return func() error {
-		/*ctx := context.Background()
-		users, client.Users.Show(ctx,nil) // LIKE '%TEST_%'
-		for _, user := range users {
-			err := client.Users.Drop(ctx, user.ID(), nil)
-			if err != nil {
-				return err
-			}
-		}*/
-		return nil
+func SweepAll(client *Client) error {
+	return Sweep(client, "")
+}
+
+func hasSweepPrefix(name string, prefix string) bool {
+	return prefix == "" || strings.HasPrefix(name, prefix)
+}
+
+func sweptObject(sweeperName string, objectType ObjectType, name string, drop func(ctx context.Context) error) SweptObject {
+	return SweptObject{Sweeper: sweeperName, ObjectType: objectType, Name: name, drop: drop}
+}
+
+// listAccountPolicyAttachments unsets the password and session policies set on the account level, so the policies
+// (and the databases containing them) can be dropped. The errors are ignored, because the policies may not be set.
+func listAccountPolicyAttachments(_ context.Context, client *Client, _ string) ([]SweptObject, error) {
+	unset := func(accountUnset *AccountUnset) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			_ = client.Accounts.Alter(ctx, &AlterAccountOptions{Unset: accountUnset})
+			return nil
+		}
 	}
+	return []SweptObject{
+		sweptObject("account policy attachments", ObjectTypePasswordPolicy, "ACCOUNT", unset(&AccountUnset{PasswordPolicy: Bool(true)})),
+		sweptObject("account policy attachments", ObjectTypeSessionPolicy, "ACCOUNT", unset(&AccountUnset{SessionPolicy: Bool(true)})),
+	}, nil
 }
 
-func getRoleSweeper(client *Client, prefix string) func() error {
-	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all roles")
-		} else {
-			log.Printf("[DEBUG] Sweeping all roles with prefix %s", prefix)
+func listManagedAccounts(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	managedAccounts, err := client.ManagedAccounts.Show(ctx, NewShowManagedAccountRequest())
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, managedAccount := range managedAccounts {
+		if !hasSweepPrefix(managedAccount.Name, prefix) {
+			continue
 		}
-		ctx := context.Background()
-		roles, err := client.Roles.Show(ctx, NewShowRoleRequest())
-		if err != nil {
-			return err
+		id := NewAccountObjectIdentifier(managedAccount.Name)
+		result = append(result, sweptObject("managed accounts", ObjectTypeManagedAccount, managedAccount.Name, func(ctx context.Context) error {
+			return client.ManagedAccounts.Drop(ctx, NewDropManagedAccountRequest(id))
+		}))
+	}
+	return result, nil
+}
+
+func listResourceMonitors(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	rms, err := client.ResourceMonitors.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, rm := range rms {
+		if !hasSweepPrefix(rm.Name, prefix) {
+			continue
 		}
-		for _, role := range roles {
-			if (prefix == "" || strings.HasPrefix(role.Name, prefix)) && !slices.Contains([]string{"ACCOUNTADMIN", "SECURITYADMIN", "SYSADMIN", "ORGADMIN", "USERADMIN", "PUBLIC"}, role.Name) {
-				log.Printf("[DEBUG] Dropping role %s", role.Name)
-				if err := client.Roles.Drop(ctx, NewDropRoleRequest(role.ID())); err != nil {
-					return err
-				}
-			} else {
-				log.Printf("[DEBUG] Skipping role %s", role.Name)
-			}
+		id := rm.ID()
+		result = append(result, sweptObject("resource monitors", ObjectTypeResourceMonitor, rm.Name, func(ctx context.Context) error {
+			return client.ResourceMonitors.Drop(ctx, id)
+		}))
+	}
+	return result, nil
+}
+
+// listFailoverGroups lists only the failover groups created in the current account (not the secondary ones).
+func listFailoverGroups(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	currentAccount, err := client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fgs, err := client.FailoverGroups.Show(ctx, &ShowFailoverGroupOptions{
+		InAccount: NewAccountIdentifierFromAccountLocator(currentAccount),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, fg := range fgs {
+		if !hasSweepPrefix(fg.Name, prefix) || fg.AccountLocator != currentAccount {
+			continue
 		}
-		return nil
+		id := fg.ID()
+		result = append(result, sweptObject("failover groups", ObjectTypeFailoverGroup, fg.Name, func(ctx context.Context) error {
+			return client.FailoverGroups.Drop(ctx, id, nil)
+		}))
 	}
+	return result, nil
 }
 
-func getShareSweeper(client *Client, prefix string) func() error {
-	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all shares")
-		} else {
-			log.Printf("[DEBUG] Sweeping all shares with prefix %s", prefix)
+// listShares lists only the outbound shares, the inbound ones cannot be dropped by the consumer.
+func listShares(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	shares, err := client.Shares.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, share := range shares {
+		if share.Kind != ShareKindOutbound || !hasSweepPrefix(share.Name.Name(), prefix) {
+			continue
 		}
-		ctx := context.Background()
-		shares, err := client.Shares.Show(ctx, nil)
-		if err != nil {
-			return err
+		id := share.ID()
+		result = append(result, sweptObject("shares", ObjectTypeShare, id.Name(), func(ctx context.Context) error {
+			return client.Shares.Drop(ctx, id)
+		}))
+	}
+	return result, nil
+}
+
+func listApplications(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	applications, err := client.Applications.Show(ctx, NewShowApplicationRequest())
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, application := range applications {
+		if !hasSweepPrefix(application.Name, prefix) {
+			continue
 		}
-		for _, share := range shares {
-			if (share.Kind == ShareKindOutbound) && (prefix == "" || strings.HasPrefix(share.Name.Name(), prefix)) {
-				log.Printf("[DEBUG] Dropping share %s", share.ID().Name())
-				if err := client.Shares.Drop(ctx, share.ID()); err != nil {
-					return err
-				}
-			} else {
-				log.Printf("[DEBUG] Skipping share %s", share.Name.Name())
-			}
+		id := NewAccountObjectIdentifier(application.Name)
+		result = append(result, sweptObject("applications", ObjectTypeApplication, application.Name, func(ctx context.Context) error {
+			return client.Applications.Drop(ctx, NewDropApplicationRequest(id))
+		}))
+	}
+	return result, nil
+}
+
+func listApplicationPackages(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	applicationPackages, err := client.ApplicationPackages.Show(ctx, NewShowApplicationPackageRequest())
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, applicationPackage := range applicationPackages {
+		if !hasSweepPrefix(applicationPackage.Name, prefix) {
+			continue
 		}
-		return nil
+		id := NewAccountObjectIdentifier(applicationPackage.Name)
+		result = append(result, sweptObject("application packages", ObjectTypeApplicationPackage, applicationPackage.Name, func(ctx context.Context) error {
+			return client.ApplicationPackages.Drop(ctx, NewDropApplicationPackageRequest(id))
+		}))
 	}
+	return result, nil
 }
 
-func getDatabaseSweeper(client *Client, prefix string) func() error {
-	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all databases")
-		} else {
-			log.Printf("[DEBUG] Sweeping all databases with prefix %s", prefix)
+// listTags lists the tags matching the prefix in the databases that are not swept themselves, the tags in the swept
+// databases are dropped together with them.
+func listTags(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	tags, err := client.Tags.Show(ctx, NewShowTagRequest().WithIn(&In{Account: Bool(true)}))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, tag := range tags {
+		if !hasSweepPrefix(tag.Name, prefix) || tag.DatabaseName == "SNOWFLAKE" || isSweptDatabase(tag.DatabaseName, prefix) {
+			continue
 		}
-		ctx := context.Background()
-		dbs, err := client.Databases.Show(ctx, nil)
-		if err != nil {
-			return err
+		id := tag.ID()
+		result = append(result, sweptObject("tags", ObjectTypeTag, id.FullyQualifiedName(), func(ctx context.Context) error {
+			return client.Tags.Drop(ctx, NewDropTagRequest(id))
+		}))
+	}
+	return result, nil
+}
+
+func isSweptDatabase(name string, prefix string) bool {
+	return hasSweepPrefix(name, prefix) && name != "SNOWFLAKE" && name != "terraform_test_database"
+}
+
+func listDatabases(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	dbs, err := client.Databases.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, db := range dbs {
+		if !isSweptDatabase(db.Name, prefix) {
+			continue
 		}
-		for _, db := range dbs {
-			if (prefix == "" || strings.HasPrefix(db.Name, prefix)) && db.Name != "SNOWFLAKE" && db.Name != "terraform_test_database" {
-				log.Printf("[DEBUG] Dropping database %s", db.Name)
-				if err := client.Databases.Drop(ctx, db.ID(), nil); err != nil {
-					if strings.Contains(err.Error(), "Object found is of type 'APPLICATION', not specified type 'DATABASE'") {
-						log.Printf("[DEBUG] Skipping database %s", db.Name)
-					} else {
-						return err
-					}
+		id := db.ID()
+		result = append(result, sweptObject("databases", ObjectTypeDatabase, db.Name, func(ctx context.Context) error {
+			if err := client.Databases.Drop(ctx, id, nil); err != nil {
+				// applications are listed as databases too, the ones not matching the prefix are left untouched
+				if strings.Contains(err.Error(), "Object found is of type 'APPLICATION', not specified type 'DATABASE'") {
+					log.Printf("[DEBUG] Skipping database %s", id.Name())
+					return nil
 				}
-			} else {
-				log.Printf("[DEBUG] Skipping database %s", db.Name)
+				return err
 			}
+			return nil
+		}))
+	}
+	return result, nil
+}
+
+func listWarehouses(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	whs, err := client.Warehouses.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, wh := range whs {
+		if !hasSweepPrefix(wh.Name, prefix) || wh.Name == "SNOWFLAKE" || wh.Name == "terraform_test_warehouse" {
+			continue
 		}
-		return nil
+		id := wh.ID()
+		result = append(result, sweptObject("warehouses", ObjectTypeWarehouse, wh.Name, func(ctx context.Context) error {
+			return client.Warehouses.Drop(ctx, id, nil)
+		}))
 	}
+	return result, nil
 }
 
-func getWarehouseSweeper(client *Client, prefix string) func() error {
-	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all warehouses")
-		} else {
-			log.Printf("[DEBUG] Sweeping all warehouses with prefix %s", prefix)
+func listApiIntegrations(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	integrations, err := client.ApiIntegrations.Show(ctx, NewShowApiIntegrationRequest())
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, integration := range integrations {
+		if !hasSweepPrefix(integration.Name, prefix) {
+			continue
 		}
-		ctx := context.Background()
-		whs, err := client.Warehouses.Show(ctx, nil)
-		if err != nil {
-			return err
+		id := integration.ID()
+		result = append(result, sweptObject("api integrations", ObjectTypeIntegration, integration.Name, func(ctx context.Context) error {
+			return client.ApiIntegrations.Drop(ctx, NewDropApiIntegrationRequest(id))
+		}))
+	}
+	return result, nil
+}
+
+func listNotificationIntegrations(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	integrations, err := client.NotificationIntegrations.Show(ctx, NewShowNotificationIntegrationRequest())
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, integration := range integrations {
+		if !hasSweepPrefix(integration.Name, prefix) {
+			continue
 		}
-		for _, wh := range whs {
-			if (prefix == "" || strings.HasPrefix(wh.Name, prefix)) && wh.Name != "SNOWFLAKE" && wh.Name != "terraform_test_warehouse" {
-				log.Printf("[DEBUG] Dropping warehouse %s", wh.Name)
-				if err := client.Warehouses.Drop(ctx, wh.ID(), nil); err != nil {
-					return err
-				}
-			} else {
-				log.Printf("[DEBUG] Skipping warehouse %s", wh.Name)
-			}
+		id := integration.ID()
+		result = append(result, sweptObject("notification integrations", ObjectTypeIntegration, integration.Name, func(ctx context.Context) error {
+			return client.NotificationIntegrations.Drop(ctx, NewDropNotificationIntegrationRequest(id))
+		}))
+	}
+	return result, nil
+}
+
+func listStorageIntegrations(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	integrations, err := client.StorageIntegrations.Show(ctx, NewShowStorageIntegrationRequest())
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, integration := range integrations {
+		if !hasSweepPrefix(integration.Name, prefix) {
+			continue
 		}
-		return nil
+		id := NewAccountObjectIdentifier(integration.Name)
+		result = append(result, sweptObject("storage integrations", ObjectTypeIntegration, integration.Name, func(ctx context.Context) error {
+			return client.StorageIntegrations.Drop(ctx, NewDropStorageIntegrationRequest(id))
+		}))
 	}
+	return result, nil
 }
 
-func getAccountPolicyAttachementsSweeper(client *Client) func() error {
-	return func() error {
-		log.Printf("[DEBUG] Unsetting password and session policies set on the account level")
-		ctx := context.Background()
-		opts := &AlterAccountOptions{
-			Unset: &AccountUnset{
-				PasswordPolicy: Bool(true),
-			},
+// listNetworkPolicies skips the network policy set on the account, dropping it would fail.
+func listNetworkPolicies(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	accountNetworkPolicy := ""
+	if parameter, err := client.Parameters.ShowAccountParameter(ctx, AccountParameterNetworkPolicy); err == nil {
+		accountNetworkPolicy = parameter.Value
+	}
+	networkPolicies, err := client.NetworkPolicies.Show(ctx, NewShowNetworkPolicyRequest())
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, networkPolicy := range networkPolicies {
+		if !hasSweepPrefix(networkPolicy.Name, prefix) || strings.EqualFold(networkPolicy.Name, accountNetworkPolicy) {
+			continue
 		}
-		_ = client.Accounts.Alter(ctx, opts)
-		opts = &AlterAccountOptions{
-			Unset: &AccountUnset{
-				SessionPolicy: Bool(true),
-			},
+		id := NewAccountObjectIdentifier(networkPolicy.Name)
+		result = append(result, sweptObject("network policies", ObjectTypeNetworkPolicy, networkPolicy.Name, func(ctx context.Context) error {
+			return client.NetworkPolicies.Drop(ctx, NewDropNetworkPolicyRequest(id))
+		}))
+	}
+	return result, nil
+}
+
+// listUsers skips the current user (used by the sweeper itself) and the system user.
+func listUsers(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	currentUser, err := client.ContextFunctions.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := client.Users.Show(ctx, &ShowUserOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, user := range users {
+		if !hasSweepPrefix(user.Name, prefix) || user.Name == "SNOWFLAKE" || strings.EqualFold(user.Name, currentUser) {
+			continue
+		}
+		id := user.ID()
+		result = append(result, sweptObject("users", ObjectTypeUser, user.Name, func(ctx context.Context) error {
+			return client.Users.Drop(ctx, id)
+		}))
+	}
+	return result, nil
+}
+
+func listRoles(ctx context.Context, client *Client, prefix string) ([]SweptObject, error) {
+	roles, err := client.Roles.Show(ctx, NewShowRoleRequest())
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SweptObject, 0)
+	for _, role := range roles {
+		if !hasSweepPrefix(role.Name, prefix) || slices.Contains([]string{"ACCOUNTADMIN", "SECURITYADMIN", "SYSADMIN", "ORGADMIN", "USERADMIN", "PUBLIC"}, role.Name) {
+			continue
 		}
-		_ = client.Accounts.Alter(ctx, opts)
-		return nil
+		id := role.ID()
+		result = append(result, sweptObject("roles", ObjectTypeRole, role.Name, func(ctx context.Context) error {
+			return client.Roles.Drop(ctx, NewDropRoleRequest(id))
+		}))
 	}
+	return result, nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +33,92 @@ func TestSweep(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestSweepDryRun(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableSweep)
+
+	t.Run("lists objects without dropping them", func(t *testing.T) {
+		client := testClient(t)
+		objects, err := SweepDryRun(client, "TEST_")
+		require.NoError(t, err)
+
+		again, err := SweepDryRun(client, "TEST_")
+		require.NoError(t, err)
+		assert.Len(t, again, len(objects))
+	})
+}
+
+func TestOrderSweepers(t *testing.T) {
+	names := func(ordered []sweeper) []string {
+		result := make([]string, len(ordered))
+		for i, s := range ordered {
+			result[i] = s.name
+		}
+		return result
+	}
+
+	t.Run("keeps registration order without dependencies", func(t *testing.T) {
+		ordered, err := orderSweepers([]sweeper{{name: "a"}, {name: "b"}, {name: "c"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, names(ordered))
+	})
+
+	t.Run("runs dependencies first", func(t *testing.T) {
+		ordered, err := orderSweepers([]sweeper{
+			{name: "databases", after: []string{"applications", "shares"}},
+			{name: "application packages", after: []string{"applications"}},
+			{name: "applications"},
+			{name: "shares"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"applications", "application packages", "shares", "databases"}, names(ordered))
+	})
+
+	t.Run("cyclic dependencies", func(t *testing.T) {
+		_, err := orderSweepers([]sweeper{
+			{name: "a", after: []string{"c"}},
+			{name: "b"},
+			{name: "c", after: []string{"a"}},
+		})
+		require.ErrorContains(t, err, "sweepers have cyclic dependencies: a, c")
+	})
+
+	t.Run("unknown dependency", func(t *testing.T) {
+		_, err := orderSweepers([]sweeper{{name: "a", after: []string{"b"}}})
+		require.ErrorContains(t, err, "sweeper a depends on unknown sweeper b")
+	})
+
+	t.Run("duplicated sweeper", func(t *testing.T) {
+		_, err := orderSweepers([]sweeper{{name: "a"}, {name: "a"}})
+		require.ErrorContains(t, err, "sweeper a is registered more than once")
+	})
+
+	t.Run("registered sweepers", func(t *testing.T) {
+		ordered, err := orderSweepers(sweepers)
+		require.NoError(t, err)
+		require.Len(t, ordered, len(sweepers))
+
+		position := make(map[string]int)
+		for i, s := range ordered {
+			position[s.name] = i
+		}
+		for _, s := range ordered {
+			for _, dependency := range s.after {
+				assert.Less(t, position[dependency], position[s.name], "%s should be swept before %s", dependency, s.name)
+			}
+		}
+	})
+
+	t.Run("sweepers requiring a prefix", func(t *testing.T) {
+		all, err := sweepersFor("")
+		require.NoError(t, err)
+		prefixed, err := sweepersFor("TEST_")
+		require.NoError(t, err)
+		require.Len(t, prefixed, len(sweepers))
+
+		for _, s := range all {
+			assert.False(t, s.prefixRequired, "%s should not be run without a prefix", s.name)
+		}
+		assert.Less(t, len(all), len(prefixed))
+	})
+}
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -10,8 +11,11 @@ import (
 )
 
 type SystemFunctions interface {
+	// GetTag returns the value of the tag set on the object (directly or inherited); it is empty when the tag is not set.
 	GetTag(ctx context.Context, tagID ObjectIdentifier, objectID ObjectIdentifier, objectType ObjectType) (string, error)
 	PipeStatus(pipeId SchemaObjectIdentifier) (PipeExecutionState, error)
+	// PipeStatusDetails returns the whole output of SYSTEM$PIPE_STATUS, not only the execution state.
+	PipeStatusDetails(pipeId SchemaObjectIdentifier) (*PipeStatusDetails, error)
 	// PipeForceResume unpauses a pipe after ownership transfer. Snowflake will throw an error whenever a pipe changes its owner,
 	// and someone tries to unpause it. To unpause a pipe after ownership transfer, this system function has to be called instead of ALTER PIPE.
 	PipeForceResume(pipeId SchemaObjectIdentifier, options []ForceResumePipeOption) error
@@ -25,14 +29,14 @@ type systemFunctions struct {
 
 func (c *systemFunctions) GetTag(ctx context.Context, tagID ObjectIdentifier, objectID ObjectIdentifier, objectType ObjectType) (string, error) {
 	s := &struct {
-		Tag string `db:"TAG"`
+		Tag sql.NullString `db:"TAG"`
 	}{}
-	sql := fmt.Sprintf(`SELECT SYSTEM$GET_TAG('%s', '%s', '%v') AS "TAG"`, tagID.FullyQualifiedName(), objectID.FullyQualifiedName(), objectType)
-	err := c.client.queryOne(ctx, s, sql)
+	query := fmt.Sprintf(`SELECT SYSTEM$GET_TAG('%s', '%s', '%v') AS "TAG"`, tagID.FullyQualifiedName(), objectID.FullyQualifiedName(), objectType)
+	err := c.client.queryOne(ctx, s, query)
 	if err != nil {
 		return "", err
 	}
-	return s.Tag, nil
+	return s.Tag.String, nil
 }
 
 type PipeExecutionState string
@@ -58,7 +62,31 @@ const (
 	StalledStagePermissionErrorPipeExecutionState           PipeExecutionState = "STALLED_STAGE_PERMISSION_ERROR"
 )
 
+// PipeStatusDetails is based on https://docs.snowflake.com/en/sql-reference/functions/system_pipe_status#returns.
+type PipeStatusDetails struct {
+	ExecutionState                  PipeExecutionState `json:"executionState"`
+	PendingFileCount                int                `json:"pendingFileCount"`
+	LastIngestedTimestamp           string             `json:"lastIngestedTimestamp"`
+	LastIngestedFilePath            string             `json:"lastIngestedFilePath"`
+	NotificationChannelName         string             `json:"notificationChannelName"`
+	NumOutstandingMessagesOnChannel int                `json:"numOutstandingMessagesOnChannel"`
+	LastReceivedMessageTimestamp    string             `json:"lastReceivedMessageTimestamp"`
+	LastForwardedMessageTimestamp   string             `json:"lastForwardedMessageTimestamp"`
+	LastPulledFromChannelTimestamp  string             `json:"lastPulledFromChannelTimestamp"`
+	LastForwardedFilePath           string             `json:"lastForwardedFilePath"`
+	Error                           string             `json:"error"`
+	Fault                           string             `json:"fault"`
+}
+
 func (c *systemFunctions) PipeStatus(pipeId SchemaObjectIdentifier) (PipeExecutionState, error) {
+	details, err := c.PipeStatusDetails(pipeId)
+	if err != nil {
+		return "", err
+	}
+	return details.ExecutionState, nil
+}
+
+func (c *systemFunctions) PipeStatusDetails(pipeId SchemaObjectIdentifier) (*PipeStatusDetails, error) {
 	row := &struct {
 		PipeStatus string `db:"PIPE_STATUS"`
 	}{}
@@ -67,20 +95,27 @@ func (c *systemFunctions) PipeStatus(pipeId SchemaObjectIdentifier) (PipeExecuti
 
 	err := c.client.queryOne(ctx, row, sql)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var pipeStatus map[string]any
-	err = json.Unmarshal([]byte(row.PipeStatus), &pipeStatus)
-	if err != nil {
-		return "", err
+	return parsePipeStatus(row.PipeStatus)
+}
+
+func parsePipeStatus(pipeStatus string) (*PipeStatusDetails, error) {
+	var raw map[string]any
+	if err := json.Unmarshal([]byte(pipeStatus), &raw); err != nil {
+		return nil, err
 	}
 
-	if _, ok := pipeStatus["executionState"]; !ok {
-		return "", NewError(fmt.Sprintf("executionState key not found in: %s", pipeStatus))
+	if _, ok := raw["executionState"]; !ok {
+		return nil, NewError(fmt.Sprintf("executionState key not found in: %s", raw))
 	}
 
-	return PipeExecutionState(pipeStatus["executionState"].(string)), nil
+	details := &PipeStatusDetails{}
+	if err := json.Unmarshal([]byte(pipeStatus), details); err != nil {
+		return nil, err
+	}
+	return details, nil
 }
 
 type ForceResumePipeOption string
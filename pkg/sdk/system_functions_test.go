@@ -0,0 +1,38 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemFunctions_parsePipeStatus(t *testing.T) {
+	t.Run("running pipe", func(t *testing.T) {
+		details, err := parsePipeStatus(`{"executionState":"RUNNING","pendingFileCount":3,"lastIngestedTimestamp":"2024-01-01T10:00:00.000Z","lastIngestedFilePath":"file.csv","lastReceivedMessageTimestamp":"2024-01-01T09:59:59.000Z"}`)
+		require.NoError(t, err)
+		assert.Equal(t, RunningPipeExecutionState, details.ExecutionState)
+		assert.Equal(t, 3, details.PendingFileCount)
+		assert.Equal(t, "2024-01-01T10:00:00.000Z", details.LastIngestedTimestamp)
+		assert.Equal(t, "file.csv", details.LastIngestedFilePath)
+		assert.Equal(t, "2024-01-01T09:59:59.000Z", details.LastReceivedMessageTimestamp)
+		assert.Empty(t, details.LastForwardedMessageTimestamp)
+	})
+
+	t.Run("stalled pipe with error", func(t *testing.T) {
+		details, err := parsePipeStatus(`{"executionState":"STALLED_EXECUTION_ERROR","pendingFileCount":0,"error":"table does not exist"}`)
+		require.NoError(t, err)
+		assert.Equal(t, StalledExecutionErrorPipeExecutionState, details.ExecutionState)
+		assert.Equal(t, "table does not exist", details.Error)
+	})
+
+	t.Run("missing execution state", func(t *testing.T) {
+		_, err := parsePipeStatus(`{"pendingFileCount":0}`)
+		require.ErrorContains(t, err, "executionState key not found")
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := parsePipeStatus(`not a json`)
+		require.Error(t, err)
+	})
+}
@@ -530,6 +530,8 @@ type tableDBRow struct {
 	OwnerRoleType              sql.NullString `db:"owner_role_type"`
 	IsEvent                    sql.NullString `db:"is_event"`
 	Budget                     sql.NullString `db:"budget"`
+	IsDynamic                  sql.NullString `db:"is_dynamic"`
+	IsIceberg                  sql.NullString `db:"is_iceberg"`
 }
 
 type Table struct {
@@ -555,6 +557,8 @@ type Table struct {
 	OwnerRoleType              string
 	IsEvent                    bool
 	Budget                     *string
+	IsDynamic                  bool
+	IsIceberg                  bool
 }
 
 // GetClusterByKeys converts the SHOW TABLES result for ClusterBy and converts it to list of keys.
@@ -635,6 +639,12 @@ func (row tableDBRow) convert() *Table {
 	if row.IsEvent.Valid {
 		table.IsEvent = row.IsEvent.String == "Y"
 	}
+	if row.IsDynamic.Valid {
+		table.IsDynamic = row.IsDynamic.String == "Y"
+	}
+	if row.IsIceberg.Valid {
+		table.IsIceberg = row.IsIceberg.String == "Y"
+	}
 	if row.EnableSchemaEvolution.Valid {
 		table.EnableSchemaEvolution = row.EnableSchemaEvolution.String == "Y"
 	}
@@ -0,0 +1,131 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+var _ convertibleRow[TagReference] = new(tagReferenceDBRow)
+
+type TagReferences interface {
+	// GetForEntity returns the tags set on the object (directly or inherited), based on https://docs.snowflake.com/en/sql-reference/functions/tag_references.
+	GetForEntity(ctx context.Context, request *GetForEntityTagReferenceRequest) ([]TagReference, error)
+	// GetForAllColumns returns the tags set on all the columns of the table (directly or inherited), based on https://docs.snowflake.com/en/sql-reference/functions/tag_references_all_columns.
+	GetForAllColumns(ctx context.Context, request *GetForAllColumnsTagReferenceRequest) ([]TagReference, error)
+}
+
+type getForEntityTagReferenceOptions struct {
+	selectEverythingFrom bool                    `ddl:"static" sql:"SELECT * FROM TABLE"`
+	parameters           *tagReferenceParameters `ddl:"list,parentheses,no_comma"`
+}
+
+type tagReferenceParameters struct {
+	functionFullyQualifiedName bool                           `ddl:"static" sql:"SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES"`
+	arguments                  *tagReferenceFunctionArguments `ddl:"list,parentheses"`
+}
+
+type getForAllColumnsTagReferenceOptions struct {
+	selectEverythingFrom bool                              `ddl:"static" sql:"SELECT * FROM TABLE"`
+	parameters           *tagReferenceAllColumnsParameters `ddl:"list,parentheses,no_comma"`
+}
+
+type tagReferenceAllColumnsParameters struct {
+	functionFullyQualifiedName bool                           `ddl:"static" sql:"SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS"`
+	arguments                  *tagReferenceFunctionArguments `ddl:"list,parentheses"`
+}
+
+type tagReferenceFunctionArguments struct {
+	objectName   *string                   `ddl:"keyword,single_quotes"`
+	objectDomain *TagReferenceObjectDomain `ddl:"keyword,single_quotes"`
+}
+
+type TagReferenceObjectDomain string
+
+const (
+	TagReferenceObjectDomainAccount       TagReferenceObjectDomain = "ACCOUNT"
+	TagReferenceObjectDomainColumn        TagReferenceObjectDomain = "COLUMN"
+	TagReferenceObjectDomainDatabase      TagReferenceObjectDomain = "DATABASE"
+	TagReferenceObjectDomainIntegration   TagReferenceObjectDomain = "INTEGRATION"
+	TagReferenceObjectDomainNetworkPolicy TagReferenceObjectDomain = "NETWORK POLICY"
+	TagReferenceObjectDomainRole          TagReferenceObjectDomain = "ROLE"
+	TagReferenceObjectDomainSchema        TagReferenceObjectDomain = "SCHEMA"
+	TagReferenceObjectDomainShare         TagReferenceObjectDomain = "SHARE"
+	TagReferenceObjectDomainStage         TagReferenceObjectDomain = "STAGE"
+	TagReferenceObjectDomainTable         TagReferenceObjectDomain = "TABLE"
+	TagReferenceObjectDomainUser          TagReferenceObjectDomain = "USER"
+	TagReferenceObjectDomainWarehouse     TagReferenceObjectDomain = "WAREHOUSE"
+)
+
+// TagReferenceObjectDomainFromObjectType returns the domain of the object type; all the table-like objects (e.g. views)
+// belong to the TABLE domain.
+func TagReferenceObjectDomainFromObjectType(objectType ObjectType) TagReferenceObjectDomain {
+	switch objectType {
+	case ObjectTypeView, ObjectTypeMaterializedView, ObjectTypeExternalTable, ObjectTypeEventTable, ObjectTypeIcebergTable, ObjectTypeDynamicTable:
+		return TagReferenceObjectDomainTable
+	default:
+		return TagReferenceObjectDomain(objectType.String())
+	}
+}
+
+type TagReference struct {
+	TagDatabase    string
+	TagSchema      string
+	TagName        string
+	TagValue       string
+	Level          string
+	ObjectDatabase *string
+	ObjectSchema   *string
+	ObjectName     string
+	Domain         string
+	ColumnName     *string
+	ApplyMethod    *string
+}
+
+func (v *TagReference) TagId() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.TagDatabase, v.TagSchema, v.TagName)
+}
+
+// IsInherited returns true when the tag is set on a parent of the object (e.g. on the table of a column, or on the
+// schema of a table) instead of on the object itself.
+func (v *TagReference) IsInherited() bool {
+	return v.Level != v.Domain
+}
+
+type tagReferenceDBRow struct {
+	TagDatabase    string         `db:"TAG_DATABASE"`
+	TagSchema      string         `db:"TAG_SCHEMA"`
+	TagName        string         `db:"TAG_NAME"`
+	TagValue       string         `db:"TAG_VALUE"`
+	Level          string         `db:"LEVEL"`
+	ObjectDatabase sql.NullString `db:"OBJECT_DATABASE"`
+	ObjectSchema   sql.NullString `db:"OBJECT_SCHEMA"`
+	ObjectName     string         `db:"OBJECT_NAME"`
+	Domain         string         `db:"DOMAIN"`
+	ColumnName     sql.NullString `db:"COLUMN_NAME"`
+	ApplyMethod    sql.NullString `db:"APPLY_METHOD"`
+}
+
+func (row tagReferenceDBRow) convert() *TagReference {
+	tagReference := TagReference{
+		TagDatabase: row.TagDatabase,
+		TagSchema:   row.TagSchema,
+		TagName:     row.TagName,
+		TagValue:    row.TagValue,
+		Level:       row.Level,
+		ObjectName:  row.ObjectName,
+		Domain:      row.Domain,
+	}
+	if row.ObjectDatabase.Valid {
+		tagReference.ObjectDatabase = &row.ObjectDatabase.String
+	}
+	if row.ObjectSchema.Valid {
+		tagReference.ObjectSchema = &row.ObjectSchema.String
+	}
+	if row.ColumnName.Valid {
+		tagReference.ColumnName = &row.ColumnName.String
+	}
+	if row.ApplyMethod.Valid {
+		tagReference.ApplyMethod = &row.ApplyMethod.String
+	}
+	return &tagReference
+}
@@ -0,0 +1,39 @@
+package sdk
+
+var (
+	_ optionsProvider[getForEntityTagReferenceOptions]     = new(GetForEntityTagReferenceRequest)
+	_ optionsProvider[getForAllColumnsTagReferenceOptions] = new(GetForAllColumnsTagReferenceRequest)
+)
+
+//go:generate go run ./dto-builder-generator/main.go
+
+type GetForEntityTagReferenceRequest struct {
+	ObjectName   ObjectIdentifier         // required
+	ObjectDomain TagReferenceObjectDomain // required
+}
+
+type GetForAllColumnsTagReferenceRequest struct {
+	TableName SchemaObjectIdentifier // required
+}
+
+func (request *GetForEntityTagReferenceRequest) toOpts() *getForEntityTagReferenceOptions {
+	return &getForEntityTagReferenceOptions{
+		parameters: &tagReferenceParameters{
+			arguments: &tagReferenceFunctionArguments{
+				objectName:   String(request.ObjectName.FullyQualifiedName()),
+				objectDomain: Pointer(request.ObjectDomain),
+			},
+		},
+	}
+}
+
+func (request *GetForAllColumnsTagReferenceRequest) toOpts() *getForAllColumnsTagReferenceOptions {
+	return &getForAllColumnsTagReferenceOptions{
+		parameters: &tagReferenceAllColumnsParameters{
+			arguments: &tagReferenceFunctionArguments{
+				objectName:   String(request.TableName.FullyQualifiedName()),
+				objectDomain: Pointer(TagReferenceObjectDomainTable),
+			},
+		},
+	}
+}
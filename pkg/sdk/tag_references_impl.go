@@ -0,0 +1,29 @@
+package sdk
+
+import "context"
+
+var _ TagReferences = new(tagReference)
+
+type tagReference struct {
+	client *Client
+}
+
+func (v *tagReference) GetForEntity(ctx context.Context, request *GetForEntityTagReferenceRequest) ([]TagReference, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[tagReferenceDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[tagReferenceDBRow, TagReference](dbRows)
+	return resultList, nil
+}
+
+func (v *tagReference) GetForAllColumns(ctx context.Context, request *GetForAllColumnsTagReferenceRequest) ([]TagReference, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[tagReferenceDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[tagReferenceDBRow, TagReference](dbRows)
+	return resultList, nil
+}
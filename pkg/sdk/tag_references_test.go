@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagReferencesGetForEntity(t *testing.T) {
+	t.Run("validation: missing parameters", func(t *testing.T) {
+		opts := &getForEntityTagReferenceOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("getForEntityTagReferenceOptions", "parameters"))
+	})
+
+	t.Run("validation: missing arguments", func(t *testing.T) {
+		opts := &getForEntityTagReferenceOptions{
+			parameters: &tagReferenceParameters{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("tagReferenceParameters", "arguments"))
+	})
+
+	t.Run("validation: missing objectName", func(t *testing.T) {
+		opts := &getForEntityTagReferenceOptions{
+			parameters: &tagReferenceParameters{
+				arguments: &tagReferenceFunctionArguments{
+					objectDomain: Pointer(TagReferenceObjectDomainTable),
+				},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("tagReferenceFunctionArguments", "objectName"))
+	})
+
+	t.Run("validation: missing objectDomain", func(t *testing.T) {
+		opts := &getForEntityTagReferenceOptions{
+			parameters: &tagReferenceParameters{
+				arguments: &tagReferenceFunctionArguments{
+					objectName: String(NewAccountObjectIdentifier("warehouse_name").FullyQualifiedName()),
+				},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("tagReferenceFunctionArguments", "objectDomain"))
+	})
+
+	t.Run("warehouse domain", func(t *testing.T) {
+		opts := NewGetForEntityTagReferenceRequest(NewAccountObjectIdentifier("warehouse_name"), TagReferenceObjectDomainWarehouse).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES ('\"warehouse_name\"', 'WAREHOUSE'))`)
+	})
+
+	t.Run("table domain", func(t *testing.T) {
+		opts := NewGetForEntityTagReferenceRequest(NewSchemaObjectIdentifier("db", "schema", "table"), TagReferenceObjectDomainTable).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES ('\"db\".\"schema\".\"table\"', 'TABLE'))`)
+	})
+
+	t.Run("column domain", func(t *testing.T) {
+		opts := NewGetForEntityTagReferenceRequest(NewTableColumnIdentifier("db", "schema", "table", "column"), TagReferenceObjectDomainColumn).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES ('\"db\".\"schema\".\"table\".\"column\"', 'COLUMN'))`)
+	})
+}
+
+func TestTagReferencesGetForAllColumns(t *testing.T) {
+	t.Run("validation: missing parameters", func(t *testing.T) {
+		opts := &getForAllColumnsTagReferenceOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("getForAllColumnsTagReferenceOptions", "parameters"))
+	})
+
+	t.Run("validation: missing objectName", func(t *testing.T) {
+		opts := NewGetForAllColumnsTagReferenceRequest(SchemaObjectIdentifier{}).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("tagReferenceFunctionArguments", "objectName"))
+	})
+
+	t.Run("table", func(t *testing.T) {
+		opts := NewGetForAllColumnsTagReferenceRequest(NewSchemaObjectIdentifier("db", "schema", "table")).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS ('\"db\".\"schema\".\"table\"', 'TABLE'))`)
+	})
+}
+
+func TestTagReference_IsInherited(t *testing.T) {
+	t.Run("set directly on the column", func(t *testing.T) {
+		reference := TagReference{Level: "COLUMN", Domain: "COLUMN"}
+		assert.False(t, reference.IsInherited())
+	})
+
+	t.Run("inherited from the table", func(t *testing.T) {
+		reference := TagReference{Level: "TABLE", Domain: "COLUMN"}
+		assert.True(t, reference.IsInherited())
+	})
+}
+
+func TestTagReferenceObjectDomainFromObjectType(t *testing.T) {
+	assert.Equal(t, TagReferenceObjectDomainTable, TagReferenceObjectDomainFromObjectType(ObjectTypeView))
+	assert.Equal(t, TagReferenceObjectDomainTable, TagReferenceObjectDomainFromObjectType(ObjectTypeMaterializedView))
+	assert.Equal(t, TagReferenceObjectDomainWarehouse, TagReferenceObjectDomainFromObjectType(ObjectTypeWarehouse))
+	assert.Equal(t, TagReferenceObjectDomainColumn, TagReferenceObjectDomainFromObjectType(ObjectTypeColumn))
+}
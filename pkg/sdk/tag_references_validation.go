@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"errors"
+)
+
+var (
+	_ validatable = new(getForEntityTagReferenceOptions)
+	_ validatable = new(getForAllColumnsTagReferenceOptions)
+)
+
+func (opts *getForEntityTagReferenceOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.parameters) {
+		errs = append(errs, errNotSet("getForEntityTagReferenceOptions", "parameters"))
+	} else {
+		if !valueSet(opts.parameters.arguments) {
+			errs = append(errs, errNotSet("tagReferenceParameters", "arguments"))
+		} else {
+			errs = append(errs, opts.parameters.arguments.validate()...)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *getForAllColumnsTagReferenceOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.parameters) {
+		errs = append(errs, errNotSet("getForAllColumnsTagReferenceOptions", "parameters"))
+	} else {
+		if !valueSet(opts.parameters.arguments) {
+			errs = append(errs, errNotSet("tagReferenceAllColumnsParameters", "arguments"))
+		} else {
+			errs = append(errs, opts.parameters.arguments.validate()...)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (arguments *tagReferenceFunctionArguments) validate() []error {
+	var errs []error
+	if arguments.objectName == nil || *arguments.objectName == "" {
+		errs = append(errs, errNotSet("tagReferenceFunctionArguments", "objectName"))
+	}
+	if arguments.objectDomain == nil {
+		errs = append(errs, errNotSet("tagReferenceFunctionArguments", "objectDomain"))
+	}
+	return errs
+}
@@ -37,13 +37,37 @@ type unsetTagOptions struct {
 
 // createTagOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-tag
 type createTagOptions struct {
-	create        bool                   `ddl:"static" sql:"CREATE"`
-	OrReplace     *bool                  `ddl:"keyword" sql:"OR REPLACE"`
-	tag           string                 `ddl:"static" sql:"TAG"`
-	IfNotExists   *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
-	name          SchemaObjectIdentifier `ddl:"identifier"`
-	AllowedValues *AllowedValues         `ddl:"keyword" sql:"ALLOWED_VALUES"`
-	Comment       *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	create        bool                      `ddl:"static" sql:"CREATE"`
+	OrReplace     *bool                     `ddl:"keyword" sql:"OR REPLACE"`
+	tag           string                    `ddl:"static" sql:"TAG"`
+	IfNotExists   *bool                     `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name          SchemaObjectIdentifier    `ddl:"identifier"`
+	AllowedValues *AllowedValues            `ddl:"keyword" sql:"ALLOWED_VALUES"`
+	Propagate     *TagPropagation           `ddl:"parameter" sql:"PROPAGATE"`
+	OnConflict    *TagPropagationOnConflict `ddl:"keyword"`
+	Comment       *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type TagPropagation string
+
+const (
+	TagPropagationOnDependencyAndDataMovement TagPropagation = "ON_DEPENDENCY_AND_DATA_MOVEMENT"
+	TagPropagationOnDependency                TagPropagation = "ON_DEPENDENCY"
+	TagPropagationOnDataMovement              TagPropagation = "ON_DATA_MOVEMENT"
+)
+
+var AllTagPropagations = []TagPropagation{
+	TagPropagationOnDependencyAndDataMovement,
+	TagPropagationOnDependency,
+	TagPropagationOnDataMovement,
+}
+
+// TagPropagationOnConflict specifies the value of a propagated tag when the values propagated from different sources
+// conflict; one of the fields should be set.
+type TagPropagationOnConflict struct {
+	onConflict            bool    `ddl:"static" sql:"ON_CONFLICT ="`
+	AllowedValuesSequence *bool   `ddl:"keyword" sql:"ALLOWED_VALUES_SEQUENCE"`
+	Value                 *string `ddl:"keyword,single_quotes"`
 }
 
 type AllowedValues struct {
@@ -71,6 +95,8 @@ type Tag struct {
 	Comment       string
 	AllowedValues []string
 	OwnerRole     string
+	Propagate     *TagPropagation
+	OnConflict    *string
 }
 
 func (v *Tag) ID() SchemaObjectIdentifier {
@@ -86,6 +112,8 @@ type tagRow struct {
 	Comment       string         `db:"comment"`
 	AllowedValues sql.NullString `db:"allowed_values"`
 	OwnerRoleType string         `db:"owner_role_type"`
+	Propagate     sql.NullString `db:"propagate"`
+	OnConflict    sql.NullString `db:"on_conflict"`
 }
 
 func (tr tagRow) convert() *Tag {
@@ -98,6 +126,12 @@ func (tr tagRow) convert() *Tag {
 		Comment:      tr.Comment,
 		OwnerRole:    tr.OwnerRoleType,
 	}
+	if tr.Propagate.Valid && tr.Propagate.String != "" {
+		t.Propagate = Pointer(TagPropagation(tr.Propagate.String))
+	}
+	if tr.OnConflict.Valid && tr.OnConflict.String != "" {
+		t.OnConflict = String(tr.OnConflict.String)
+	}
 	if tr.AllowedValues.Valid {
 		// remove brackets
 		if s := strings.Trim(tr.AllowedValues.String, "[]"); s != "" {
@@ -126,13 +160,17 @@ type TagMaskingPolicy struct {
 }
 
 type TagSet struct {
-	MaskingPolicies *TagSetMaskingPolicies `ddl:"keyword"`
-	Comment         *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	MaskingPolicies *TagSetMaskingPolicies    `ddl:"keyword"`
+	Propagate       *TagPropagation           `ddl:"parameter" sql:"PROPAGATE"`
+	OnConflict      *TagPropagationOnConflict `ddl:"keyword"`
+	Comment         *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 type TagUnset struct {
 	MaskingPolicies *TagUnsetMaskingPolicies `ddl:"keyword"`
 	AllowedValues   *bool                    `ddl:"keyword" sql:"ALLOWED_VALUES"`
+	Propagate       *bool                    `ddl:"keyword" sql:"PROPAGATE"`
+	OnConflict      *bool                    `ddl:"keyword" sql:"ON_CONFLICT"`
 	Comment         *bool                    `ddl:"keyword" sql:"COMMENT"`
 }
 
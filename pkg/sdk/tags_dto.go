@@ -34,6 +34,8 @@ type CreateTagRequest struct {
 	// One of
 	comment       *string
 	allowedValues *AllowedValues
+	propagate     *TagPropagation
+	onConflict    *TagPropagationOnConflict
 }
 
 type AlterTagRequest struct {
@@ -50,12 +52,16 @@ type AlterTagRequest struct {
 type TagSetRequest struct {
 	maskingPolicies []SchemaObjectIdentifier
 	force           *bool
+	propagate       *TagPropagation
+	onConflict      *TagPropagationOnConflict
 	comment         *string
 }
 
 type TagUnsetRequest struct {
 	maskingPolicies []SchemaObjectIdentifier
 	allowedValues   *bool
+	propagate       *bool
+	onConflict      *bool
 	comment         *bool
 }
 
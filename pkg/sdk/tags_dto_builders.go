@@ -52,6 +52,16 @@ func (s *CreateTagRequest) WithAllowedValues(values []string) *CreateTagRequest
 	return s
 }
 
+func (s *CreateTagRequest) WithPropagate(propagate *TagPropagation) *CreateTagRequest {
+	s.propagate = propagate
+	return s
+}
+
+func (s *CreateTagRequest) WithOnConflict(onConflict *TagPropagationOnConflict) *CreateTagRequest {
+	s.onConflict = onConflict
+	return s
+}
+
 func createAllowedValues(values []string) *AllowedValues {
 	items := make([]AllowedValue, 0, len(values))
 	for _, value := range values {
@@ -98,6 +108,16 @@ func (s *TagSetRequest) WithForce(force bool) *TagSetRequest {
 	return s
 }
 
+func (s *TagSetRequest) WithPropagate(propagate TagPropagation) *TagSetRequest {
+	s.propagate = Pointer(propagate)
+	return s
+}
+
+func (s *TagSetRequest) WithOnConflict(onConflict *TagPropagationOnConflict) *TagSetRequest {
+	s.onConflict = onConflict
+	return s
+}
+
 func (s *TagSetRequest) WithComment(comment string) *TagSetRequest {
 	s.comment = String(comment)
 	return s
@@ -115,7 +135,9 @@ func createTagMaskingPolicies(maskingPolicies []SchemaObjectIdentifier) []TagMas
 
 func (s *AlterTagRequest) WithSet(request *TagSetRequest) *AlterTagRequest {
 	set := &TagSet{
-		Comment: request.comment,
+		Propagate:  request.propagate,
+		OnConflict: request.onConflict,
+		Comment:    request.comment,
 	}
 	if len(request.maskingPolicies) > 0 {
 		set.MaskingPolicies = &TagSetMaskingPolicies{
@@ -141,6 +163,16 @@ func (s *TagUnsetRequest) WithAllowedValues(allowedValues bool) *TagUnsetRequest
 	return s
 }
 
+func (s *TagUnsetRequest) WithPropagate(propagate bool) *TagUnsetRequest {
+	s.propagate = Bool(propagate)
+	return s
+}
+
+func (s *TagUnsetRequest) WithOnConflict(onConflict bool) *TagUnsetRequest {
+	s.onConflict = Bool(onConflict)
+	return s
+}
+
 func (s *TagUnsetRequest) WithComment(comment bool) *TagUnsetRequest {
 	s.comment = Bool(comment)
 	return s
@@ -149,6 +181,8 @@ func (s *TagUnsetRequest) WithComment(comment bool) *TagUnsetRequest {
 func (s *AlterTagRequest) WithUnset(request *TagUnsetRequest) *AlterTagRequest {
 	unset := &TagUnset{
 		AllowedValues: request.allowedValues,
+		Propagate:     request.propagate,
+		OnConflict:    request.onConflict,
 		Comment:       request.comment,
 	}
 	if len(request.maskingPolicies) > 0 {
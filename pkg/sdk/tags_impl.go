@@ -71,6 +71,8 @@ func (s *CreateTagRequest) toOpts() *createTagOptions {
 		name:          s.name,
 		Comment:       s.comment,
 		AllowedValues: s.allowedValues,
+		Propagate:     s.propagate,
+		OnConflict:    s.onConflict,
 	}
 }
 
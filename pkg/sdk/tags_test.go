@@ -33,6 +33,19 @@ func TestTagCreate(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `CREATE TAG IF NOT EXISTS %s ALLOWED_VALUES 'value1', 'value2' COMMENT = 'comment'`, id.FullyQualifiedName())
 	})
 
+	t.Run("create with propagate", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Propagate = Pointer(TagPropagationOnDependencyAndDataMovement)
+		opts.OnConflict = &TagPropagationOnConflict{Value: String("conflict")}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE TAG %s PROPAGATE = ON_DEPENDENCY_AND_DATA_MOVEMENT ON_CONFLICT = 'conflict'`, id.FullyQualifiedName())
+	})
+
+	t.Run("validation: on conflict without propagate", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OnConflict = &TagPropagationOnConflict{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("createTagOptions", "Propagate"), errExactlyOneOf("TagPropagationOnConflict", "AllowedValuesSequence", "Value"))
+	})
+
 	t.Run("validation: nil options", func(t *testing.T) {
 		opts := (*createTagOptions)(nil)
 		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
@@ -248,6 +261,27 @@ func TestTagAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER TAG %s SET COMMENT = 'comment'`, id.FullyQualifiedName())
 	})
 
+	t.Run("alter with set propagate", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &TagSet{
+			Propagate:  Pointer(TagPropagationOnDependency),
+			OnConflict: &TagPropagationOnConflict{AllowedValuesSequence: Bool(true)},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TAG %s SET PROPAGATE = ON_DEPENDENCY ON_CONFLICT = ALLOWED_VALUES_SEQUENCE`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter with unset propagate", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &TagUnset{Propagate: Bool(true)}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TAG %s UNSET PROPAGATE`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter with unset on conflict", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &TagUnset{OnConflict: Bool(true)}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TAG %s UNSET ON_CONFLICT`, id.FullyQualifiedName())
+	})
+
 	t.Run("alter with unset comment", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Unset = &TagUnset{Comment: Bool(true)}
@@ -302,7 +336,7 @@ func TestTagAlter(t *testing.T) {
 	t.Run("validation: no property to unset", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Unset = &TagUnset{}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("TagUnset", "MaskingPolicies", "AllowedValues", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("TagUnset", "MaskingPolicies", "AllowedValues", "Propagate", "OnConflict", "Comment"))
 	})
 
 	t.Run("validation: allowed values count", func(t *testing.T) {
@@ -31,9 +31,24 @@ func (opts *createTagOptions) validate() error {
 			errs = append(errs, err)
 		}
 	}
+	if valueSet(opts.OnConflict) {
+		if !valueSet(opts.Propagate) {
+			errs = append(errs, errNotSet("createTagOptions", "Propagate"))
+		}
+		if err := opts.OnConflict.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	return errors.Join(errs...)
 }
 
+func (v *TagPropagationOnConflict) validate() error {
+	if !exactlyOneValueSet(v.AllowedValuesSequence, v.Value) {
+		return errExactlyOneOf("TagPropagationOnConflict", "AllowedValuesSequence", "Value")
+	}
+	return nil
+}
+
 func (v *AllowedValues) validate() error {
 	if !validateIntInRange(len(v.Values), 1, 300) {
 		return errIntBetween("AllowedValues", "Values", 1, 300)
@@ -43,8 +58,16 @@ func (v *AllowedValues) validate() error {
 
 func (v *TagSet) validate() error {
 	var errs []error
-	if !exactlyOneValueSet(v.MaskingPolicies, v.Comment) {
-		errs = append(errs, errOneOf("TagSet", "MaskingPolicies", "Comment"))
+	if !exactlyOneValueSet(v.MaskingPolicies, v.Propagate, v.Comment) {
+		errs = append(errs, errOneOf("TagSet", "MaskingPolicies", "Propagate", "Comment"))
+	}
+	if valueSet(v.OnConflict) {
+		if !valueSet(v.Propagate) {
+			errs = append(errs, errNotSet("TagSet", "Propagate"))
+		}
+		if err := v.OnConflict.validate(); err != nil {
+			errs = append(errs, err)
+		}
 	}
 	if valueSet(v.MaskingPolicies) {
 		if !validateIntGreaterThan(len(v.MaskingPolicies.MaskingPolicies), 0) {
@@ -56,8 +79,8 @@ func (v *TagSet) validate() error {
 
 func (v *TagUnset) validate() error {
 	var errs []error
-	if !exactlyOneValueSet(v.MaskingPolicies, v.AllowedValues, v.Comment) {
-		errs = append(errs, errExactlyOneOf("TagUnset", "MaskingPolicies", "AllowedValues", "Comment"))
+	if !exactlyOneValueSet(v.MaskingPolicies, v.AllowedValues, v.Propagate, v.OnConflict, v.Comment) {
+		errs = append(errs, errExactlyOneOf("TagUnset", "MaskingPolicies", "AllowedValues", "Propagate", "OnConflict", "Comment"))
 	}
 	if valueSet(v.MaskingPolicies) {
 		if !validateIntGreaterThan(len(v.MaskingPolicies.MaskingPolicies), 0) {
@@ -143,6 +143,11 @@ func TestInt_AccountCreate(t *testing.T) {
 		_, err = client.Accounts.ShowByID(ctx, newAccountID)
 		require.Error(t, err)
 
+		// check if the dropped account is listed in the history
+		droppedAccount, err := client.Accounts.ShowDroppedByID(ctx, newAccountID)
+		require.NoError(t, err)
+		assert.NotNil(t, droppedAccount.DroppedOn)
+
 		// undrop account
 		err = client.Accounts.Undrop(ctx, newAccountID)
 		require.NoError(t, err)
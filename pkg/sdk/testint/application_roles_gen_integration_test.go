@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -156,4 +159,100 @@ func TestInt_ApplicationRoles(t *testing.T) {
 		require.Equal(t, sdk.ObjectTypeApplicationRole, grants[0].GrantedOn)
 		require.Equal(t, sdk.ObjectTypeApplication, grants[0].GrantedTo)
 	})
+
+	t.Run("grant and revoke application role to account role", func(t *testing.T) {
+		id := sdk.NewDatabaseObjectIdentifier(appName, "app_role_2")
+		role, cleanupRole := createRole(t, client)
+		t.Cleanup(cleanupRole)
+		roleId := role.ID()
+		ctx := context.Background()
+
+		err := client.Grants.GrantApplicationRole(ctx, id, sdk.ApplicationRoleGrantee{AccountRole: &roleId})
+		require.NoError(t, err)
+
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Of: &sdk.ShowGrantsOf{ApplicationRole: id}})
+		require.NoError(t, err)
+		_, err = collections.FindOne(grants, func(grant sdk.Grant) bool {
+			return grant.GrantedTo == sdk.ObjectTypeRole && grant.GranteeName.Name() == roleId.Name()
+		})
+		require.NoError(t, err)
+
+		err = client.Grants.RevokeApplicationRole(ctx, id, sdk.ApplicationRoleGrantee{AccountRole: &roleId})
+		require.NoError(t, err)
+	})
+
+	t.Run("grant and revoke privileges to application", func(t *testing.T) {
+		warehouse, cleanupWarehouse := createWarehouse(t, client)
+		t.Cleanup(cleanupWarehouse)
+		warehouseId := warehouse.ID()
+		applicationId := sdk.NewAccountObjectIdentifier(appName)
+		privileges := &sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}}
+		on := &sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Warehouse: &warehouseId}}
+		ctx := context.Background()
+
+		err := client.Grants.GrantPrivilegesToApplication(ctx, privileges, on, applicationId)
+		require.NoError(t, err)
+
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Application: applicationId}})
+		require.NoError(t, err)
+		_, err = collections.FindOne(grants, func(grant sdk.Grant) bool {
+			return grant.GrantedOn == sdk.ObjectTypeWarehouse && grant.Privilege == sdk.AccountObjectPrivilegeUsage.String()
+		})
+		require.NoError(t, err)
+
+		err = client.Grants.RevokePrivilegesFromApplication(ctx, privileges, on, applicationId)
+		require.NoError(t, err)
+	})
+
+	t.Run("import and read privileges granted to application with the resource", func(t *testing.T) {
+		warehouse, cleanupWarehouse := createWarehouse(t, client)
+		t.Cleanup(cleanupWarehouse)
+		warehouseId := warehouse.ID()
+		applicationId := sdk.NewAccountObjectIdentifier(appName)
+		on := &sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Warehouse: &warehouseId}}
+		usage := &sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}}
+		monitor := &sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}}
+		ctx := context.Background()
+		meta := &provider.Context{Client: client}
+
+		err := client.Grants.GrantPrivilegesToApplication(ctx, usage, on, applicationId)
+		require.NoError(t, err)
+		err = client.Grants.GrantPrivilegesToApplication(ctx, monitor, on, applicationId)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = client.Grants.RevokePrivilegesFromApplication(ctx, usage, on, applicationId)
+			_ = client.Grants.RevokePrivilegesFromApplication(ctx, monitor, on, applicationId)
+		})
+
+		privilegesOf := func(d *schema.ResourceData) []string {
+			return collections.Map(d.Get("privileges").(*schema.Set).List(), func(privilege any) string { return privilege.(string) })
+		}
+
+		// import takes over all the privileges granted on the object
+		d := schema.TestResourceDataRaw(t, resources.GrantPrivilegesToApplication().Schema, map[string]any{})
+		d.SetId(resources.GrantPrivilegesToApplicationId{
+			ApplicationName: applicationId,
+			Kind:            resources.OnAccountObjectAccountRoleGrantKind,
+			ObjectType:      sdk.ObjectTypeWarehouse,
+			ObjectName:      warehouseId,
+		}.String())
+		imported, err := resources.ImportGrantPrivilegesToApplication(ctx, d, meta)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		assert.ElementsMatch(t, []string{"USAGE", "MONITOR"}, privilegesOf(d))
+
+		// read keeps only the privileges managed by the resource
+		require.NoError(t, d.Set("privileges", []string{"USAGE"}))
+		require.Empty(t, resources.ReadGrantPrivilegesToApplication(ctx, d, meta))
+		assert.ElementsMatch(t, []string{"USAGE"}, privilegesOf(d))
+
+		// after the privilege is revoked outside of Terraform, the privileges granted by other means are not taken over
+		err = client.Grants.RevokePrivilegesFromApplication(ctx, usage, on, applicationId)
+		require.NoError(t, err)
+		require.Empty(t, resources.ReadGrantPrivilegesToApplication(ctx, d, meta))
+		assert.Empty(t, privilegesOf(d))
+		require.Empty(t, resources.ReadGrantPrivilegesToApplication(ctx, d, meta))
+		assert.Empty(t, privilegesOf(d))
+		assert.Equal(t, d.Id(), imported[0].Id())
+	})
 }
@@ -0,0 +1,163 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_GitRepositories(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	origin := "https://github.com/Snowflake-Labs/terraform-provider-snowflake.git"
+
+	apiIntegrationId := sdk.NewAccountObjectIdentifier(random.AlphaN(20))
+	err := client.ApiIntegrations.Create(ctx, sdk.NewCreateApiIntegrationRequest(
+		apiIntegrationId,
+		[]sdk.ApiIntegrationEndpointPrefix{{Path: "https://github.com/Snowflake-Labs/"}},
+		true,
+	).WithGitHttpsApiProviderParams(sdk.NewGitHttpsApiParamsRequest()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ApiIntegrations.Drop(ctx, sdk.NewDropApiIntegrationRequest(apiIntegrationId))
+		require.NoError(t, err)
+	})
+
+	createGitRepository := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+
+		id := sdk.NewSchemaObjectIdentifier(TestDatabaseName, TestSchemaName, random.AlphaN(20))
+		err := client.GitRepositories.Create(ctx, sdk.NewCreateGitRepositoryRequest(id, origin, apiIntegrationId))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.GitRepositories.Drop(ctx, sdk.NewDropGitRepositoryRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(TestDatabaseName, TestSchemaName, random.AlphaN(20))
+		err := client.GitRepositories.Create(ctx, sdk.NewCreateGitRepositoryRequest(id, origin, apiIntegrationId).WithComment(sdk.String("some comment")))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.GitRepositories.Drop(ctx, sdk.NewDropGitRepositoryRequest(id))
+			require.NoError(t, err)
+		})
+
+		gitRepository, err := client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, gitRepository.CreatedOn)
+		assert.Equal(t, id.Name(), gitRepository.Name)
+		assert.Equal(t, id.DatabaseName(), gitRepository.DatabaseName)
+		assert.Equal(t, id.SchemaName(), gitRepository.SchemaName)
+		assert.Equal(t, origin, gitRepository.Origin)
+		assert.Equal(t, apiIntegrationId.Name(), gitRepository.ApiIntegration)
+		assert.Nil(t, gitRepository.GitCredentials)
+		assert.Equal(t, "ACCOUNTADMIN", gitRepository.Owner)
+		assert.Equal(t, "ROLE", gitRepository.OwnerRoleType)
+		require.NotNil(t, gitRepository.Comment)
+		assert.Equal(t, "some comment", *gitRepository.Comment)
+	})
+
+	t.Run("Alter: set and unset comment", func(t *testing.T) {
+		id := createGitRepository(t)
+
+		err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithSet(sdk.NewGitRepositorySetRequest().WithComment(sdk.String("new comment"))))
+		require.NoError(t, err)
+
+		gitRepository, err := client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, gitRepository.Comment)
+		assert.Equal(t, "new comment", *gitRepository.Comment)
+
+		err = client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithUnset(sdk.NewGitRepositoryUnsetRequest().WithComment(sdk.Bool(true))))
+		require.NoError(t, err)
+
+		gitRepository, err = client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, gitRepository.Comment)
+	})
+
+	t.Run("Alter: fetch", func(t *testing.T) {
+		id := createGitRepository(t)
+
+		err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithFetch(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		gitRepository, err := client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.NotNil(t, gitRepository.LastFetchedAt)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id := createGitRepository(t)
+
+		err := client.GitRepositories.Drop(ctx, sdk.NewDropGitRepositoryRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.GitRepositories.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id1 := createGitRepository(t)
+		id2 := createGitRepository(t)
+
+		gitRepositories, err := client.GitRepositories.Show(ctx, sdk.NewShowGitRepositoryRequest().WithIn(&sdk.In{
+			Schema: sdk.NewDatabaseObjectIdentifier(TestDatabaseName, TestSchemaName),
+		}))
+		require.NoError(t, err)
+
+		names := make([]string, len(gitRepositories))
+		for i, gitRepository := range gitRepositories {
+			names[i] = gitRepository.Name
+		}
+		assert.Contains(t, names, id1.Name())
+		assert.Contains(t, names, id2.Name())
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		id := createGitRepository(t)
+
+		gitRepository, err := client.GitRepositories.Describe(ctx, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, id.Name(), gitRepository.Name)
+		assert.Equal(t, origin, gitRepository.Origin)
+		assert.Equal(t, apiIntegrationId.Name(), gitRepository.ApiIntegration)
+	})
+
+	t.Run("ShowGitBranches", func(t *testing.T) {
+		id := createGitRepository(t)
+
+		branches, err := client.GitRepositories.ShowGitBranches(ctx, sdk.NewShowGitBranchesGitRepositoryRequest(id).WithLike(&sdk.Like{
+			Pattern: sdk.String("main"),
+		}))
+		require.NoError(t, err)
+
+		require.Len(t, branches, 1)
+		assert.Equal(t, "main", branches[0].Name)
+		assert.Equal(t, "/branches/main", branches[0].Path)
+		assert.NotEmpty(t, branches[0].CommitHash)
+	})
+
+	t.Run("ShowGitTags", func(t *testing.T) {
+		id := createGitRepository(t)
+
+		tags, err := client.GitRepositories.ShowGitTags(ctx, sdk.NewShowGitTagsGitRepositoryRequest(id).WithLike(&sdk.Like{
+			Pattern: sdk.String("v0.80.0"),
+		}))
+		require.NoError(t, err)
+
+		require.Len(t, tags, 1)
+		assert.Equal(t, "v0.80.0", tags[0].Name)
+		assert.Equal(t, "/tags/v0.80.0", tags[0].Path)
+		assert.NotEmpty(t, tags[0].CommitHash)
+	})
+}
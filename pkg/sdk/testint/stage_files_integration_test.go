@@ -0,0 +1,51 @@
+package testint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_StageFiles(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	stageId := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(20))
+	_, stageCleanup := createStage(t, client, stageId)
+	t.Cleanup(stageCleanup)
+
+	localPath := filepath.Join(t.TempDir(), "handler.py")
+	err := os.WriteFile(localPath, []byte("def run(session):\n  return 'ok'\n"), 0o600)
+	require.NoError(t, err)
+
+	location := sdk.NewStageLocation(stageId, "code")
+
+	t.Run("put, list and remove", func(t *testing.T) {
+		results, err := client.StageFiles.Put(ctx, localPath, location, &sdk.PutStageFileOptions{
+			AutoCompress: sdk.Bool(false),
+			Overwrite:    sdk.Bool(true),
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "handler.py", results[0].Target)
+		assert.Equal(t, "UPLOADED", results[0].Status)
+
+		files, err := client.StageFiles.List(ctx, sdk.NewStageLocation(stageId, "code/handler.py"), nil)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Contains(t, files[0].Name, "code/handler.py")
+		assert.Positive(t, files[0].Size)
+
+		err = client.StageFiles.Remove(ctx, sdk.NewStageLocation(stageId, "code/handler.py"), nil)
+		require.NoError(t, err)
+
+		files, err = client.StageFiles.List(ctx, location, nil)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+}
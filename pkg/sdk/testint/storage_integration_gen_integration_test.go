@@ -249,7 +249,7 @@ func TestInt_StorageIntegrations(t *testing.T) {
 		req := sdk.NewAlterStorageIntegrationRequest(id).
 			WithSet(
 				sdk.NewStorageIntegrationSetRequest().
-					WithS3Params(sdk.NewSetS3StorageParamsRequest(awsRoleARN)).
+					WithS3Params(sdk.NewSetS3StorageParamsRequest(awsRoleARN).WithUsePrivatelinkEndpoint(sdk.Bool(false))).
 					WithEnabled(true).
 					WithStorageAllowedLocations(changedS3AllowedLocations).
 					WithStorageBlockedLocations(changedS3BlockedLocations).
@@ -296,7 +296,8 @@ func TestInt_StorageIntegrations(t *testing.T) {
 					WithStorageAwsObjectAcl(sdk.Bool(true)).
 					WithEnabled(sdk.Bool(true)).
 					WithStorageBlockedLocations(sdk.Bool(true)).
-					WithComment(sdk.Bool(true)),
+					WithComment(sdk.Bool(true)).
+					WithUsePrivatelinkEndpoint(sdk.Bool(true)),
 			)
 		err := client.StorageIntegrations.Alter(ctx, req)
 		require.NoError(t, err)
@@ -205,6 +205,16 @@ func (s *AlterViewRequest) WithUnsetTagsOnColumn(UnsetTagsOnColumn *ViewUnsetCol
 	return s
 }
 
+func (s *AlterViewRequest) WithSetDataMetricSchedule(SetDataMetricSchedule *string) *AlterViewRequest {
+	s.SetDataMetricSchedule = SetDataMetricSchedule
+	return s
+}
+
+func (s *AlterViewRequest) WithUnsetDataMetricSchedule(UnsetDataMetricSchedule *bool) *AlterViewRequest {
+	s.UnsetDataMetricSchedule = UnsetDataMetricSchedule
+	return s
+}
+
 func NewViewAddRowAccessPolicyRequest(
 	RowAccessPolicy SchemaObjectIdentifier,
 	On []string,
@@ -66,6 +66,8 @@ type AlterViewRequest struct {
 	UnsetMaskingPolicyOnColumn *ViewUnsetColumnMaskingPolicyRequest
 	SetTagsOnColumn            *ViewSetColumnTagsRequest
 	UnsetTagsOnColumn          *ViewUnsetColumnTagsRequest
+	SetDataMetricSchedule      *string
+	UnsetDataMetricSchedule    *bool
 }
 
 type ViewAddRowAccessPolicyRequest struct {
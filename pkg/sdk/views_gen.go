@@ -74,6 +74,8 @@ type AlterViewOptions struct {
 	UnsetMaskingPolicyOnColumn *ViewUnsetColumnMaskingPolicy  `ddl:"keyword"`
 	SetTagsOnColumn            *ViewSetColumnTags             `ddl:"keyword"`
 	UnsetTagsOnColumn          *ViewUnsetColumnTags           `ddl:"keyword"`
+	SetDataMetricSchedule      *string                        `ddl:"parameter,single_quotes" sql:"SET DATA_METRIC_SCHEDULE"`
+	UnsetDataMetricSchedule    *bool                          `ddl:"keyword" sql:"UNSET DATA_METRIC_SCHEDULE"`
 }
 
 type ViewAddRowAccessPolicy struct {
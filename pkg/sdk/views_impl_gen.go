@@ -108,6 +108,8 @@ func (r *AlterViewRequest) toOpts() *AlterViewOptions {
 		SetTags:                  r.SetTags,
 		UnsetTags:                r.UnsetTags,
 		DropAllRowAccessPolicies: r.DropAllRowAccessPolicies,
+		SetDataMetricSchedule:    r.SetDataMetricSchedule,
+		UnsetDataMetricSchedule:  r.UnsetDataMetricSchedule,
 	}
 	if r.AddRowAccessPolicy != nil {
 		opts.AddRowAccessPolicy = &ViewAddRowAccessPolicy{